@@ -139,7 +139,7 @@ func (a *array) Offset() int {
 type arrayConstructorFn func(*Data) Interface
 
 var (
-	makeArrayFn [32]arrayConstructorFn
+	makeArrayFn [64]arrayConstructorFn
 )
 
 func unsupportedArrayType(data *Data) Interface {
@@ -152,7 +152,7 @@ func invalidDataType(data *Data) Interface {
 
 // MakeFromData constructs a strongly-typed array instance from generic Data.
 func MakeFromData(data *Data) Interface {
-	return makeArrayFn[byte(data.dtype.ID()&0x1f)](data)
+	return makeArrayFn[byte(data.dtype.ID()&0x3f)](data)
 }
 
 // NewSlice constructs a zero-copy slice of the array with the indicated
@@ -201,8 +201,17 @@ func init() {
 		arrow.EXTENSION:         unsupportedArrayType,
 		arrow.FIXED_SIZE_LIST:   func(data *Data) Interface { return NewFixedSizeListData(data) },
 		arrow.DURATION:          func(data *Data) Interface { return NewDurationData(data) },
+		arrow.LARGE_STRING:      func(data *Data) Interface { return NewLargeStringData(data) },
+		arrow.LARGE_BINARY:      func(data *Data) Interface { return NewLargeBinaryData(data) },
+		arrow.LARGE_LIST:        func(data *Data) Interface { return NewLargeListData(data) },
 
-		// invalid data types to fill out array size 2⁵-1
-		31: invalidDataType,
+		// invalid data types to fill out array size 2⁶-1
+		63: invalidDataType,
+	}
+
+	for i, fn := range makeArrayFn {
+		if fn == nil {
+			makeArrayFn[i] = invalidDataType
+		}
 	}
 }
@@ -84,6 +84,12 @@ func TestMakeFromData(t *testing.T) {
 			array.NewData(&testDataType{arrow.INT64}, 0, make([]*memory.Buffer, 4), nil, 0, 0),
 		}},
 		{name: "duration", d: &testDataType{arrow.DURATION}},
+		{name: "large_string", d: &testDataType{arrow.LARGE_STRING}, size: 3},
+		{name: "large_binary", d: &testDataType{arrow.LARGE_BINARY}, size: 3},
+		{name: "large_list", d: &testDataType{arrow.LARGE_LIST}, child: []*array.Data{
+			array.NewData(&testDataType{arrow.INT64}, 0, make([]*memory.Buffer, 4), nil, 0, 0),
+			array.NewData(&testDataType{arrow.INT64}, 0, make([]*memory.Buffer, 4), nil, 0, 0),
+		}},
 
 		// unsupported types
 		{name: "union", d: &testDataType{arrow.UNION}, expPanic: true, expError: "unsupported data type: UNION"},
@@ -93,7 +99,8 @@ func TestMakeFromData(t *testing.T) {
 
 		// invalid types
 		{name: "invalid(-1)", d: &testDataType{arrow.Type(-1)}, expPanic: true, expError: "invalid data type: Type(-1)"},
-		{name: "invalid(31)", d: &testDataType{arrow.Type(31)}, expPanic: true, expError: "invalid data type: Type(31)"},
+		{name: "invalid(34)", d: &testDataType{arrow.Type(34)}, expPanic: true, expError: "invalid data type: Type(34)"},
+		{name: "invalid(63)", d: &testDataType{arrow.Type(63)}, expPanic: true, expError: "invalid data type: Type(63)"},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
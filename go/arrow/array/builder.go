@@ -284,6 +284,13 @@ func NewBuilder(mem memory.Allocator, dtype arrow.DataType) Builder {
 	case arrow.DURATION:
 		typ := dtype.(*arrow.DurationType)
 		return NewDurationBuilder(mem, typ)
+	case arrow.LARGE_STRING:
+		return NewLargeStringBuilder(mem)
+	case arrow.LARGE_BINARY:
+		return NewLargeBinaryBuilder(mem, arrow.BinaryTypes.LargeBinary)
+	case arrow.LARGE_LIST:
+		typ := dtype.(*arrow.LargeListType)
+		return NewLargeListBuilder(mem, typ.Elem())
 	}
 	panic(fmt.Errorf("arrow/array: unsupported builder for %T", dtype))
 }
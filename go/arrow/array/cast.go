@@ -0,0 +1,269 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array
+
+import (
+	"math"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/float16"
+	"github.com/apache/arrow/go/arrow/memory"
+	"golang.org/x/xerrors"
+)
+
+// Cast returns an array holding the values of arr with the data type dtype.
+//
+// Cast supports casting an array to its own data type, and casting between
+// the variable-width types with 32-bit offsets and their counterparts with
+// 64-bit offsets: String and LargeString, Binary and LargeBinary, List and
+// LargeList. Lists are cast element-wise, so that a list<utf8> may be cast
+// to a large_list<large_utf8>.
+// The value buffers are shared with arr; only the offsets are converted.
+// Casting to a type with 32-bit offsets fails if the values of arr do not
+// fit them.
+//
+// Cast also supports the numeric casts that cannot lose information, as
+// defined by arrow.PromoteTypes, and casting a null array to any data type.
+//
+// The returned array must be Release'd after use.
+func Cast(arr Interface, dtype arrow.DataType, mem memory.Allocator) (Interface, error) {
+	data, err := castData(arr.Data(), dtype, mem)
+	if err != nil {
+		return nil, err
+	}
+	defer data.Release()
+	return MakeFromData(data), nil
+}
+
+func castData(d *Data, dtype arrow.DataType, mem memory.Allocator) (*Data, error) {
+	if arrow.TypeEqual(d.dtype, dtype) {
+		d.Retain()
+		return d, nil
+	}
+
+	var (
+		from = d.dtype.ID()
+		to   = dtype.ID()
+		n    = d.offset + d.length + 1
+	)
+	switch {
+	case from == arrow.NULL:
+		return makeNullData(dtype, d.length, mem), nil
+
+	case isNumeric(from) && isNumeric(to):
+		if dt, ok := arrow.PromoteTypes(d.dtype, dtype); !ok || !arrow.TypeEqual(dt, dtype) {
+			break
+		}
+		return castNumeric(d, dtype, mem), nil
+
+	case from == to && (from == arrow.LIST || from == arrow.LARGE_LIST):
+		return castOffsets(d, dtype, d.buffers[1], mem)
+
+	case from == arrow.STRING && to == arrow.LARGE_STRING,
+		from == arrow.BINARY && to == arrow.LARGE_BINARY,
+		from == arrow.LIST && to == arrow.LARGE_LIST:
+		if d.length == 0 || d.buffers[1] == nil {
+			n = 0
+		}
+		offsets := memory.NewResizableBuffer(mem)
+		defer offsets.Release()
+		offsets.Resize(arrow.Int64Traits.BytesRequired(n))
+		if n > 0 {
+			src := arrow.Int32Traits.CastFromBytes(d.buffers[1].Bytes())[:n]
+			dst := arrow.Int64Traits.CastFromBytes(offsets.Bytes())
+			for i, v := range src {
+				dst[i] = int64(v)
+			}
+		}
+		return castOffsets(d, dtype, offsets, mem)
+
+	case from == arrow.LARGE_STRING && to == arrow.STRING,
+		from == arrow.LARGE_BINARY && to == arrow.BINARY,
+		from == arrow.LARGE_LIST && to == arrow.LIST:
+		if d.length == 0 || d.buffers[1] == nil {
+			n = 0
+		}
+		offsets := memory.NewResizableBuffer(mem)
+		defer offsets.Release()
+		offsets.Resize(arrow.Int32Traits.BytesRequired(n))
+		if n > 0 {
+			src := arrow.Int64Traits.CastFromBytes(d.buffers[1].Bytes())[:n]
+			if last := src[n-1]; last > math.MaxInt32 {
+				return nil, xerrors.Errorf("arrow/array: cannot cast %v to %v: offset %d overflows int32", d.dtype, dtype, last)
+			}
+			dst := arrow.Int32Traits.CastFromBytes(offsets.Bytes())
+			for i, v := range src {
+				dst[i] = int32(v)
+			}
+		}
+		return castOffsets(d, dtype, offsets, mem)
+	}
+
+	return nil, xerrors.Errorf("arrow/array: cannot cast %v to %v", d.dtype, dtype)
+}
+
+// castOffsets returns array data of type dtype, sharing the buffers and
+// children of d but with the given offsets.
+// The child of lists is cast to the element type of dtype.
+func castOffsets(d *Data, dtype arrow.DataType, offsets *memory.Buffer, mem memory.Allocator) (*Data, error) {
+	buffers := make([]*memory.Buffer, len(d.buffers))
+	copy(buffers, d.buffers)
+	buffers[1] = offsets
+
+	var children []*Data
+	switch dt := dtype.(type) {
+	case *arrow.ListType:
+		child, err := castData(d.childData[0], dt.Elem(), mem)
+		if err != nil {
+			return nil, err
+		}
+		defer child.Release()
+		children = []*Data{child}
+	case *arrow.LargeListType:
+		child, err := castData(d.childData[0], dt.Elem(), mem)
+		if err != nil {
+			return nil, err
+		}
+		defer child.Release()
+		children = []*Data{child}
+	}
+
+	return NewData(dtype, d.length, buffers, children, d.nulls, d.offset), nil
+}
+
+// castNumeric converts the values of d to the numeric type dtype.
+// The conversion goes through float64, which is exact for all the lossless
+// numeric casts.
+func castNumeric(d *Data, dtype arrow.DataType, mem memory.Allocator) *Data {
+	arr := MakeFromData(d)
+	defer arr.Release()
+
+	bldr := NewBuilder(mem, dtype)
+	defer bldr.Release()
+	bldr.Reserve(arr.Len())
+
+	var (
+		get = numericGetter(arr)
+		add = numericAppender(bldr)
+	)
+	for i := 0; i < arr.Len(); i++ {
+		if arr.IsNull(i) {
+			bldr.AppendNull()
+			continue
+		}
+		add(get(i))
+	}
+
+	out := bldr.NewArray()
+	defer out.Release()
+	out.Data().Retain()
+	return out.Data()
+}
+
+func numericGetter(arr Interface) func(i int) float64 {
+	switch arr := arr.(type) {
+	case *Int8:
+		return func(i int) float64 { return float64(arr.Value(i)) }
+	case *Int16:
+		return func(i int) float64 { return float64(arr.Value(i)) }
+	case *Int32:
+		return func(i int) float64 { return float64(arr.Value(i)) }
+	case *Int64:
+		return func(i int) float64 { return float64(arr.Value(i)) }
+	case *Uint8:
+		return func(i int) float64 { return float64(arr.Value(i)) }
+	case *Uint16:
+		return func(i int) float64 { return float64(arr.Value(i)) }
+	case *Uint32:
+		return func(i int) float64 { return float64(arr.Value(i)) }
+	case *Uint64:
+		return func(i int) float64 { return float64(arr.Value(i)) }
+	case *Float16:
+		return func(i int) float64 { return float64(arr.Value(i).Float32()) }
+	case *Float32:
+		return func(i int) float64 { return float64(arr.Value(i)) }
+	case *Float64:
+		return arr.Value
+	}
+	panic(xerrors.Errorf("arrow/array: invalid numeric array %T", arr))
+}
+
+func numericAppender(bldr Builder) func(v float64) {
+	switch bldr := bldr.(type) {
+	case *Int8Builder:
+		return func(v float64) { bldr.Append(int8(v)) }
+	case *Int16Builder:
+		return func(v float64) { bldr.Append(int16(v)) }
+	case *Int32Builder:
+		return func(v float64) { bldr.Append(int32(v)) }
+	case *Int64Builder:
+		return func(v float64) { bldr.Append(int64(v)) }
+	case *Uint8Builder:
+		return func(v float64) { bldr.Append(uint8(v)) }
+	case *Uint16Builder:
+		return func(v float64) { bldr.Append(uint16(v)) }
+	case *Uint32Builder:
+		return func(v float64) { bldr.Append(uint32(v)) }
+	case *Uint64Builder:
+		return func(v float64) { bldr.Append(uint64(v)) }
+	case *Float16Builder:
+		return func(v float64) { bldr.Append(float16.New(float32(v))) }
+	case *Float32Builder:
+		return func(v float64) { bldr.Append(float32(v)) }
+	case *Float64Builder:
+		return bldr.Append
+	}
+	panic(xerrors.Errorf("arrow/array: invalid numeric builder %T", bldr))
+}
+
+func isNumeric(id arrow.Type) bool {
+	switch id {
+	case arrow.INT8, arrow.INT16, arrow.INT32, arrow.INT64,
+		arrow.UINT8, arrow.UINT16, arrow.UINT32, arrow.UINT64,
+		arrow.FLOAT16, arrow.FLOAT32, arrow.FLOAT64:
+		return true
+	}
+	return false
+}
+
+// makeNullData returns array data of type dtype holding n null values.
+func makeNullData(dtype arrow.DataType, n int, mem memory.Allocator) *Data {
+	bldr := NewBuilder(mem, dtype)
+	defer bldr.Release()
+
+	appendNulls(bldr, n)
+
+	arr := bldr.NewArray()
+	defer arr.Release()
+	arr.Data().Retain()
+	return arr.Data()
+}
+
+func appendNulls(bldr Builder, n int) {
+	switch bldr := bldr.(type) {
+	case *FixedSizeListBuilder:
+		// the child values of null fixed-size lists still need to exist.
+		for i := 0; i < n; i++ {
+			bldr.AppendNull()
+		}
+		appendNulls(bldr.values, n*int(bldr.n))
+	default:
+		for i := 0; i < n; i++ {
+			bldr.AppendNull()
+		}
+	}
+}
@@ -0,0 +1,185 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+func TestCast(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	lb := array.NewListBuilder(mem, arrow.BinaryTypes.String)
+	defer lb.Release()
+
+	vb := lb.ValueBuilder().(*array.StringBuilder)
+	lb.Append(true)
+	vb.AppendValues([]string{"a", "bc"}, nil)
+	lb.AppendNull()
+	lb.Append(true)
+	lb.Append(true)
+	vb.AppendValues([]string{"d", "", "ef"}, []bool{true, false, true})
+
+	list := lb.NewArray()
+	defer list.Release()
+
+	slice := array.NewSlice(list, 1, 4)
+	defer slice.Release()
+
+	for _, tc := range []struct {
+		arr   array.Interface
+		dtype arrow.DataType
+		want  string
+	}{
+		{list, arrow.LargeListOf(arrow.BinaryTypes.String), `[["a" "bc"] (null) [] ["d" (null) "ef"]]`},
+		{list, arrow.LargeListOf(arrow.BinaryTypes.LargeString), `[["a" "bc"] (null) [] ["d" (null) "ef"]]`},
+		{slice, arrow.LargeListOf(arrow.BinaryTypes.LargeString), `[(null) [] ["d" (null) "ef"]]`},
+		{list, list.DataType(), `[["a" "bc"] (null) [] ["d" (null) "ef"]]`},
+	} {
+		t.Run(fmt.Sprint(tc.dtype), func(t *testing.T) {
+			out, err := array.Cast(tc.arr, tc.dtype, mem)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer out.Release()
+
+			if !arrow.TypeEqual(out.DataType(), tc.dtype) {
+				t.Fatalf("invalid type: got=%v, want=%v", out.DataType(), tc.dtype)
+			}
+			if err := array.ValidateFull(out); err != nil {
+				t.Fatalf("invalid array: %v", err)
+			}
+			if got := fmt.Sprint(out); got != tc.want {
+				t.Fatalf("invalid values: got=%s, want=%s", got, tc.want)
+			}
+
+			back, err := array.Cast(out, tc.arr.DataType(), mem)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer back.Release()
+
+			if !array.ArrayEqual(back, tc.arr) {
+				t.Fatalf("invalid round-trip: got=%v, want=%v", back, tc.arr)
+			}
+		})
+	}
+}
+
+func TestCastErrors(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	bldr := array.NewLargeStringBuilder(mem)
+	defer bldr.Release()
+	bldr.AppendValues([]string{"a", "b"}, nil)
+
+	arr := bldr.NewArray()
+	defer arr.Release()
+
+	for _, dtype := range []arrow.DataType{
+		arrow.BinaryTypes.Binary,
+		arrow.BinaryTypes.LargeBinary,
+		arrow.PrimitiveTypes.Int64,
+		arrow.ListOf(arrow.BinaryTypes.String),
+	} {
+		_, err := array.Cast(arr, dtype, mem)
+		if err == nil || !strings.Contains(err.Error(), "cannot cast") {
+			t.Fatalf("expected a cast error for %v, got=%v", dtype, err)
+		}
+	}
+}
+
+func TestCastPromote(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	ib := array.NewInt32Builder(mem)
+	defer ib.Release()
+	ib.AppendValues([]int32{-1, 0, 2}, []bool{true, false, true})
+	i32 := ib.NewArray()
+	defer i32.Release()
+
+	ub := array.NewUint16Builder(mem)
+	defer ub.Release()
+	ub.AppendValues([]uint16{1, 65535}, nil)
+	u16 := ub.NewArray()
+	defer u16.Release()
+
+	lb := array.NewListBuilder(mem, arrow.PrimitiveTypes.Int32)
+	defer lb.Release()
+	lb.Append(true)
+	lb.ValueBuilder().(*array.Int32Builder).AppendValues([]int32{1, 2}, nil)
+	lb.AppendNull()
+	list := lb.NewArray()
+	defer list.Release()
+
+	nulls := array.NewNull(2)
+	defer nulls.Release()
+
+	for _, tc := range []struct {
+		arr   array.Interface
+		dtype arrow.DataType
+		want  string
+	}{
+		{i32, arrow.PrimitiveTypes.Int64, "[-1 (null) 2]"},
+		{i32, arrow.PrimitiveTypes.Float64, "[-1 (null) 2]"},
+		{u16, arrow.PrimitiveTypes.Int32, "[1 65535]"},
+		{u16, arrow.PrimitiveTypes.Uint64, "[1 65535]"},
+		{list, arrow.ListOf(arrow.PrimitiveTypes.Int64), "[[1 2] (null)]"},
+		{list, arrow.LargeListOf(arrow.PrimitiveTypes.Float64), "[[1 2] (null)]"},
+		{nulls, arrow.BinaryTypes.String, "[(null) (null)]"},
+		{nulls, arrow.FixedSizeListOf(2, arrow.PrimitiveTypes.Int8), "[(null) (null)]"},
+		{nulls, arrow.StructOf(arrow.Field{Name: "a", Type: arrow.PrimitiveTypes.Int8}), "{[(null) (null)]}"},
+	} {
+		t.Run(fmt.Sprintf("%v-%v", tc.arr.DataType(), tc.dtype), func(t *testing.T) {
+			out, err := array.Cast(tc.arr, tc.dtype, mem)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer out.Release()
+
+			if !arrow.TypeEqual(out.DataType(), tc.dtype) {
+				t.Fatalf("invalid type: got=%v, want=%v", out.DataType(), tc.dtype)
+			}
+			if err := array.ValidateFull(out); err != nil {
+				t.Fatalf("invalid array: %v", err)
+			}
+			if got := fmt.Sprint(out); got != tc.want {
+				t.Fatalf("invalid values: got=%s, want=%s", got, tc.want)
+			}
+		})
+	}
+
+	for _, dtype := range []arrow.DataType{
+		arrow.PrimitiveTypes.Int16,
+		arrow.PrimitiveTypes.Uint32,
+		arrow.PrimitiveTypes.Float32,
+	} {
+		_, err := array.Cast(i32, dtype, mem)
+		if err == nil || !strings.Contains(err.Error(), "cannot cast") {
+			t.Fatalf("expected a cast error for %v, got=%v", dtype, err)
+		}
+	}
+}
@@ -0,0 +1,486 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array
+
+import (
+	"math"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/bitutil"
+	"github.com/apache/arrow/go/arrow/memory"
+	"golang.org/x/xerrors"
+)
+
+// Concatenate creates a new array holding the values of all the arrays, in
+// order. The arrays must all have the same data type.
+// Concatenate returns an error if arrs is empty.
+//
+// The returned array must be Release()'d after use.
+func Concatenate(arrs []Interface, mem memory.Allocator) (Interface, error) {
+	if len(arrs) == 0 {
+		return nil, xerrors.Errorf("arrow/array: no arrays to concatenate")
+	}
+
+	data := make([]*Data, len(arrs))
+	for i, arr := range arrs {
+		if !arrow.TypeEqual(arr.DataType(), arrs[0].DataType()) {
+			return nil, xerrors.Errorf(
+				"arrow/array: arrays to concatenate have different types (%v and %v)",
+				arrs[0].DataType(), arr.DataType(),
+			)
+		}
+		data[i] = arr.Data()
+	}
+
+	out, err := concatData(data, mem)
+	if err != nil {
+		return nil, err
+	}
+	defer out.Release()
+
+	return MakeFromData(out), nil
+}
+
+// concatData concatenates the array data, which all have the same type.
+func concatData(data []*Data, mem memory.Allocator) (*Data, error) {
+	var (
+		dtype  = data[0].dtype
+		length = 0
+		nulls  = 0
+	)
+	for _, d := range data {
+		length += d.length
+		nulls += dataNullN(d)
+	}
+	if length > math.MaxInt32 && hasInt32Offsets(dtype) {
+		return nil, xerrors.Errorf("arrow/array: concatenated array of type %v too large (%d elements)", dtype, length)
+	}
+
+	if dtype.ID() == arrow.NULL {
+		return NewData(dtype, length, []*memory.Buffer{nil}, nil, length, 0), nil
+	}
+
+	var (
+		buffers  = []*memory.Buffer{concatBitmaps(data, nulls, mem)}
+		children []*Data
+		err      error
+	)
+	defer func() {
+		for _, buf := range buffers {
+			if buf != nil {
+				buf.Release()
+			}
+		}
+		for _, child := range children {
+			child.Release()
+		}
+	}()
+
+	switch dt := dtype.(type) {
+	case *arrow.BooleanType:
+		buffers = append(buffers, concatBools(data, mem))
+
+	case *arrow.StringType, *arrow.BinaryType:
+		offsets, ranges, err := concatOffsets(data, mem)
+		if err != nil {
+			return nil, err
+		}
+		buffers = append(buffers, offsets, concatValues(data, ranges, mem))
+
+	case *arrow.LargeStringType, *arrow.LargeBinaryType:
+		offsets, ranges := concatLargeOffsets(data, mem)
+		buffers = append(buffers, offsets, concatValues(data, ranges, mem))
+
+	case *arrow.ListType:
+		offsets, ranges, err := concatOffsets(data, mem)
+		if err != nil {
+			return nil, err
+		}
+		buffers = append(buffers, offsets)
+
+		child, err := concatChildren(data, 0, ranges, mem)
+		if err != nil {
+			return nil, err
+		}
+		children = append(children, child)
+
+	case *arrow.LargeListType:
+		offsets, ranges := concatLargeOffsets(data, mem)
+		buffers = append(buffers, offsets)
+
+		child, err := concatChildren(data, 0, ranges, mem)
+		if err != nil {
+			return nil, err
+		}
+		children = append(children, child)
+
+	case *arrow.FixedSizeListType:
+		n := int(dt.Len())
+		ranges := make([]valueRange, len(data))
+		for i, d := range data {
+			ranges[i] = valueRange{beg: d.offset * n, end: (d.offset + d.length) * n}
+		}
+		child, err := concatChildren(data, 0, ranges, mem)
+		if err != nil {
+			return nil, err
+		}
+		children = append(children, child)
+
+	case *arrow.StructType:
+		ranges := make([]valueRange, len(data))
+		for i, d := range data {
+			ranges[i] = valueRange{beg: d.offset, end: d.offset + d.length}
+		}
+		for k := range dt.Fields() {
+			child, err := concatChildren(data, k, ranges, mem)
+			if err != nil {
+				return nil, err
+			}
+			children = append(children, child)
+		}
+
+	case arrow.FixedWidthDataType:
+		width := dt.BitWidth() / 8
+		if _, ok := dt.(*arrow.Decimal128Type); ok {
+			width = arrow.Decimal128SizeBytes
+		}
+		ranges := make([]valueRange, len(data))
+		for i, d := range data {
+			ranges[i] = valueRange{beg: d.offset * width, end: (d.offset + d.length) * width}
+		}
+		buffers = append(buffers, concatBuffers(data, 1, ranges, mem))
+
+	default:
+		err = xerrors.Errorf("arrow/array: concatenation of arrays of type %v not supported", dtype)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return NewData(dtype, length, buffers, children, nulls, 0), nil
+}
+
+// dataNullN returns the number of null values of the array data, computing
+// it from the validity bitmap if it is unknown.
+func dataNullN(d *Data) int {
+	if d.nulls < 0 {
+		if len(d.buffers) == 0 || d.buffers[0] == nil {
+			d.nulls = 0
+			return 0
+		}
+		d.nulls = d.length - bitutil.CountSetBits(d.buffers[0].Bytes(), d.offset, d.length)
+	}
+	return d.nulls
+}
+
+func hasInt32Offsets(dt arrow.DataType) bool {
+	switch dt.ID() {
+	case arrow.STRING, arrow.BINARY, arrow.LIST:
+		return true
+	}
+	return false
+}
+
+// valueRange is a range of values, or bytes, of an array data.
+type valueRange struct {
+	beg, end int
+}
+
+// concatBitmaps returns the concatenation of the validity bitmaps of the
+// array data, or nil if no value is null.
+func concatBitmaps(data []*Data, nulls int, mem memory.Allocator) *memory.Buffer {
+	if nulls == 0 {
+		return nil
+	}
+
+	length := 0
+	for _, d := range data {
+		length += d.length
+	}
+
+	buf := memory.NewResizableBuffer(mem)
+	buf.Resize(int(bitutil.BytesForBits(int64(length))))
+	out := buf.Bytes()
+
+	pos := 0
+	for _, d := range data {
+		if dataNullN(d) == 0 {
+			setBits(out, pos, d.length)
+		} else {
+			copyBits(out, pos, d.buffers[0].Bytes(), d.offset, d.length)
+		}
+		pos += d.length
+	}
+	return buf
+}
+
+// concatBools returns the concatenation of the values of boolean array data.
+func concatBools(data []*Data, mem memory.Allocator) *memory.Buffer {
+	length := 0
+	for _, d := range data {
+		length += d.length
+	}
+
+	buf := memory.NewResizableBuffer(mem)
+	buf.Resize(int(bitutil.BytesForBits(int64(length))))
+	out := buf.Bytes()
+
+	pos := 0
+	for _, d := range data {
+		if d.length > 0 {
+			copyBits(out, pos, d.buffers[1].Bytes(), d.offset, d.length)
+		}
+		pos += d.length
+	}
+	return buf
+}
+
+// setBits sets the n bits of dst starting at bit pos.
+func setBits(dst []byte, pos, n int) {
+	for i := pos; i < pos+n; i++ {
+		bitutil.SetBit(dst, i)
+	}
+}
+
+// copyBits copies n bits of src starting at bit offset to dst starting at
+// bit pos. Whole bytes are copied when both positions are byte-aligned.
+func copyBits(dst []byte, pos int, src []byte, offset, n int) {
+	if pos%8 == 0 && offset%8 == 0 {
+		nbytes := n / 8
+		copy(dst[pos/8:pos/8+nbytes], src[offset/8:offset/8+nbytes])
+		pos += 8 * nbytes
+		offset += 8 * nbytes
+		n -= 8 * nbytes
+	}
+	for i := 0; i < n; i++ {
+		bitutil.SetBitTo(dst, pos+i, bitutil.BitIsSet(src, offset+i))
+	}
+}
+
+// concatOffsets returns the concatenation of the int32 offsets of the array
+// data, re-based to start at zero, and the ranges of values spanned by each
+// array data.
+func concatOffsets(data []*Data, mem memory.Allocator) (*memory.Buffer, []valueRange, error) {
+	length := 0
+	for _, d := range data {
+		length += d.length
+	}
+
+	buf := memory.NewResizableBuffer(mem)
+	buf.Resize(arrow.Int32Traits.BytesRequired(length + 1))
+	out := arrow.Int32Traits.CastFromBytes(buf.Bytes())
+
+	var (
+		ranges = make([]valueRange, len(data))
+		pos    = 0
+		values = 0
+	)
+	for i, d := range data {
+		if d.length == 0 {
+			continue
+		}
+		src := arrow.Int32Traits.CastFromBytes(d.buffers[1].Bytes())[d.offset : d.offset+d.length+1]
+		ranges[i] = valueRange{beg: int(src[0]), end: int(src[d.length])}
+		if values+ranges[i].end-ranges[i].beg > math.MaxInt32 {
+			buf.Release()
+			return nil, nil, xerrors.Errorf("arrow/array: concatenated offsets overflow int32")
+		}
+		delta := int32(values) - src[0]
+		for j, v := range src[:d.length] {
+			out[pos+j] = v + delta
+		}
+		pos += d.length
+		values += ranges[i].end - ranges[i].beg
+	}
+	out[pos] = int32(values)
+	return buf, ranges, nil
+}
+
+// concatLargeOffsets is like concatOffsets, for int64 offsets.
+func concatLargeOffsets(data []*Data, mem memory.Allocator) (*memory.Buffer, []valueRange) {
+	length := 0
+	for _, d := range data {
+		length += d.length
+	}
+
+	buf := memory.NewResizableBuffer(mem)
+	buf.Resize(arrow.Int64Traits.BytesRequired(length + 1))
+	out := arrow.Int64Traits.CastFromBytes(buf.Bytes())
+
+	var (
+		ranges = make([]valueRange, len(data))
+		pos    = 0
+		values = int64(0)
+	)
+	for i, d := range data {
+		if d.length == 0 {
+			continue
+		}
+		src := arrow.Int64Traits.CastFromBytes(d.buffers[1].Bytes())[d.offset : d.offset+d.length+1]
+		ranges[i] = valueRange{beg: int(src[0]), end: int(src[d.length])}
+		delta := values - src[0]
+		for j, v := range src[:d.length] {
+			out[pos+j] = v + delta
+		}
+		pos += d.length
+		values += src[d.length] - src[0]
+	}
+	out[pos] = values
+	return buf, ranges
+}
+
+// concatValues returns the concatenation of the ranges of bytes of the value
+// buffers of binary array data.
+func concatValues(data []*Data, ranges []valueRange, mem memory.Allocator) *memory.Buffer {
+	return concatBuffers(data, 2, ranges, mem)
+}
+
+// concatBuffers returns the concatenation of the ranges of bytes of the i-th
+// buffers of the array data.
+func concatBuffers(data []*Data, i int, ranges []valueRange, mem memory.Allocator) *memory.Buffer {
+	size := 0
+	for _, r := range ranges {
+		size += r.end - r.beg
+	}
+
+	buf := memory.NewResizableBuffer(mem)
+	buf.Resize(size)
+	out := buf.Bytes()
+
+	pos := 0
+	for k, d := range data {
+		r := ranges[k]
+		if r.end == r.beg {
+			continue
+		}
+		pos += copy(out[pos:], d.buffers[i].Bytes()[r.beg:r.end])
+	}
+	return buf
+}
+
+// concatChildren returns the concatenation of the ranges of values of the
+// k-th children of the array data.
+func concatChildren(data []*Data, k int, ranges []valueRange, mem memory.Allocator) (*Data, error) {
+	children := make([]*Data, len(data))
+	for i, d := range data {
+		children[i] = NewSliceData(d.childData[k], int64(ranges[i].beg), int64(ranges[i].end))
+	}
+	defer func() {
+		for _, child := range children {
+			child.Release()
+		}
+	}()
+	return concatData(children, mem)
+}
+
+// Combine returns a new array holding all the values of the chunked array.
+// The chunked array may be empty.
+//
+// The returned array must be Release()'d after use.
+func (a *Chunked) Combine(mem memory.Allocator) (Interface, error) {
+	switch len(a.chunks) {
+	case 0:
+		bldr := NewBuilder(mem, a.dtype)
+		defer bldr.Release()
+		return bldr.NewArray(), nil
+	case 1:
+		a.chunks[0].Retain()
+		return a.chunks[0], nil
+	default:
+		return Concatenate(a.chunks, mem)
+	}
+}
+
+// ConcatenateRecords creates a new record holding the rows of all the
+// records, in order. The records must all have the same schema.
+// ConcatenateRecords returns an error if recs is empty.
+//
+// The returned record must be Release()'d after use.
+func ConcatenateRecords(recs []Record, mem memory.Allocator) (Record, error) {
+	if len(recs) == 0 {
+		return nil, xerrors.Errorf("arrow/array: no records to concatenate")
+	}
+
+	schema := recs[0].Schema()
+	for _, rec := range recs[1:] {
+		if !rec.Schema().Equal(schema) {
+			return nil, xerrors.Errorf("arrow/array: records to concatenate have different schemas")
+		}
+	}
+
+	var (
+		rows int64
+		cols = make([]Interface, len(schema.Fields()))
+		arrs = make([]Interface, len(recs))
+	)
+	defer func() {
+		for _, col := range cols {
+			if col != nil {
+				col.Release()
+			}
+		}
+	}()
+
+	for _, rec := range recs {
+		rows += rec.NumRows()
+	}
+	for i := range cols {
+		for j, rec := range recs {
+			arrs[j] = rec.Column(i)
+		}
+		col, err := Concatenate(arrs, mem)
+		if err != nil {
+			return nil, err
+		}
+		cols[i] = col
+	}
+
+	return NewRecord(schema, cols, rows), nil
+}
+
+// NewRecordFromTable creates a new record holding all the rows of the table,
+// combining the chunks of each of its columns.
+//
+// The returned record must be Release()'d after use.
+func NewRecordFromTable(tbl Table, mem memory.Allocator) (Record, error) {
+	var (
+		rows = tbl.NumRows()
+		cols = make([]Interface, tbl.NumCols())
+	)
+	defer func() {
+		for _, col := range cols {
+			if col != nil {
+				col.Release()
+			}
+		}
+	}()
+
+	for i := range cols {
+		chunks := tbl.Column(i).Data()
+		if int64(chunks.Len()) != rows {
+			chunks = chunks.NewSlice(0, rows)
+			defer chunks.Release()
+		}
+		col, err := chunks.Combine(mem)
+		if err != nil {
+			return nil, err
+		}
+		cols[i] = col
+	}
+
+	return NewRecord(tbl.Schema(), cols, rows), nil
+}
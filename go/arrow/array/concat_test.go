@@ -0,0 +1,371 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/decimal128"
+	"github.com/apache/arrow/go/arrow/float16"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+// concatCases describes how to fill builders of various types with the i-th
+// valid value.
+var concatCases = []struct {
+	dtype  arrow.DataType
+	append func(b array.Builder, i int)
+}{
+	{
+		dtype:  arrow.FixedWidthTypes.Boolean,
+		append: func(b array.Builder, i int) { b.(*array.BooleanBuilder).Append(i%2 == 0) },
+	},
+	{
+		dtype:  arrow.PrimitiveTypes.Int8,
+		append: func(b array.Builder, i int) { b.(*array.Int8Builder).Append(int8(i)) },
+	},
+	{
+		dtype:  arrow.PrimitiveTypes.Uint16,
+		append: func(b array.Builder, i int) { b.(*array.Uint16Builder).Append(uint16(i)) },
+	},
+	{
+		dtype:  arrow.PrimitiveTypes.Int32,
+		append: func(b array.Builder, i int) { b.(*array.Int32Builder).Append(int32(i)) },
+	},
+	{
+		dtype:  arrow.PrimitiveTypes.Float64,
+		append: func(b array.Builder, i int) { b.(*array.Float64Builder).Append(float64(i) / 2) },
+	},
+	{
+		dtype:  arrow.FixedWidthTypes.Float16,
+		append: func(b array.Builder, i int) { b.(*array.Float16Builder).Append(float16.New(float32(i))) },
+	},
+	{
+		dtype: &arrow.Decimal128Type{Precision: 10, Scale: 2},
+		append: func(b array.Builder, i int) {
+			b.(*array.Decimal128Builder).Append(decimal128.FromI64(int64(i)*1001 - 5000))
+		},
+	},
+	{
+		dtype:  arrow.FixedWidthTypes.Timestamp_ms,
+		append: func(b array.Builder, i int) { b.(*array.TimestampBuilder).Append(arrow.Timestamp(i)) },
+	},
+	{
+		dtype: arrow.FixedWidthTypes.DayTimeInterval,
+		append: func(b array.Builder, i int) {
+			b.(*array.DayTimeIntervalBuilder).Append(arrow.DayTimeInterval{Days: int32(i), Milliseconds: int32(-i)})
+		},
+	},
+	{
+		dtype:  arrow.BinaryTypes.String,
+		append: func(b array.Builder, i int) { b.(*array.StringBuilder).Append(strings.Repeat("x", i%5)) },
+	},
+	{
+		dtype:  arrow.BinaryTypes.Binary,
+		append: func(b array.Builder, i int) { b.(*array.BinaryBuilder).Append([]byte(fmt.Sprint(i))) },
+	},
+	{
+		dtype:  arrow.BinaryTypes.LargeString,
+		append: func(b array.Builder, i int) { b.(*array.LargeStringBuilder).Append(strings.Repeat("y", i%3)) },
+	},
+	{
+		dtype:  arrow.BinaryTypes.LargeBinary,
+		append: func(b array.Builder, i int) { b.(*array.LargeBinaryBuilder).Append([]byte(fmt.Sprint(i))) },
+	},
+	{
+		dtype:  &arrow.FixedSizeBinaryType{ByteWidth: 3},
+		append: func(b array.Builder, i int) { b.(*array.FixedSizeBinaryBuilder).Append([]byte{byte(i), 1, 2}) },
+	},
+	{
+		dtype: arrow.ListOf(arrow.PrimitiveTypes.Int32),
+		append: func(b array.Builder, i int) {
+			lb := b.(*array.ListBuilder)
+			lb.Append(true)
+			vb := lb.ValueBuilder().(*array.Int32Builder)
+			for j := 0; j < i%4; j++ {
+				if j == 2 {
+					vb.AppendNull()
+					continue
+				}
+				vb.Append(int32(i + j))
+			}
+		},
+	},
+	{
+		dtype: arrow.LargeListOf(arrow.BinaryTypes.String),
+		append: func(b array.Builder, i int) {
+			lb := b.(*array.LargeListBuilder)
+			lb.Append(true)
+			vb := lb.ValueBuilder().(*array.StringBuilder)
+			for j := 0; j < i%3; j++ {
+				vb.Append(fmt.Sprint(i + j))
+			}
+		},
+	},
+	{
+		dtype: arrow.FixedSizeListOf(2, arrow.BinaryTypes.String),
+		append: func(b array.Builder, i int) {
+			lb := b.(*array.FixedSizeListBuilder)
+			lb.Append(true)
+			vb := lb.ValueBuilder().(*array.StringBuilder)
+			vb.Append(fmt.Sprint(i))
+			vb.AppendNull()
+		},
+	},
+	{
+		dtype: arrow.StructOf(
+			arrow.Field{Name: "a", Type: arrow.PrimitiveTypes.Int64, Nullable: true},
+			arrow.Field{Name: "b", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true},
+		),
+		append: func(b array.Builder, i int) {
+			sb := b.(*array.StructBuilder)
+			sb.Append(true)
+			sb.FieldBuilder(0).(*array.Int64Builder).Append(int64(i))
+			lb := sb.FieldBuilder(1).(*array.ListBuilder)
+			lb.Append(true)
+			lb.ValueBuilder().(*array.StringBuilder).Append(fmt.Sprint(i))
+		},
+	},
+}
+
+// appendNullTo appends a null value to b, along with the child values needed
+// by struct and fixed-size list builders.
+func appendNullTo(b array.Builder) {
+	switch b := b.(type) {
+	case *array.StructBuilder:
+		b.AppendValues([]bool{false})
+		for i := 0; i < b.NumField(); i++ {
+			appendNullTo(b.FieldBuilder(i))
+		}
+	case *array.FixedSizeListBuilder:
+		b.AppendNull()
+		appendNullTo(b.ValueBuilder())
+		appendNullTo(b.ValueBuilder())
+	default:
+		b.AppendNull()
+	}
+}
+
+func TestConcatenate(t *testing.T) {
+	const n = 37
+	cuts := []int64{0, 3, 10, 10, 11, 29, n}
+
+	for _, tc := range concatCases {
+		t.Run(fmt.Sprint(tc.dtype), func(t *testing.T) {
+			mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+			defer mem.AssertSize(t, 0)
+
+			bldr := array.NewBuilder(mem, tc.dtype)
+			defer bldr.Release()
+
+			for i := 0; i < n; i++ {
+				switch {
+				case i%3 == 1 || i == 20:
+					appendNullTo(bldr)
+				default:
+					tc.append(bldr, i)
+				}
+			}
+			want := bldr.NewArray()
+			defer want.Release()
+
+			slices := make([]array.Interface, 0, len(cuts)-1)
+			for i := range cuts[1:] {
+				slice := array.NewSlice(want, cuts[i], cuts[i+1])
+				defer slice.Release()
+				slices = append(slices, slice)
+			}
+
+			got, err := array.Concatenate(slices, mem)
+			if err != nil {
+				t.Fatalf("could not concatenate arrays: %v", err)
+			}
+			defer got.Release()
+
+			if !array.ArrayEqual(got, want) {
+				t.Fatalf("invalid concatenation:\ngot= %v\nwant=%v", got, want)
+			}
+			if got, want := got.NullN(), want.NullN(); got != want {
+				t.Fatalf("invalid number of nulls: got=%d, want=%d", got, want)
+			}
+
+			// concatenate slices of the concatenation, with unaligned offsets.
+			sub := array.NewSlice(got, 5, 30)
+			defer sub.Release()
+			twice, err := array.Concatenate([]array.Interface{sub, sub}, mem)
+			if err != nil {
+				t.Fatalf("could not concatenate slices: %v", err)
+			}
+			defer twice.Release()
+
+			if got, want := twice.Len(), 50; got != want {
+				t.Fatalf("invalid length: got=%d, want=%d", got, want)
+			}
+			if !array.ArraySliceEqual(twice, 0, 25, want, 5, 30) || !array.ArraySliceEqual(twice, 25, 50, want, 5, 30) {
+				t.Fatalf("invalid concatenation of slices:\ngot= %v\nwant=%v", twice, sub)
+			}
+		})
+	}
+}
+
+func TestConcatenateNull(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	a1 := array.NewNull(3)
+	defer a1.Release()
+	a2 := array.NewNull(4)
+	defer a2.Release()
+
+	got, err := array.Concatenate([]array.Interface{a1, a2}, mem)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer got.Release()
+
+	if got, want := got.Len(), 7; got != want {
+		t.Fatalf("invalid length: got=%d, want=%d", got, want)
+	}
+	if got, want := got.NullN(), 7; got != want {
+		t.Fatalf("invalid number of nulls: got=%d, want=%d", got, want)
+	}
+}
+
+func TestConcatenateErrors(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	_, err := array.Concatenate(nil, mem)
+	if err == nil {
+		t.Fatalf("expected an error on empty input")
+	}
+
+	a1 := array.NewNull(3)
+	defer a1.Release()
+
+	ib := array.NewInt32Builder(mem)
+	defer ib.Release()
+	ib.Append(1)
+	a2 := ib.NewArray()
+	defer a2.Release()
+
+	_, err = array.Concatenate([]array.Interface{a1, a2}, mem)
+	if err == nil {
+		t.Fatalf("expected an error on mismatching types")
+	}
+}
+
+func TestChunkedCombine(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	fb := array.NewFloat64Builder(mem)
+	defer fb.Release()
+
+	fb.AppendValues([]float64{1, 2, 3}, []bool{true, false, true})
+	f1 := fb.NewArray()
+	defer f1.Release()
+
+	fb.AppendValues([]float64{4, 5}, nil)
+	f2 := fb.NewArray()
+	defer f2.Release()
+
+	for _, tc := range []struct {
+		chunks []array.Interface
+		want   string
+	}{
+		{nil, "[]"},
+		{[]array.Interface{f1}, "[1 (null) 3]"},
+		{[]array.Interface{f1, f2, f1}, "[1 (null) 3 4 5 1 (null) 3]"},
+	} {
+		chunked := array.NewChunked(arrow.PrimitiveTypes.Float64, tc.chunks)
+		arr, err := chunked.Combine(mem)
+		chunked.Release()
+		if err != nil {
+			t.Fatalf("could not combine chunks: %v", err)
+		}
+		if got := fmt.Sprint(arr); got != tc.want {
+			t.Fatalf("invalid array: got=%s, want=%s", got, tc.want)
+		}
+		arr.Release()
+	}
+}
+
+func TestConcatenateRecords(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	schema := arrow.NewSchema([]arrow.Field{
+		{Name: "i32", Type: arrow.PrimitiveTypes.Int32},
+		{Name: "str", Type: arrow.BinaryTypes.String, Nullable: true},
+	}, nil)
+
+	bld := array.NewRecordBuilder(mem, schema)
+	defer bld.Release()
+
+	bld.Field(0).(*array.Int32Builder).AppendValues([]int32{1, 2}, nil)
+	bld.Field(1).(*array.StringBuilder).AppendValues([]string{"a", ""}, []bool{true, false})
+	r1 := bld.NewRecord()
+	defer r1.Release()
+
+	bld.Field(0).(*array.Int32Builder).AppendValues([]int32{3, 4, 5}, nil)
+	bld.Field(1).(*array.StringBuilder).AppendValues([]string{"c", "d", "e"}, nil)
+	r2 := bld.NewRecord()
+	defer r2.Release()
+
+	rec, err := array.ConcatenateRecords([]array.Record{r1, r2}, mem)
+	if err != nil {
+		t.Fatalf("could not concatenate records: %v", err)
+	}
+	defer rec.Release()
+
+	if got, want := rec.NumRows(), int64(5); got != want {
+		t.Fatalf("invalid number of rows: got=%d, want=%d", got, want)
+	}
+	for i, want := range []string{"[1 2 3 4 5]", `["a" (null) "c" "d" "e"]`} {
+		if got := fmt.Sprint(rec.Column(i)); got != want {
+			t.Fatalf("invalid column %d: got=%s, want=%s", i, got, want)
+		}
+	}
+
+	tbl := array.NewTableFromRecords(schema, []array.Record{r1, r2, r1})
+	defer tbl.Release()
+
+	trec, err := array.NewRecordFromTable(tbl, mem)
+	if err != nil {
+		t.Fatalf("could not create record from table: %v", err)
+	}
+	defer trec.Release()
+
+	for i, want := range []string{"[1 2 3 4 5 1 2]", `["a" (null) "c" "d" "e" "a" (null)]`} {
+		if got := fmt.Sprint(trec.Column(i)); got != want {
+			t.Fatalf("invalid table column %d: got=%s, want=%s", i, got, want)
+		}
+	}
+
+	other := array.NewRecord(arrow.NewSchema(schema.Fields()[:1], nil), r1.Columns()[:1], r1.NumRows())
+	defer other.Release()
+
+	_, err = array.ConcatenateRecords([]array.Record{r1, other}, mem)
+	if err == nil {
+		t.Fatalf("expected an error on mismatching schemas")
+	}
+}
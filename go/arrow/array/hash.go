@@ -0,0 +1,302 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array
+
+import (
+	"math"
+
+	"github.com/apache/arrow/go/arrow/memory"
+	"golang.org/x/xerrors"
+)
+
+// Hashes are computed with FNV-1a over the values, so that they are stable
+// across processes and platforms:
+//   - integers of all widths, and temporal values, hash as their 64-bit value,
+//   - floating point values hash as their float64 value, with -0 hashed as 0
+//     and all NaNs hashed alike,
+//   - strings and binary values, of all offset widths, hash as their bytes,
+//   - nulls hash to the same constant, whatever their type,
+//   - list values hash their length and the hashes of their elements, and
+//     struct values hash the hashes of their fields.
+const (
+	hashOffset = 14695981039346656037
+	hashPrime  = 1099511628211
+	nullHash   = 0x9e3779b97f4a7c15
+	nanBits    = 0x7ff8000000000001
+)
+
+// HashArray returns the 64-bit hash of each value of arr.
+//
+// HashArray returns an error if the data type of arr is not supported.
+func HashArray(arr Interface) ([]uint64, error) {
+	hashes := make([]uint64, arr.Len())
+	var get func(i int) uint64
+	switch arr := arr.(type) {
+	case *Null:
+		get = func(i int) uint64 { return nullHash }
+	case *Boolean:
+		get = func(i int) uint64 {
+			if arr.Value(i) {
+				return hashUint64(1)
+			}
+			return hashUint64(0)
+		}
+	case *Int8:
+		get = func(i int) uint64 { return hashUint64(uint64(arr.Value(i))) }
+	case *Int16:
+		get = func(i int) uint64 { return hashUint64(uint64(arr.Value(i))) }
+	case *Int32:
+		get = func(i int) uint64 { return hashUint64(uint64(arr.Value(i))) }
+	case *Int64:
+		get = func(i int) uint64 { return hashUint64(uint64(arr.Value(i))) }
+	case *Uint8:
+		get = func(i int) uint64 { return hashUint64(uint64(arr.Value(i))) }
+	case *Uint16:
+		get = func(i int) uint64 { return hashUint64(uint64(arr.Value(i))) }
+	case *Uint32:
+		get = func(i int) uint64 { return hashUint64(uint64(arr.Value(i))) }
+	case *Uint64:
+		get = func(i int) uint64 { return hashUint64(arr.Value(i)) }
+	case *Float16:
+		get = func(i int) uint64 { return hashFloat(float64(arr.Value(i).Float32())) }
+	case *Float32:
+		get = func(i int) uint64 { return hashFloat(float64(arr.Value(i))) }
+	case *Float64:
+		get = func(i int) uint64 { return hashFloat(arr.Value(i)) }
+	case *Date32:
+		get = func(i int) uint64 { return hashUint64(uint64(arr.Value(i))) }
+	case *Date64:
+		get = func(i int) uint64 { return hashUint64(uint64(arr.Value(i))) }
+	case *Time32:
+		get = func(i int) uint64 { return hashUint64(uint64(arr.Value(i))) }
+	case *Time64:
+		get = func(i int) uint64 { return hashUint64(uint64(arr.Value(i))) }
+	case *Timestamp:
+		get = func(i int) uint64 { return hashUint64(uint64(arr.Value(i))) }
+	case *Duration:
+		get = func(i int) uint64 { return hashUint64(uint64(arr.Value(i))) }
+	case *MonthInterval:
+		get = func(i int) uint64 { return hashUint64(uint64(arr.Value(i))) }
+	case *DayTimeInterval:
+		get = func(i int) uint64 {
+			v := arr.Value(i)
+			return hashCombine(hashUint64(uint64(v.Days)), hashUint64(uint64(v.Milliseconds)))
+		}
+	case *Decimal128:
+		get = func(i int) uint64 {
+			v := arr.Value(i)
+			return hashCombine(hashUint64(uint64(v.HighBits())), hashUint64(v.LowBits()))
+		}
+	case *FixedSizeBinary:
+		get = func(i int) uint64 { return hashBytes(arr.Value(i)) }
+	case *Binary:
+		get = func(i int) uint64 { return hashBytes(arr.Value(i)) }
+	case *LargeBinary:
+		get = func(i int) uint64 { return hashBytes(arr.Value(i)) }
+	case *String:
+		get = func(i int) uint64 { return hashString(arr.Value(i)) }
+	case *LargeString:
+		get = func(i int) uint64 { return hashString(arr.Value(i)) }
+	case *List:
+		values, err := HashArray(arr.ListValues())
+		if err != nil {
+			return nil, err
+		}
+		offsets := arr.Offsets()[arr.Offset():]
+		get = func(i int) uint64 { return hashSeq(values[offsets[i]:offsets[i+1]]) }
+	case *LargeList:
+		values, err := HashArray(arr.ListValues())
+		if err != nil {
+			return nil, err
+		}
+		offsets := arr.Offsets()[arr.Offset():]
+		get = func(i int) uint64 { return hashSeq(values[offsets[i]:offsets[i+1]]) }
+	case *FixedSizeList:
+		values, err := HashArray(arr.ListValues())
+		if err != nil {
+			return nil, err
+		}
+		n := int(arr.n)
+		get = func(i int) uint64 {
+			beg := (arr.Offset() + i) * n
+			return hashSeq(values[beg : beg+n])
+		}
+	case *Struct:
+		fields := make([][]uint64, arr.NumField())
+		for k := range fields {
+			h, err := HashArray(arr.Field(k))
+			if err != nil {
+				return nil, err
+			}
+			fields[k] = h
+		}
+		get = func(i int) uint64 {
+			h := uint64(hashOffset)
+			for _, f := range fields {
+				h = hashCombine(h, f[i])
+			}
+			return h
+		}
+	default:
+		return nil, xerrors.Errorf("arrow/array: hashing not implemented for %v", arr.DataType())
+	}
+
+	for i := range hashes {
+		if arr.IsNull(i) {
+			hashes[i] = nullHash
+			continue
+		}
+		hashes[i] = get(i)
+	}
+	return hashes, nil
+}
+
+// HashRecord returns the 64-bit hash of each row of rec, over the columns
+// with the provided names, in order. All the columns are hashed if no name
+// is provided.
+//
+// HashRecord returns an error if a name does not match exactly one column.
+func HashRecord(rec Record, keys []string) ([]uint64, error) {
+	cols, err := keyColumns(rec, keys)
+	if err != nil {
+		return nil, err
+	}
+
+	hashes := make([]uint64, rec.NumRows())
+	for i := range hashes {
+		hashes[i] = hashOffset
+	}
+	for _, i := range cols {
+		h, err := HashArray(rec.Column(i))
+		if err != nil {
+			return nil, xerrors.Errorf("arrow/array: could not hash column %q: %w", rec.ColumnName(i), err)
+		}
+		for j, v := range h {
+			hashes[j] = hashCombine(hashes[j], v)
+		}
+	}
+	return hashes, nil
+}
+
+// Partition splits rec into n records by the hash of the columns with the
+// provided names, as computed by HashRecord. Row i of rec goes to the record
+// at index HashRecord(rec, keys)[i] % n, rows keeping their relative order.
+//
+// The returned records must be Release()'d after use.
+func Partition(rec Record, keys []string, n int, mem memory.Allocator) ([]Record, error) {
+	if n <= 0 {
+		return nil, xerrors.Errorf("arrow/array: invalid number of partitions %d", n)
+	}
+
+	hashes, err := HashRecord(rec, keys)
+	if err != nil {
+		return nil, err
+	}
+
+	indices := make([][]int, n)
+	for i, h := range hashes {
+		k := h % uint64(n)
+		indices[k] = append(indices[k], i)
+	}
+
+	recs := make([]Record, n)
+	for k := range recs {
+		recs[k], err = TakeRecord(rec, indices[k], mem)
+		if err != nil {
+			for _, rec := range recs[:k] {
+				rec.Release()
+			}
+			return nil, err
+		}
+	}
+	return recs, nil
+}
+
+func keyColumns(rec Record, keys []string) ([]int, error) {
+	if len(keys) == 0 {
+		cols := make([]int, rec.NumCols())
+		for i := range cols {
+			cols[i] = i
+		}
+		return cols, nil
+	}
+
+	cols := make([]int, len(keys))
+	for i, key := range keys {
+		idx := rec.Schema().FieldIndices(key)
+		switch len(idx) {
+		case 0:
+			return nil, xerrors.Errorf("arrow/array: no column named %q", key)
+		case 1:
+			cols[i] = idx[0]
+		default:
+			return nil, xerrors.Errorf("arrow/array: ambiguous column name %q", key)
+		}
+	}
+	return cols, nil
+}
+
+func hashUint64(v uint64) uint64 {
+	h := uint64(hashOffset)
+	for i := 0; i < 8; i++ {
+		h ^= v & 0xff
+		h *= hashPrime
+		v >>= 8
+	}
+	return h
+}
+
+func hashFloat(v float64) uint64 {
+	switch {
+	case v == 0:
+		return hashUint64(0) // -0 and +0
+	case math.IsNaN(v):
+		return hashUint64(nanBits)
+	}
+	return hashUint64(math.Float64bits(v))
+}
+
+func hashBytes(b []byte) uint64 {
+	h := uint64(hashOffset)
+	for _, c := range b {
+		h ^= uint64(c)
+		h *= hashPrime
+	}
+	return h
+}
+
+func hashString(s string) uint64 {
+	h := uint64(hashOffset)
+	for i := 0; i < len(s); i++ {
+		h ^= uint64(s[i])
+		h *= hashPrime
+	}
+	return h
+}
+
+// hashSeq returns the hash of a sequence of values from their hashes.
+func hashSeq(values []uint64) uint64 {
+	h := hashUint64(uint64(len(values)))
+	for _, v := range values {
+		h = hashCombine(h, v)
+	}
+	return h
+}
+
+func hashCombine(h, v uint64) uint64 {
+	return h ^ (v + 0x9e3779b97f4a7c15 + h<<6 + h>>2)
+}
@@ -0,0 +1,245 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array_test
+
+import (
+	"hash/fnv"
+	"math"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/internal/arrdata"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+func TestHashArray(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	hash := func(arr array.Interface) []uint64 {
+		t.Helper()
+		defer arr.Release()
+		h, err := array.HashArray(arr)
+		if err != nil {
+			t.Fatalf("could not hash %v: %v", arr.DataType(), err)
+		}
+		return h
+	}
+
+	i32 := func() array.Interface {
+		b := array.NewInt32Builder(mem)
+		defer b.Release()
+		b.AppendValues([]int32{1, -2, 0}, []bool{true, true, false})
+		return b.NewArray()
+	}
+	i64 := func() array.Interface {
+		b := array.NewInt64Builder(mem)
+		defer b.Release()
+		b.AppendValues([]int64{1, -2, 42}, []bool{true, true, false})
+		return b.NewArray()
+	}
+	str := func() array.Interface {
+		b := array.NewStringBuilder(mem)
+		defer b.Release()
+		b.AppendValues([]string{"abc", "", "x"}, []bool{true, true, false})
+		return b.NewArray()
+	}
+	lstr := func() array.Interface {
+		b := array.NewLargeStringBuilder(mem)
+		defer b.Release()
+		b.AppendValues([]string{"abc", "", "y"}, []bool{true, true, false})
+		return b.NewArray()
+	}
+	f64 := func() array.Interface {
+		b := array.NewFloat64Builder(mem)
+		defer b.Release()
+		b.AppendValues([]float64{0, math.NaN()}, nil)
+		return b.NewArray()
+	}
+	negf64 := func() array.Interface {
+		b := array.NewFloat64Builder(mem)
+		defer b.Release()
+		b.AppendValues([]float64{math.Copysign(0, -1), -math.NaN()}, nil)
+		return b.NewArray()
+	}
+
+	equal := func(name string, a, b []uint64) {
+		t.Helper()
+		if len(a) != len(b) {
+			t.Fatalf("%s: invalid lengths: %d != %d", name, len(a), len(b))
+		}
+		for i := range a {
+			if a[i] != b[i] {
+				t.Fatalf("%s: hashes differ at %d: %x != %x", name, i, a[i], b[i])
+			}
+		}
+	}
+
+	equal("int32-int64", hash(i32()), hash(i64()))
+	equal("string-large-string", hash(str()), hash(lstr()))
+	equal("floats", hash(f64()), hash(negf64()))
+
+	h := hash(str())
+	fh := fnv.New64a()
+	fh.Write([]byte("abc"))
+	if got, want := h[0], fh.Sum64(); got != want {
+		t.Fatalf("invalid string hash: got=%x, want=%x", got, want)
+	}
+	if h[1] == h[2] {
+		t.Fatalf("empty string and null hash alike")
+	}
+
+	// sliced arrays hash as their values.
+	arr := str()
+	defer arr.Release()
+	slice := array.NewSlice(arr, 1, 3)
+	equal("slice", hash(slice), h[1:])
+}
+
+func TestHashArrayNested(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	lb := array.NewListBuilder(mem, arrow.PrimitiveTypes.Int32)
+	defer lb.Release()
+	vb := lb.ValueBuilder().(*array.Int32Builder)
+
+	lb.Append(true)
+	vb.AppendValues([]int32{1, 2}, nil)
+	lb.Append(true)
+	vb.AppendValues([]int32{1}, nil)
+	lb.Append(true)
+	vb.AppendValues([]int32{2}, nil)
+	lb.Append(true)
+	vb.AppendValues([]int32{1, 2}, nil)
+	lb.AppendNull()
+
+	list := lb.NewArray()
+	defer list.Release()
+
+	h, err := array.HashArray(list)
+	if err != nil {
+		t.Fatalf("could not hash list: %v", err)
+	}
+	if h[0] != h[3] {
+		t.Fatalf("equal lists hash differently")
+	}
+	if h[0] == h[1] || h[0] == h[2] || h[1] == h[2] {
+		t.Fatalf("different lists hash alike: %x", h)
+	}
+
+	slice := array.NewSlice(list, 2, 4)
+	defer slice.Release()
+
+	hs, err := array.HashArray(slice)
+	if err != nil {
+		t.Fatalf("could not hash list slice: %v", err)
+	}
+	if hs[0] != h[2] || hs[1] != h[3] {
+		t.Fatalf("invalid hashes of list slice: got=%x, want=%x", hs, h[2:4])
+	}
+
+	for name, recs := range arrdata.Records {
+		for _, rec := range recs {
+			if _, err := array.HashRecord(rec, nil); err != nil {
+				t.Fatalf("could not hash %q record: %v", name, err)
+			}
+		}
+	}
+}
+
+func TestPartition(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	schema := arrow.NewSchema([]arrow.Field{
+		{Name: "key", Type: arrow.BinaryTypes.String},
+		{Name: "value", Type: arrow.PrimitiveTypes.Int64},
+	}, nil)
+
+	b := array.NewRecordBuilder(mem, schema)
+	defer b.Release()
+
+	keys := []string{"a", "b", "c", "a", "d", "b", "a", "e", "f", "c"}
+	for i, key := range keys {
+		b.Field(0).(*array.StringBuilder).Append(key)
+		b.Field(1).(*array.Int64Builder).Append(int64(i))
+	}
+	rec := b.NewRecord()
+	defer rec.Release()
+
+	const n = 3
+	parts, err := array.Partition(rec, []string{"key"}, n, mem)
+	if err != nil {
+		t.Fatalf("could not partition record: %v", err)
+	}
+	defer func() {
+		for _, part := range parts {
+			part.Release()
+		}
+	}()
+
+	if got, want := len(parts), n; got != want {
+		t.Fatalf("invalid number of partitions: got=%d, want=%d", got, want)
+	}
+
+	var (
+		rows  int64
+		owner = make(map[string]int)
+	)
+	for k, part := range parts {
+		if !part.Schema().Equal(schema) {
+			t.Fatalf("invalid schema for partition %d: %v", k, part.Schema())
+		}
+		rows += part.NumRows()
+
+		var (
+			ks   = part.Column(0).(*array.String)
+			vs   = part.Column(1).(*array.Int64)
+			prev = int64(-1)
+		)
+		for i := 0; i < ks.Len(); i++ {
+			key := ks.Value(i)
+			if o, ok := owner[key]; ok && o != k {
+				t.Fatalf("key %q in partitions %d and %d", key, o, k)
+			}
+			owner[key] = k
+
+			v := vs.Value(i)
+			if keys[v] != key {
+				t.Fatalf("invalid row in partition %d: key=%q, value=%d", k, key, v)
+			}
+			if v <= prev {
+				t.Fatalf("rows of partition %d are not in order", k)
+			}
+			prev = v
+		}
+	}
+	if got, want := rows, rec.NumRows(); got != want {
+		t.Fatalf("invalid number of rows: got=%d, want=%d", got, want)
+	}
+
+	for _, keys := range [][]string{{"missing"}} {
+		if _, err := array.Partition(rec, keys, n, mem); err == nil {
+			t.Fatalf("expected an error for keys %v", keys)
+		}
+	}
+	if _, err := array.Partition(rec, nil, 0, mem); err == nil {
+		t.Fatalf("expected an error for 0 partitions")
+	}
+}
@@ -0,0 +1,322 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"unsafe"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/internal/debug"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+// LargeBinary represents an immutable sequence of variable-length binary strings,
+// using 64-bit offsets.
+type LargeBinary struct {
+	array
+	valueOffsets []int64
+	valueBytes   []byte
+}
+
+// NewLargeBinaryData constructs a new LargeBinary array from data.
+func NewLargeBinaryData(data *Data) *LargeBinary {
+	a := &LargeBinary{}
+	a.refCount = 1
+	a.setData(data)
+	return a
+}
+
+// Value returns the slice at index i. This value should not be mutated.
+func (a *LargeBinary) Value(i int) []byte {
+	if i < 0 || i >= a.array.data.length {
+		panic("arrow/array: index out of range")
+	}
+	idx := a.array.data.offset + i
+	return a.valueBytes[a.valueOffsets[idx]:a.valueOffsets[idx+1]]
+}
+
+// ValueString returns the string at index i without performing additional allocations.
+// The string is only valid for the lifetime of the LargeBinary array.
+func (a *LargeBinary) ValueString(i int) string {
+	b := a.Value(i)
+	return *(*string)(unsafe.Pointer(&b))
+}
+
+func (a *LargeBinary) ValueOffset(i int) int64 {
+	if i < 0 || i >= a.array.data.length {
+		panic("arrow/array: index out of range")
+	}
+	return a.valueOffsets[a.array.data.offset+i]
+}
+
+func (a *LargeBinary) ValueLen(i int) int {
+	if i < 0 || i >= a.array.data.length {
+		panic("arrow/array: index out of range")
+	}
+	beg := a.array.data.offset + i
+	return int(a.valueOffsets[beg+1] - a.valueOffsets[beg])
+}
+
+func (a *LargeBinary) ValueOffsets() []int64 {
+	beg := a.array.data.offset
+	end := beg + a.array.data.length + 1
+	return a.valueOffsets[beg:end]
+}
+
+func (a *LargeBinary) ValueBytes() []byte {
+	beg := a.array.data.offset
+	end := beg + a.array.data.length
+	return a.valueBytes[a.valueOffsets[beg]:a.valueOffsets[end]]
+}
+
+func (a *LargeBinary) String() string {
+	o := new(strings.Builder)
+	o.WriteString("[")
+	for i := 0; i < a.Len(); i++ {
+		if i > 0 {
+			o.WriteString(" ")
+		}
+		switch {
+		case a.IsNull(i):
+			o.WriteString("(null)")
+		default:
+			fmt.Fprintf(o, "%q", a.ValueString(i))
+		}
+	}
+	o.WriteString("]")
+	return o.String()
+}
+
+func (a *LargeBinary) setData(data *Data) {
+	if len(data.buffers) != 3 {
+		panic("len(data.buffers) != 3")
+	}
+
+	a.array.setData(data)
+
+	if valueData := data.buffers[2]; valueData != nil {
+		a.valueBytes = valueData.Bytes()
+	}
+
+	if valueOffsets := data.buffers[1]; valueOffsets != nil {
+		a.valueOffsets = arrow.Int64Traits.CastFromBytes(valueOffsets.Bytes())
+	}
+}
+
+func arrayEqualLargeBinary(left, right *LargeBinary) bool {
+	for i := 0; i < left.Len(); i++ {
+		if left.IsNull(i) {
+			continue
+		}
+		if !bytes.Equal(left.Value(i), right.Value(i)) {
+			return false
+		}
+	}
+	return true
+}
+
+// A LargeBinaryBuilder is used to build a LargeBinary array using the Append methods.
+type LargeBinaryBuilder struct {
+	builder
+
+	dtype   arrow.BinaryDataType
+	offsets *int64BufferBuilder
+	values  *byteBufferBuilder
+}
+
+// NewLargeBinaryBuilder creates a new LargeBinaryBuilder.
+// The data type must be arrow.BinaryTypes.LargeBinary or arrow.BinaryTypes.LargeString.
+func NewLargeBinaryBuilder(mem memory.Allocator, dtype arrow.BinaryDataType) *LargeBinaryBuilder {
+	b := &LargeBinaryBuilder{
+		builder: builder{refCount: 1, mem: mem},
+		dtype:   dtype,
+		offsets: newInt64BufferBuilder(mem),
+		values:  newByteBufferBuilder(mem),
+	}
+	return b
+}
+
+// Release decreases the reference count by 1.
+// When the reference count goes to zero, the memory is freed.
+// Release may be called simultaneously from multiple goroutines.
+func (b *LargeBinaryBuilder) Release() {
+	debug.Assert(atomic.LoadInt64(&b.refCount) > 0, "too many releases")
+
+	if atomic.AddInt64(&b.refCount, -1) == 0 {
+		if b.nullBitmap != nil {
+			b.nullBitmap.Release()
+			b.nullBitmap = nil
+		}
+		if b.offsets != nil {
+			b.offsets.Release()
+			b.offsets = nil
+		}
+		if b.values != nil {
+			b.values.Release()
+			b.values = nil
+		}
+	}
+}
+
+func (b *LargeBinaryBuilder) Append(v []byte) {
+	b.Reserve(1)
+	b.appendNextOffset()
+	b.values.Append(v)
+	b.UnsafeAppendBoolToBitmap(true)
+}
+
+func (b *LargeBinaryBuilder) AppendString(v string) {
+	b.Append([]byte(v))
+}
+
+func (b *LargeBinaryBuilder) AppendNull() {
+	b.Reserve(1)
+	b.appendNextOffset()
+	b.UnsafeAppendBoolToBitmap(false)
+}
+
+// AppendValues will append the values in the v slice. The valid slice determines which values
+// in v are valid (not null). The valid slice must either be empty or be equal in length to v. If empty,
+// all values in v are appended and considered valid.
+func (b *LargeBinaryBuilder) AppendValues(v [][]byte, valid []bool) {
+	if len(v) != len(valid) && len(valid) != 0 {
+		panic("len(v) != len(valid) && len(valid) != 0")
+	}
+
+	if len(v) == 0 {
+		return
+	}
+
+	b.Reserve(len(v))
+	for _, vv := range v {
+		b.appendNextOffset()
+		b.values.Append(vv)
+	}
+
+	b.builder.unsafeAppendBoolsToBitmap(valid, len(v))
+}
+
+// AppendStringValues will append the values in the v slice. The valid slice determines which values
+// in v are valid (not null). The valid slice must either be empty or be equal in length to v. If empty,
+// all values in v are appended and considered valid.
+func (b *LargeBinaryBuilder) AppendStringValues(v []string, valid []bool) {
+	if len(v) != len(valid) && len(valid) != 0 {
+		panic("len(v) != len(valid) && len(valid) != 0")
+	}
+
+	if len(v) == 0 {
+		return
+	}
+
+	b.Reserve(len(v))
+	for _, vv := range v {
+		b.appendNextOffset()
+		b.values.Append([]byte(vv))
+	}
+
+	b.builder.unsafeAppendBoolsToBitmap(valid, len(v))
+}
+
+func (b *LargeBinaryBuilder) Value(i int) []byte {
+	offsets := b.offsets.Values()
+	start := int(offsets[i])
+	var end int
+	if i == (b.length - 1) {
+		end = b.values.Len()
+	} else {
+		end = int(offsets[i+1])
+	}
+	return b.values.Bytes()[start:end]
+}
+
+func (b *LargeBinaryBuilder) init(capacity int) {
+	b.builder.init(capacity)
+	b.offsets.resize((capacity + 1) * arrow.Int64SizeBytes)
+}
+
+// DataLen returns the number of bytes in the data array.
+func (b *LargeBinaryBuilder) DataLen() int { return b.values.length }
+
+// DataCap returns the total number of bytes that can be stored
+// without allocating additional memory.
+func (b *LargeBinaryBuilder) DataCap() int { return b.values.capacity }
+
+// Reserve ensures there is enough space for appending n elements
+// by checking the capacity and calling Resize if necessary.
+func (b *LargeBinaryBuilder) Reserve(n int) {
+	b.builder.reserve(n, b.Resize)
+}
+
+// ReserveData ensures there is enough space for appending n bytes
+// by checking the capacity and resizing the data buffer if necessary.
+func (b *LargeBinaryBuilder) ReserveData(n int) {
+	if b.values.capacity < b.values.length+n {
+		b.values.resize(b.values.Len() + n)
+	}
+}
+
+// Resize adjusts the space allocated by b to n elements. If n is greater than b.Cap(),
+// additional memory will be allocated. If n is smaller, the allocated memory may be reduced.
+func (b *LargeBinaryBuilder) Resize(n int) {
+	b.offsets.resize((n + 1) * arrow.Int64SizeBytes)
+	b.builder.resize(n, b.init)
+}
+
+// NewArray creates a LargeBinary array from the memory buffers used by the builder and resets the LargeBinaryBuilder
+// so it can be used to build a new array.
+func (b *LargeBinaryBuilder) NewArray() Interface {
+	return b.NewLargeBinaryArray()
+}
+
+// NewLargeBinaryArray creates a LargeBinary array from the memory buffers used by the builder and resets the LargeBinaryBuilder
+// so it can be used to build a new array.
+func (b *LargeBinaryBuilder) NewLargeBinaryArray() (a *LargeBinary) {
+	data := b.newData()
+	a = NewLargeBinaryData(data)
+	data.Release()
+	return
+}
+
+func (b *LargeBinaryBuilder) newData() (data *Data) {
+	b.appendNextOffset()
+	offsets, values := b.offsets.Finish(), b.values.Finish()
+	data = NewData(b.dtype, b.length, []*memory.Buffer{b.nullBitmap, offsets, values}, nil, b.nulls, 0)
+	if offsets != nil {
+		offsets.Release()
+	}
+
+	if values != nil {
+		values.Release()
+	}
+
+	b.builder.reset()
+
+	return
+}
+
+func (b *LargeBinaryBuilder) appendNextOffset() {
+	b.offsets.AppendValue(int64(b.values.Len()))
+}
+
+var (
+	_ Interface = (*LargeBinary)(nil)
+	_ Builder   = (*LargeBinaryBuilder)(nil)
+)
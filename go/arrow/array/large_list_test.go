@@ -0,0 +1,83 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+func TestLargeListArray(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	var (
+		vs      = []int32{0, 1, 2, 3, 4, 5, 6}
+		lengths = []int{3, 0, 4}
+		isValid = []bool{true, false, true}
+		offsets = []int64{0, 3, 3, 7}
+	)
+
+	lb := array.NewLargeListBuilder(mem, arrow.PrimitiveTypes.Int32)
+	defer lb.Release()
+
+	vb := lb.ValueBuilder().(*array.Int32Builder)
+	pos := 0
+	for i, length := range lengths {
+		lb.Append(isValid[i])
+		for j := 0; j < length; j++ {
+			vb.Append(vs[pos])
+			pos++
+		}
+	}
+
+	arr := lb.NewArray().(*array.LargeList)
+	defer arr.Release()
+
+	if got, want := arr.DataType(), arrow.LargeListOf(arrow.PrimitiveTypes.Int32); !arrow.TypeEqual(got, want) {
+		t.Fatalf("invalid type: got=%v, want=%v", got, want)
+	}
+
+	if got, want := arr.NullN(), 1; got != want {
+		t.Fatalf("invalid nulls: got=%d, want=%d", got, want)
+	}
+
+	for i, v := range arr.Offsets() {
+		if v != offsets[i] {
+			t.Fatalf("invalid offset[%d]: got=%d, want=%d", i, v, offsets[i])
+		}
+	}
+
+	if got, want := arr.String(), "[[0 1 2] (null) [3 4 5 6]]"; got != want {
+		t.Fatalf("invalid string: got=%q, want=%q", got, want)
+	}
+
+	if err := array.ValidateFull(arr); err != nil {
+		t.Fatalf("invalid array: %v", err)
+	}
+
+	slice := array.NewSlice(arr, 2, 3)
+	defer slice.Release()
+
+	if got, want := fmt.Sprint(slice), "[[3 4 5 6]]"; got != want {
+		t.Fatalf("invalid slice: got=%q, want=%q", got, want)
+	}
+}
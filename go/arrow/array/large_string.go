@@ -0,0 +1,200 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array
+
+import (
+	"fmt"
+	"strings"
+	"unsafe"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+// LargeString represents an immutable sequence of variable-length UTF-8 strings,
+// using 64-bit offsets.
+type LargeString struct {
+	array
+	offsets []int64
+	values  string
+}
+
+// NewLargeStringData constructs a new LargeString array from data.
+func NewLargeStringData(data *Data) *LargeString {
+	a := &LargeString{}
+	a.refCount = 1
+	a.setData(data)
+	return a
+}
+
+// Reset resets the LargeString with a different set of Data.
+func (a *LargeString) Reset(data *Data) {
+	a.setData(data)
+}
+
+// Value returns the slice at index i. This value should not be mutated.
+func (a *LargeString) Value(i int) string {
+	i = i + a.array.data.offset
+	return a.values[a.offsets[i]:a.offsets[i+1]]
+}
+
+// ValueOffset returns the offset of the value at index i.
+func (a *LargeString) ValueOffset(i int) int64 { return a.offsets[a.array.data.offset+i] }
+
+func (a *LargeString) String() string {
+	o := new(strings.Builder)
+	o.WriteString("[")
+	for i := 0; i < a.Len(); i++ {
+		if i > 0 {
+			o.WriteString(" ")
+		}
+		switch {
+		case a.IsNull(i):
+			o.WriteString("(null)")
+		default:
+			fmt.Fprintf(o, "%q", a.Value(i))
+		}
+	}
+	o.WriteString("]")
+	return o.String()
+}
+
+func (a *LargeString) setData(data *Data) {
+	if len(data.buffers) != 3 {
+		panic("arrow/array: len(data.buffers) != 3")
+	}
+
+	a.array.setData(data)
+
+	if vdata := data.buffers[2]; vdata != nil {
+		b := vdata.Bytes()
+		a.values = *(*string)(unsafe.Pointer(&b))
+	}
+
+	if offsets := data.buffers[1]; offsets != nil {
+		a.offsets = arrow.Int64Traits.CastFromBytes(offsets.Bytes())
+	}
+}
+
+func arrayEqualLargeString(left, right *LargeString) bool {
+	for i := 0; i < left.Len(); i++ {
+		if left.IsNull(i) {
+			continue
+		}
+		if left.Value(i) != right.Value(i) {
+			return false
+		}
+	}
+	return true
+}
+
+// A LargeStringBuilder is used to build a LargeString array using the Append methods.
+type LargeStringBuilder struct {
+	builder *LargeBinaryBuilder
+}
+
+// NewLargeStringBuilder creates a new LargeStringBuilder.
+func NewLargeStringBuilder(mem memory.Allocator) *LargeStringBuilder {
+	b := &LargeStringBuilder{
+		builder: NewLargeBinaryBuilder(mem, arrow.BinaryTypes.LargeString),
+	}
+	return b
+}
+
+// Release decreases the reference count by 1.
+// When the reference count goes to zero, the memory is freed.
+// Release may be called simultaneously from multiple goroutines.
+func (b *LargeStringBuilder) Release() {
+	b.builder.Release()
+}
+
+// Retain increases the reference count by 1.
+// Retain may be called simultaneously from multiple goroutines.
+func (b *LargeStringBuilder) Retain() {
+	b.builder.Retain()
+}
+
+// Len returns the number of elements in the array builder.
+func (b *LargeStringBuilder) Len() int { return b.builder.Len() }
+
+// Cap returns the total number of elements that can be stored without allocating additional memory.
+func (b *LargeStringBuilder) Cap() int { return b.builder.Cap() }
+
+// NullN returns the number of null values in the array builder.
+func (b *LargeStringBuilder) NullN() int { return b.builder.NullN() }
+
+// Append appends a string to the builder.
+func (b *LargeStringBuilder) Append(v string) {
+	b.builder.Append([]byte(v))
+}
+
+// AppendNull appends a null to the builder.
+func (b *LargeStringBuilder) AppendNull() {
+	b.builder.AppendNull()
+}
+
+// AppendValues will append the values in the v slice. The valid slice determines which values
+// in v are valid (not null). The valid slice must either be empty or be equal in length to v. If empty,
+// all values in v are appended and considered valid.
+func (b *LargeStringBuilder) AppendValues(v []string, valid []bool) {
+	b.builder.AppendStringValues(v, valid)
+}
+
+// Value returns the string at index i.
+func (b *LargeStringBuilder) Value(i int) string {
+	return string(b.builder.Value(i))
+}
+
+func (b *LargeStringBuilder) init(capacity int) {
+	b.builder.init(capacity)
+}
+
+func (b *LargeStringBuilder) resize(newBits int, init func(int)) {
+	b.builder.resize(newBits, init)
+}
+
+// Reserve ensures there is enough space for appending n elements
+// by checking the capacity and calling Resize if necessary.
+func (b *LargeStringBuilder) Reserve(n int) {
+	b.builder.Reserve(n)
+}
+
+// Resize adjusts the space allocated by b to n elements. If n is greater than b.Cap(),
+// additional memory will be allocated. If n is smaller, the allocated memory may reduced.
+func (b *LargeStringBuilder) Resize(n int) {
+	b.builder.Resize(n)
+}
+
+// NewArray creates a LargeString array from the memory buffers used by the builder and resets the LargeStringBuilder
+// so it can be used to build a new array.
+func (b *LargeStringBuilder) NewArray() Interface {
+	return b.NewLargeStringArray()
+}
+
+// NewLargeStringArray creates a LargeString array from the memory buffers used by the builder and resets the LargeStringBuilder
+// so it can be used to build a new array.
+func (b *LargeStringBuilder) NewLargeStringArray() (a *LargeString) {
+	data := b.builder.newData()
+	a = NewLargeStringData(data)
+	data.Release()
+	return
+}
+
+var (
+	_ Interface = (*LargeString)(nil)
+	_ Builder   = (*LargeStringBuilder)(nil)
+)
@@ -0,0 +1,122 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array_test
+
+import (
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+func TestLargeStringArray(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	var (
+		want    = []string{"hello", "世界", "", "bye"}
+		valids  = []bool{true, true, false, true}
+		offsets = []int64{0, 5, 11, 11, 14}
+	)
+
+	sb := array.NewLargeStringBuilder(mem)
+	defer sb.Release()
+
+	sb.AppendValues(want[:2], nil)
+	sb.AppendNull()
+	sb.Append(want[3])
+
+	if got, want := sb.Len(), len(want); got != want {
+		t.Fatalf("invalid len: got=%d, want=%d", got, want)
+	}
+
+	arr := sb.NewLargeStringArray()
+	defer arr.Release()
+
+	if got, want := arr.DataType().ID(), arrow.LARGE_STRING; got != want {
+		t.Fatalf("invalid type: got=%v, want=%v", got, want)
+	}
+
+	if got, want := arr.NullN(), 1; got != want {
+		t.Fatalf("invalid nulls: got=%d, want=%d", got, want)
+	}
+
+	for i := range want {
+		if arr.IsNull(i) != !valids[i] {
+			t.Fatalf("arr[%d]-validity: got=%v want=%v", i, !arr.IsNull(i), valids[i])
+		}
+		if got := arr.Value(i); valids[i] && got != want[i] {
+			t.Fatalf("arr[%d]: got=%q, want=%q", i, got, want[i])
+		}
+		if got, want := arr.ValueOffset(i), offsets[i]; got != want {
+			t.Fatalf("arr-offset-beg[%d]: got=%d, want=%d", i, got, want)
+		}
+	}
+
+	if got, want := arr.String(), `["hello" "世界" (null) "bye"]`; got != want {
+		t.Fatalf("invalid string: got=%q, want=%q", got, want)
+	}
+
+	slice := array.NewSlice(arr, 1, 4).(*array.LargeString)
+	defer slice.Release()
+
+	if got, want := slice.Value(0), "世界"; got != want {
+		t.Fatalf("invalid slice value: got=%q, want=%q", got, want)
+	}
+	if got, want := slice.ValueOffset(2), offsets[3]; got != want {
+		t.Fatalf("invalid slice offset: got=%d, want=%d", got, want)
+	}
+	if err := array.ValidateFull(slice); err != nil {
+		t.Fatalf("invalid slice: %v", err)
+	}
+}
+
+func TestLargeBinaryArray(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	b := array.NewLargeBinaryBuilder(mem, arrow.BinaryTypes.LargeBinary)
+	defer b.Release()
+
+	b.AppendValues([][]byte{[]byte("AAA"), nil, []byte("BBBB")}, []bool{true, false, true})
+	b.AppendString("C")
+
+	arr := b.NewLargeBinaryArray()
+	defer arr.Release()
+
+	if got, want := arr.Len(), 4; got != want {
+		t.Fatalf("invalid len: got=%d, want=%d", got, want)
+	}
+
+	if got, want := arr.String(), `["AAA" (null) "BBBB" "C"]`; got != want {
+		t.Fatalf("invalid string: got=%q, want=%q", got, want)
+	}
+
+	slice := array.NewSlice(arr, 2, 4).(*array.LargeBinary)
+	defer slice.Release()
+
+	if got, want := string(slice.ValueBytes()), "BBBBC"; got != want {
+		t.Fatalf("invalid value bytes: got=%q, want=%q", got, want)
+	}
+	if got, want := slice.ValueOffsets(), []int64{3, 7, 8}; len(got) != len(want) || got[0] != want[0] || got[2] != want[2] {
+		t.Fatalf("invalid value offsets: got=%v, want=%v", got, want)
+	}
+	if got, want := slice.ValueLen(0), 4; got != want {
+		t.Fatalf("invalid value len: got=%d, want=%d", got, want)
+	}
+}
@@ -0,0 +1,73 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array
+
+import (
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/memory"
+	"golang.org/x/xerrors"
+)
+
+// ProjectRecord conforms rec to schema: columns are matched by name and
+// reordered to follow the fields of schema, columns of rec missing from
+// schema are dropped and fields of schema missing from rec are filled with
+// nulls. Columns whose type differs from the one of schema are cast, see Cast.
+//
+// ProjectRecord returns an error if a field of schema is missing from rec
+// and is not nullable, if a column holding nulls is projected onto a field
+// that is not nullable, or if a column cannot be cast.
+//
+// The returned record must be Release()'d after use.
+func ProjectRecord(rec Record, schema *arrow.Schema, mem memory.Allocator) (Record, error) {
+	cols := make([]Interface, len(schema.Fields()))
+	defer func() {
+		for _, col := range cols {
+			if col != nil {
+				col.Release()
+			}
+		}
+	}()
+
+	rows := int(rec.NumRows())
+	for i, field := range schema.Fields() {
+		idx := rec.Schema().FieldIndices(field.Name)
+		if len(idx) > 1 {
+			return nil, xerrors.Errorf("arrow/array: ambiguous column name %q", field.Name)
+		}
+		if len(idx) == 0 {
+			if !field.Nullable {
+				return nil, xerrors.Errorf("arrow/array: missing non-nullable column %q", field.Name)
+			}
+			data := makeNullData(field.Type, rows, mem)
+			cols[i] = MakeFromData(data)
+			data.Release()
+			continue
+		}
+
+		col := rec.Column(idx[0])
+		if !field.Nullable && col.NullN() > 0 {
+			return nil, xerrors.Errorf("arrow/array: column %q holds nulls but field is not nullable", field.Name)
+		}
+		arr, err := Cast(col, field.Type, mem)
+		if err != nil {
+			return nil, xerrors.Errorf("arrow/array: could not project column %q: %w", field.Name, err)
+		}
+		cols[i] = arr
+	}
+
+	return NewRecord(schema, cols, rec.NumRows()), nil
+}
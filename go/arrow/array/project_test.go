@@ -0,0 +1,87 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+func TestProjectRecord(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	schema := arrow.NewSchema([]arrow.Field{
+		{Name: "i32", Type: arrow.PrimitiveTypes.Int32},
+		{Name: "str", Type: arrow.BinaryTypes.String, Nullable: true},
+		{Name: "dropped", Type: arrow.FixedWidthTypes.Boolean},
+	}, nil)
+
+	b := array.NewRecordBuilder(mem, schema)
+	defer b.Release()
+
+	b.Field(0).(*array.Int32Builder).AppendValues([]int32{1, 2, 3}, nil)
+	b.Field(1).(*array.StringBuilder).AppendValues([]string{"a", "", "c"}, []bool{true, false, true})
+	b.Field(2).(*array.BooleanBuilder).AppendValues([]bool{true, false, true}, nil)
+
+	rec := b.NewRecord()
+	defer rec.Release()
+
+	target := arrow.NewSchema([]arrow.Field{
+		{Name: "str", Type: arrow.BinaryTypes.LargeString, Nullable: true},
+		{Name: "added", Type: arrow.PrimitiveTypes.Float64, Nullable: true},
+		{Name: "i32", Type: arrow.PrimitiveTypes.Int64},
+	}, nil)
+
+	out, err := array.ProjectRecord(rec, target, mem)
+	if err != nil {
+		t.Fatalf("could not project record: %v", err)
+	}
+	defer out.Release()
+
+	if err := array.ValidateRecordFull(out); err != nil {
+		t.Fatalf("invalid record: %v", err)
+	}
+	if !out.Schema().Equal(target) {
+		t.Fatalf("invalid schema: got=%v, want=%v", out.Schema(), target)
+	}
+	for i, want := range []string{
+		`["a" (null) "c"]`,
+		"[(null) (null) (null)]",
+		"[1 2 3]",
+	} {
+		if got := fmt.Sprint(out.Column(i)); got != want {
+			t.Fatalf("invalid column %q: got=%s, want=%s", out.ColumnName(i), got, want)
+		}
+	}
+
+	for _, fields := range [][]arrow.Field{
+		{{Name: "missing", Type: arrow.PrimitiveTypes.Int32}},
+		{{Name: "str", Type: arrow.BinaryTypes.String}},
+		{{Name: "i32", Type: arrow.PrimitiveTypes.Int8}},
+	} {
+		out, err := array.ProjectRecord(rec, arrow.NewSchema(fields, nil), mem)
+		if err == nil {
+			out.Release()
+			t.Fatalf("expected an error projecting onto %v", fields)
+		}
+	}
+}
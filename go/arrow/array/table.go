@@ -20,10 +20,12 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"sort"
 	"sync/atomic"
 
 	"github.com/apache/arrow/go/arrow"
 	"github.com/apache/arrow/go/arrow/internal/debug"
+	"golang.org/x/xerrors"
 )
 
 // Table represents a logical sequence of chunked arrays.
@@ -312,6 +314,263 @@ func (tbl *simpleTable) Release() {
 	}
 }
 
+// NewTableFromColumns returns a new basic, non-lazy in-memory table made of
+// the provided columns. The schema of the table is built from the fields of
+// the columns.
+//
+// NewTableFromColumns panics if the columns do not all have the same length.
+func NewTableFromColumns(cols []*Column) *simpleTable {
+	fields := make([]arrow.Field, len(cols))
+	for i, col := range cols {
+		if col.Len() != cols[0].Len() {
+			panic(fmt.Errorf("arrow/array: column %q has length %d, want %d", col.Name(), col.Len(), cols[0].Len()))
+		}
+		fields[i] = col.Field()
+	}
+	return newTableFrom(arrow.NewSchema(fields, nil), cols, -1)
+}
+
+// NewTableFromMap returns a new basic, non-lazy in-memory table made of the
+// provided chunked arrays. Columns are sorted by name and their fields are
+// nullable.
+//
+// NewTableFromMap panics if the chunked arrays do not all have the same length.
+func NewTableFromMap(chunks map[string]*Chunked) *simpleTable {
+	names := make([]string, 0, len(chunks))
+	for name := range chunks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	cols := make([]*Column, len(names))
+	defer func() {
+		for _, col := range cols {
+			if col != nil {
+				col.Release()
+			}
+		}
+	}()
+	for i, name := range names {
+		chunk := chunks[name]
+		cols[i] = NewColumn(arrow.Field{Name: name, Type: chunk.DataType(), Nullable: true}, chunk)
+	}
+
+	return NewTableFromColumns(cols)
+}
+
+// SelectColumns returns a new table holding the columns of tbl with the
+// provided names, in that order. Column data is shared with tbl.
+//
+// SelectColumns returns an error if a name does not match exactly one column.
+func SelectColumns(tbl Table, names ...string) (Table, error) {
+	cols := make([]*Column, len(names))
+	for i, name := range names {
+		idx := tbl.Schema().FieldIndices(name)
+		switch len(idx) {
+		case 0:
+			return nil, xerrors.Errorf("arrow/array: no column named %q", name)
+		case 1:
+			cols[i] = tbl.Column(idx[0])
+		default:
+			return nil, xerrors.Errorf("arrow/array: ambiguous column name %q", name)
+		}
+	}
+	return newTableFrom(tableSchema(tbl, cols), cols, tbl.NumRows()), nil
+}
+
+// AddColumn returns a new table with col appended to the columns of tbl.
+// Column data is shared with tbl.
+func AddColumn(tbl Table, col *Column) (Table, error) {
+	return InsertColumn(tbl, int(tbl.NumCols()), col)
+}
+
+// InsertColumn returns a new table with col inserted at index i in the
+// columns of tbl. Column data is shared with tbl.
+//
+// InsertColumn returns an error if i is out of range or if the length of col
+// differs from the number of rows of tbl.
+func InsertColumn(tbl Table, i int, col *Column) (Table, error) {
+	if i < 0 || i > int(tbl.NumCols()) {
+		return nil, xerrors.Errorf("arrow/array: column index %d out of range [0, %d]", i, tbl.NumCols())
+	}
+	if err := checkColumnLen(tbl, col); err != nil {
+		return nil, err
+	}
+
+	cols := tableColumns(tbl)
+	cols = append(cols[:i], append([]*Column{col}, cols[i:]...)...)
+	return newTableFrom(tableSchema(tbl, cols), cols, tbl.NumRows()), nil
+}
+
+// RemoveColumn returns a new table without the column at index i.
+// Column data is shared with tbl.
+func RemoveColumn(tbl Table, i int) (Table, error) {
+	if i < 0 || i >= int(tbl.NumCols()) {
+		return nil, xerrors.Errorf("arrow/array: column index %d out of range [0, %d)", i, tbl.NumCols())
+	}
+
+	cols := tableColumns(tbl)
+	cols = append(cols[:i], cols[i+1:]...)
+	return newTableFrom(tableSchema(tbl, cols), cols, tbl.NumRows()), nil
+}
+
+// ReplaceColumn returns a new table with the column at index i replaced
+// by col. Column data is shared with tbl.
+//
+// ReplaceColumn returns an error if i is out of range or if the length of col
+// differs from the number of rows of tbl.
+func ReplaceColumn(tbl Table, i int, col *Column) (Table, error) {
+	if i < 0 || i >= int(tbl.NumCols()) {
+		return nil, xerrors.Errorf("arrow/array: column index %d out of range [0, %d)", i, tbl.NumCols())
+	}
+	if err := checkColumnLen(tbl, col); err != nil {
+		return nil, err
+	}
+
+	cols := tableColumns(tbl)
+	cols[i] = col
+	return newTableFrom(tableSchema(tbl, cols), cols, tbl.NumRows()), nil
+}
+
+// RenameColumns returns a new table whose columns are named after names.
+// Column data is shared with tbl.
+//
+// RenameColumns returns an error if the number of names differs from the
+// number of columns of tbl.
+func RenameColumns(tbl Table, names []string) (Table, error) {
+	if len(names) != int(tbl.NumCols()) {
+		return nil, xerrors.Errorf("arrow/array: got %d names for %d columns", len(names), tbl.NumCols())
+	}
+
+	cols := make([]*Column, len(names))
+	defer func() {
+		for _, col := range cols {
+			if col != nil {
+				col.Release()
+			}
+		}
+	}()
+	for i, name := range names {
+		col := tbl.Column(i)
+		field := col.Field()
+		field.Name = name
+		cols[i] = NewColumn(field, col.Data())
+	}
+	return newTableFrom(tableSchema(tbl, cols), cols, tbl.NumRows()), nil
+}
+
+// ConcatenateTables returns a new table holding the rows of all the tables,
+// in order. The chunks of the tables are shared, not copied.
+//
+// ConcatenateTables returns an error if tbls is empty or if the tables do not
+// all have the same schema.
+func ConcatenateTables(tbls []Table) (Table, error) {
+	if len(tbls) == 0 {
+		return nil, xerrors.Errorf("arrow/array: no tables to concatenate")
+	}
+
+	schema := tbls[0].Schema()
+	for _, tbl := range tbls[1:] {
+		if !tbl.Schema().Equal(schema) {
+			return nil, xerrors.Errorf("arrow/array: tables to concatenate have different schemas")
+		}
+	}
+
+	var (
+		rows int64
+		cols = make([]*Column, len(schema.Fields()))
+	)
+	defer func() {
+		for _, col := range cols {
+			if col != nil {
+				col.Release()
+			}
+		}
+	}()
+
+	for _, tbl := range tbls {
+		rows += tbl.NumRows()
+	}
+	for i := range cols {
+		var chunks []Interface
+		for _, tbl := range tbls {
+			data := tbl.Column(i).Data()
+			if int64(data.Len()) != tbl.NumRows() {
+				// columns may be longer than their table.
+				data = data.NewSlice(0, tbl.NumRows())
+				defer data.Release()
+			}
+			chunks = append(chunks, data.Chunks()...)
+		}
+		data := NewChunked(schema.Field(i).Type, chunks)
+		cols[i] = NewColumn(schema.Field(i), data)
+		data.Release()
+	}
+
+	return newTableFrom(schema, cols, rows), nil
+}
+
+// NewTableSlice returns a new zero-copy slice of the table with the indicated
+// indices i and j, corresponding to the rows [i:j] of the table.
+// The returned table must be Release()'d after use.
+//
+// NewTableSlice panics if the slice is outside the valid range of the table.
+// NewTableSlice panics if j < i.
+func NewTableSlice(tbl Table, i, j int64) Table {
+	if j > tbl.NumRows() || i > j || i < 0 {
+		panic("arrow/array: index out of range")
+	}
+
+	cols := make([]*Column, tbl.NumCols())
+	defer func() {
+		for _, col := range cols {
+			if col != nil {
+				col.Release()
+			}
+		}
+	}()
+	for k := range cols {
+		cols[k] = tbl.Column(k).NewSlice(i, j)
+	}
+	return newTableFrom(tbl.Schema(), cols, j-i)
+}
+
+// newTableFrom returns a new table sharing the data of the provided columns.
+func newTableFrom(schema *arrow.Schema, cols []*Column, rows int64) *simpleTable {
+	tcols := make([]Column, len(cols))
+	for i, col := range cols {
+		tcols[i] = *col
+	}
+	return NewTable(schema, tcols, rows)
+}
+
+// tableColumns returns a fresh slice holding the columns of tbl.
+func tableColumns(tbl Table) []*Column {
+	cols := make([]*Column, tbl.NumCols())
+	for i := range cols {
+		cols[i] = tbl.Column(i)
+	}
+	return cols
+}
+
+// tableSchema returns the schema made of the fields of cols, with the
+// metadata of tbl.
+func tableSchema(tbl Table, cols []*Column) *arrow.Schema {
+	fields := make([]arrow.Field, len(cols))
+	for i, col := range cols {
+		fields[i] = col.Field()
+	}
+	md := tbl.Schema().Metadata()
+	return arrow.NewSchema(fields, &md)
+}
+
+func checkColumnLen(tbl Table, col *Column) error {
+	if int64(col.Len()) != tbl.NumRows() {
+		return xerrors.Errorf("arrow/array: column %q has length %d, want %d", col.Name(), col.Len(), tbl.NumRows())
+	}
+	return nil
+}
+
 // TableReader is a Record iterator over a (possibly chunked) Table
 type TableReader struct {
 	refCount int64
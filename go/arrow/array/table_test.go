@@ -745,3 +745,297 @@ func TestTableReader(t *testing.T) {
 		})
 	}
 }
+
+func TestTableColumnOps(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	md := arrow.NewMetadata([]string{"k"}, []string{"v"})
+	schema := arrow.NewSchema(
+		[]arrow.Field{
+			{Name: "i32", Type: arrow.PrimitiveTypes.Int32},
+			{Name: "f64", Type: arrow.PrimitiveTypes.Float64},
+		},
+		&md,
+	)
+
+	b := array.NewRecordBuilder(mem, schema)
+	defer b.Release()
+
+	b.Field(0).(*array.Int32Builder).AppendValues([]int32{1, 2, 3}, nil)
+	b.Field(1).(*array.Float64Builder).AppendValues([]float64{1.5, 2.5, 3.5}, nil)
+	rec := b.NewRecord()
+	defer rec.Release()
+
+	tbl := array.NewTableFromRecords(schema, []array.Record{rec})
+	defer tbl.Release()
+
+	sb := array.NewStringBuilder(mem)
+	defer sb.Release()
+	sb.AppendValues([]string{"a", "b", "c"}, nil)
+	str := sb.NewStringArray()
+	defer str.Release()
+
+	chunk := array.NewChunked(arrow.BinaryTypes.String, []array.Interface{str})
+	defer chunk.Release()
+	col := array.NewColumn(arrow.Field{Name: "str", Type: arrow.BinaryTypes.String}, chunk)
+	defer col.Release()
+
+	for _, tc := range []struct {
+		name  string
+		op    func() (array.Table, error)
+		names []string
+		want  []string
+	}{
+		{
+			name:  "select",
+			op:    func() (array.Table, error) { return array.SelectColumns(tbl, "f64", "i32") },
+			names: []string{"f64", "i32"},
+			want:  []string{"[1.5 2.5 3.5]", "[1 2 3]"},
+		},
+		{
+			name:  "add",
+			op:    func() (array.Table, error) { return array.AddColumn(tbl, col) },
+			names: []string{"i32", "f64", "str"},
+			want:  []string{"[1 2 3]", "[1.5 2.5 3.5]", `["a" "b" "c"]`},
+		},
+		{
+			name:  "insert",
+			op:    func() (array.Table, error) { return array.InsertColumn(tbl, 0, col) },
+			names: []string{"str", "i32", "f64"},
+			want:  []string{`["a" "b" "c"]`, "[1 2 3]", "[1.5 2.5 3.5]"},
+		},
+		{
+			name:  "remove",
+			op:    func() (array.Table, error) { return array.RemoveColumn(tbl, 0) },
+			names: []string{"f64"},
+			want:  []string{"[1.5 2.5 3.5]"},
+		},
+		{
+			name:  "replace",
+			op:    func() (array.Table, error) { return array.ReplaceColumn(tbl, 1, col) },
+			names: []string{"i32", "str"},
+			want:  []string{"[1 2 3]", `["a" "b" "c"]`},
+		},
+		{
+			name:  "rename",
+			op:    func() (array.Table, error) { return array.RenameColumns(tbl, []string{"a", "b"}) },
+			names: []string{"a", "b"},
+			want:  []string{"[1 2 3]", "[1.5 2.5 3.5]"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			out, err := tc.op()
+			if err != nil {
+				t.Fatalf("could not apply operation: %v", err)
+			}
+			defer out.Release()
+
+			if err := array.ValidateTable(out); err != nil {
+				t.Fatalf("invalid table: %v", err)
+			}
+			if got, want := out.NumRows(), int64(3); got != want {
+				t.Fatalf("invalid number of rows: got=%d, want=%d", got, want)
+			}
+			if got, want := out.Schema().Metadata(), md; !reflect.DeepEqual(got, want) {
+				t.Fatalf("invalid metadata: got=%v, want=%v", got, want)
+			}
+			if got, want := int(out.NumCols()), len(tc.names); got != want {
+				t.Fatalf("invalid number of columns: got=%d, want=%d", got, want)
+			}
+			for i, name := range tc.names {
+				col := out.Column(i)
+				if got, want := col.Name(), name; got != want {
+					t.Fatalf("invalid column name %d: got=%q, want=%q", i, got, want)
+				}
+				if got, want := fmt.Sprint(col.Data().Chunk(0)), tc.want[i]; got != want {
+					t.Fatalf("invalid column %q: got=%s, want=%s", name, got, want)
+				}
+			}
+		})
+	}
+
+	short := col.NewSlice(0, 2)
+	defer short.Release()
+
+	for _, tc := range []struct {
+		name string
+		op   func() (array.Table, error)
+	}{
+		{"select-missing", func() (array.Table, error) { return array.SelectColumns(tbl, "missing") }},
+		{"insert-out-of-range", func() (array.Table, error) { return array.InsertColumn(tbl, 3, col) }},
+		{"insert-length", func() (array.Table, error) { return array.InsertColumn(tbl, 0, short) }},
+		{"remove-out-of-range", func() (array.Table, error) { return array.RemoveColumn(tbl, 2) }},
+		{"replace-out-of-range", func() (array.Table, error) { return array.ReplaceColumn(tbl, -1, col) }},
+		{"replace-length", func() (array.Table, error) { return array.ReplaceColumn(tbl, 0, short) }},
+		{"rename-count", func() (array.Table, error) { return array.RenameColumns(tbl, []string{"a"}) }},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			out, err := tc.op()
+			if err == nil {
+				out.Release()
+				t.Fatalf("expected an error")
+			}
+		})
+	}
+}
+
+func TestConcatenateTables(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	schema := arrow.NewSchema(
+		[]arrow.Field{{Name: "i32", Type: arrow.PrimitiveTypes.Int32}},
+		nil,
+	)
+
+	b := array.NewRecordBuilder(mem, schema)
+	defer b.Release()
+
+	b.Field(0).(*array.Int32Builder).AppendValues([]int32{1, 2, 3}, nil)
+	rec1 := b.NewRecord()
+	defer rec1.Release()
+
+	b.Field(0).(*array.Int32Builder).AppendValues([]int32{4, 5}, nil)
+	rec2 := b.NewRecord()
+	defer rec2.Release()
+
+	tbl1 := array.NewTableFromRecords(schema, []array.Record{rec1, rec2})
+	defer tbl1.Release()
+
+	// a table whose column is longer than the table itself.
+	tbl2 := array.NewTable(schema, []array.Column{*tbl1.Column(0)}, 2)
+	defer tbl2.Release()
+
+	tbl, err := array.ConcatenateTables([]array.Table{tbl1, tbl2})
+	if err != nil {
+		t.Fatalf("could not concatenate tables: %v", err)
+	}
+	defer tbl.Release()
+
+	if got, want := tbl.NumRows(), int64(7); got != want {
+		t.Fatalf("invalid number of rows: got=%d, want=%d", got, want)
+	}
+
+	rec, err := array.NewRecordFromTable(tbl, mem)
+	if err != nil {
+		t.Fatalf("could not create record: %v", err)
+	}
+	defer rec.Release()
+
+	if got, want := fmt.Sprint(rec.Column(0)), "[1 2 3 4 5 1 2]"; got != want {
+		t.Fatalf("invalid column: got=%s, want=%s", got, want)
+	}
+
+	tbl3, err := array.RenameColumns(tbl1, []string{"other"})
+	if err != nil {
+		t.Fatalf("could not rename columns: %v", err)
+	}
+	defer tbl3.Release()
+
+	for _, tbls := range [][]array.Table{nil, {tbl1, tbl3}} {
+		out, err := array.ConcatenateTables(tbls)
+		if err == nil {
+			out.Release()
+			t.Fatalf("expected an error")
+		}
+	}
+}
+
+func TestNewTableSlice(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	schema := arrow.NewSchema(
+		[]arrow.Field{{Name: "i32", Type: arrow.PrimitiveTypes.Int32}},
+		nil,
+	)
+
+	b := array.NewRecordBuilder(mem, schema)
+	defer b.Release()
+
+	b.Field(0).(*array.Int32Builder).AppendValues([]int32{1, 2, 3}, nil)
+	rec1 := b.NewRecord()
+	defer rec1.Release()
+
+	b.Field(0).(*array.Int32Builder).AppendValues([]int32{4, 5}, nil)
+	rec2 := b.NewRecord()
+	defer rec2.Release()
+
+	tbl := array.NewTableFromRecords(schema, []array.Record{rec1, rec2})
+	defer tbl.Release()
+
+	slice := array.NewTableSlice(tbl, 2, 4)
+	defer slice.Release()
+
+	if got, want := slice.NumRows(), int64(2); got != want {
+		t.Fatalf("invalid number of rows: got=%d, want=%d", got, want)
+	}
+
+	rec, err := array.NewRecordFromTable(slice, mem)
+	if err != nil {
+		t.Fatalf("could not create record: %v", err)
+	}
+	defer rec.Release()
+
+	if got, want := fmt.Sprint(rec.Column(0)), "[3 4]"; got != want {
+		t.Fatalf("invalid column: got=%s, want=%s", got, want)
+	}
+
+	defer func() {
+		if e := recover(); e == nil {
+			t.Fatalf("test should have panicked but did not")
+		}
+	}()
+	_ = array.NewTableSlice(tbl, 3, 6)
+}
+
+func TestNewTableFromColumns(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	ib := array.NewInt32Builder(mem)
+	defer ib.Release()
+	ib.AppendValues([]int32{1, 2, 3}, nil)
+	i32 := ib.NewInt32Array()
+	defer i32.Release()
+
+	sb := array.NewStringBuilder(mem)
+	defer sb.Release()
+	sb.AppendValues([]string{"a", "b", "c"}, nil)
+	str := sb.NewStringArray()
+	defer str.Release()
+
+	c1 := array.NewChunked(arrow.PrimitiveTypes.Int32, []array.Interface{i32})
+	defer c1.Release()
+	c2 := array.NewChunked(arrow.BinaryTypes.String, []array.Interface{str})
+	defer c2.Release()
+
+	tbl := array.NewTableFromMap(map[string]*array.Chunked{"s": c2, "i": c1})
+	defer tbl.Release()
+
+	want := arrow.NewSchema(
+		[]arrow.Field{
+			{Name: "i", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
+			{Name: "s", Type: arrow.BinaryTypes.String, Nullable: true},
+		},
+		nil,
+	)
+	if got := tbl.Schema(); !got.Equal(want) {
+		t.Fatalf("invalid schema: got=%v, want=%v", got, want)
+	}
+	if got, want := tbl.NumRows(), int64(3); got != want {
+		t.Fatalf("invalid number of rows: got=%d, want=%d", got, want)
+	}
+
+	short := c1.NewSlice(0, 2)
+	defer short.Release()
+
+	defer func() {
+		if e := recover(); e == nil {
+			t.Fatalf("test should have panicked but did not")
+		}
+	}()
+	tbl2 := array.NewTableFromMap(map[string]*array.Chunked{"s": c2, "i": short})
+	tbl2.Release()
+}
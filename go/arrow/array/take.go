@@ -0,0 +1,269 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array
+
+import (
+	"math"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/bitutil"
+	"github.com/apache/arrow/go/arrow/memory"
+	"golang.org/x/xerrors"
+)
+
+// Take returns a new array holding the values of arr at the provided indices,
+// in order. Indices may be repeated.
+//
+// Take returns an error if an index is out of range, or if the data type of
+// arr is not supported.
+//
+// The returned array must be Release()'d after use.
+func Take(arr Interface, indices []int, mem memory.Allocator) (Interface, error) {
+	data, err := takeData(arr.Data(), indices, mem)
+	if err != nil {
+		return nil, err
+	}
+	defer data.Release()
+	return MakeFromData(data), nil
+}
+
+// TakeRecord returns a new record holding the rows of rec at the provided
+// indices, in order. Indices may be repeated.
+//
+// The returned record must be Release()'d after use.
+func TakeRecord(rec Record, indices []int, mem memory.Allocator) (Record, error) {
+	cols := make([]Interface, rec.NumCols())
+	defer func() {
+		for _, col := range cols {
+			if col != nil {
+				col.Release()
+			}
+		}
+	}()
+
+	for i := range cols {
+		col, err := Take(rec.Column(i), indices, mem)
+		if err != nil {
+			return nil, xerrors.Errorf("arrow/array: could not take column %q: %w", rec.ColumnName(i), err)
+		}
+		cols[i] = col
+	}
+	return NewRecord(rec.Schema(), cols, int64(len(indices))), nil
+}
+
+// takeData gathers the values of d at the provided logical indices.
+func takeData(d *Data, indices []int, mem memory.Allocator) (*Data, error) {
+	for _, i := range indices {
+		if i < 0 || i >= d.length {
+			return nil, xerrors.Errorf("arrow/array: index %d out of range [0, %d)", i, d.length)
+		}
+	}
+
+	var (
+		n       = len(indices)
+		nulls   = 0
+		buffers = make([]*memory.Buffer, len(d.buffers))
+	)
+	defer func() {
+		for _, buf := range buffers {
+			if buf != nil {
+				buf.Release()
+			}
+		}
+	}()
+
+	if d.dtype.ID() == arrow.NULL {
+		return NewData(d.dtype, n, buffers, nil, n, 0), nil
+	}
+	if n == 0 {
+		return makeEmptyData(d.dtype, mem), nil
+	}
+
+	if len(d.buffers) > 0 && d.buffers[0] != nil && d.NullN() > 0 {
+		src := d.buffers[0].Bytes()
+		buffers[0] = newBuffer(mem, int(bitutil.BytesForBits(int64(n))))
+		dst := buffers[0].Bytes()
+		for j, i := range indices {
+			valid := bitutil.BitIsSet(src, d.offset+i)
+			bitutil.SetBitTo(dst, j, valid)
+			if !valid {
+				nulls++
+			}
+		}
+	}
+
+	var children []*Data
+	switch dt := d.dtype.(type) {
+	case *arrow.BooleanType:
+		src := d.buffers[1].Bytes()
+		buffers[1] = newBuffer(mem, int(bitutil.BytesForBits(int64(n))))
+		dst := buffers[1].Bytes()
+		for j, i := range indices {
+			bitutil.SetBitTo(dst, j, bitutil.BitIsSet(src, d.offset+i))
+		}
+
+	case *arrow.BinaryType, *arrow.StringType:
+		offsets := arrow.Int32Traits.CastFromBytes(d.buffers[1].Bytes())
+		buffers[1] = newBuffer(mem, arrow.Int32Traits.BytesRequired(n+1))
+		dst := arrow.Int32Traits.CastFromBytes(buffers[1].Bytes())
+		var size int
+		for j, i := range indices {
+			dst[j] = int32(size)
+			size += int(offsets[d.offset+i+1] - offsets[d.offset+i])
+			if size > math.MaxInt32 {
+				return nil, xerrors.Errorf("arrow/array: taken values overflow int32 offsets")
+			}
+		}
+		dst[n] = int32(size)
+		buffers[2] = newBuffer(mem, size)
+		out := buffers[2].Bytes()
+		for j, i := range indices {
+			if dst[j] != dst[j+1] {
+				copy(out[dst[j]:dst[j+1]], d.buffers[2].Bytes()[offsets[d.offset+i]:offsets[d.offset+i+1]])
+			}
+		}
+
+	case *arrow.LargeBinaryType, *arrow.LargeStringType:
+		offsets := arrow.Int64Traits.CastFromBytes(d.buffers[1].Bytes())
+		buffers[1] = newBuffer(mem, arrow.Int64Traits.BytesRequired(n+1))
+		dst := arrow.Int64Traits.CastFromBytes(buffers[1].Bytes())
+		var size int64
+		for j, i := range indices {
+			dst[j] = size
+			size += offsets[d.offset+i+1] - offsets[d.offset+i]
+		}
+		dst[n] = size
+		buffers[2] = newBuffer(mem, int(size))
+		out := buffers[2].Bytes()
+		for j, i := range indices {
+			if dst[j] != dst[j+1] {
+				copy(out[dst[j]:dst[j+1]], d.buffers[2].Bytes()[offsets[d.offset+i]:offsets[d.offset+i+1]])
+			}
+		}
+
+	case *arrow.ListType:
+		offsets := arrow.Int32Traits.CastFromBytes(d.buffers[1].Bytes())
+		buffers[1] = newBuffer(mem, arrow.Int32Traits.BytesRequired(n+1))
+		dst := arrow.Int32Traits.CastFromBytes(buffers[1].Bytes())
+		var sub []int
+		for j, i := range indices {
+			dst[j] = int32(len(sub))
+			for k := offsets[d.offset+i]; k < offsets[d.offset+i+1]; k++ {
+				sub = append(sub, int(k))
+			}
+			if len(sub) > math.MaxInt32 {
+				return nil, xerrors.Errorf("arrow/array: taken values overflow int32 offsets")
+			}
+		}
+		dst[n] = int32(len(sub))
+		child, err := takeData(d.childData[0], sub, mem)
+		if err != nil {
+			return nil, err
+		}
+		defer child.Release()
+		children = []*Data{child}
+
+	case *arrow.LargeListType:
+		offsets := arrow.Int64Traits.CastFromBytes(d.buffers[1].Bytes())
+		buffers[1] = newBuffer(mem, arrow.Int64Traits.BytesRequired(n+1))
+		dst := arrow.Int64Traits.CastFromBytes(buffers[1].Bytes())
+		var sub []int
+		for j, i := range indices {
+			dst[j] = int64(len(sub))
+			for k := offsets[d.offset+i]; k < offsets[d.offset+i+1]; k++ {
+				sub = append(sub, int(k))
+			}
+		}
+		dst[n] = int64(len(sub))
+		child, err := takeData(d.childData[0], sub, mem)
+		if err != nil {
+			return nil, err
+		}
+		defer child.Release()
+		children = []*Data{child}
+
+	case *arrow.FixedSizeListType:
+		size := int(dt.Len())
+		sub := make([]int, 0, n*size)
+		for _, i := range indices {
+			for k := 0; k < size; k++ {
+				sub = append(sub, (d.offset+i)*size+k)
+			}
+		}
+		child, err := takeData(d.childData[0], sub, mem)
+		if err != nil {
+			return nil, err
+		}
+		defer child.Release()
+		children = []*Data{child}
+
+	case *arrow.StructType:
+		sub := make([]int, n)
+		for j, i := range indices {
+			sub[j] = d.offset + i
+		}
+		children = make([]*Data, len(d.childData))
+		defer func() {
+			for _, child := range children {
+				if child != nil {
+					child.Release()
+				}
+			}
+		}()
+		for k, child := range d.childData {
+			c, err := takeData(child, sub, mem)
+			if err != nil {
+				return nil, err
+			}
+			children[k] = c
+		}
+
+	case arrow.FixedWidthDataType:
+		width := dt.BitWidth() / 8
+		if _, ok := dt.(*arrow.Decimal128Type); ok {
+			width = arrow.Decimal128SizeBytes
+		}
+		src := d.buffers[1].Bytes()
+		buffers[1] = newBuffer(mem, n*width)
+		dst := buffers[1].Bytes()
+		for j, i := range indices {
+			copy(dst[j*width:(j+1)*width], src[(d.offset+i)*width:(d.offset+i+1)*width])
+		}
+
+	default:
+		return nil, xerrors.Errorf("arrow/array: take not implemented for %v", d.dtype)
+	}
+
+	return NewData(d.dtype, n, buffers, children, nulls, 0), nil
+}
+
+// makeEmptyData returns array data of type dtype holding no values.
+func makeEmptyData(dtype arrow.DataType, mem memory.Allocator) *Data {
+	bldr := NewBuilder(mem, dtype)
+	defer bldr.Release()
+
+	arr := bldr.NewArray()
+	defer arr.Release()
+	arr.Data().Retain()
+	return arr.Data()
+}
+
+func newBuffer(mem memory.Allocator, size int) *memory.Buffer {
+	buf := memory.NewResizableBuffer(mem)
+	buf.Resize(size)
+	return buf
+}
@@ -0,0 +1,125 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array_test
+
+import (
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/internal/arrdata"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+func TestTakeRecord(t *testing.T) {
+	for name, recs := range arrdata.Records {
+		t.Run(name, func(t *testing.T) {
+			mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+			defer mem.AssertSize(t, 0)
+
+			for _, rec := range recs {
+				n := int(rec.NumRows())
+				if n == 0 {
+					continue
+				}
+				for _, indices := range [][]int{
+					nil,
+					{n - 1, 0, n - 1},
+					{n / 2},
+				} {
+					out, err := array.TakeRecord(rec, indices, mem)
+					if err != nil {
+						t.Fatalf("could not take rows %v: %v", indices, err)
+					}
+
+					if err := array.ValidateRecord(out); err != nil {
+						t.Fatalf("invalid record: %v", err)
+					}
+					if got, want := out.NumRows(), int64(len(indices)); got != want {
+						t.Fatalf("invalid number of rows: got=%d, want=%d", got, want)
+					}
+					for i, col := range out.Columns() {
+						for j, idx := range indices {
+							got := array.NewSlice(col, int64(j), int64(j+1))
+							want := array.NewSlice(rec.Column(i), int64(idx), int64(idx+1))
+							if !array.ArrayEqual(got, want) {
+								t.Fatalf("invalid value %d of column %q: got=%v, want=%v", idx, rec.ColumnName(i), got, want)
+							}
+							got.Release()
+							want.Release()
+						}
+					}
+					out.Release()
+				}
+			}
+		})
+	}
+}
+
+func TestTakeOutOfRange(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	bldr := array.NewStringBuilder(mem)
+	defer bldr.Release()
+	bldr.AppendValues([]string{"a", "b", "c"}, nil)
+
+	arr := bldr.NewArray()
+	defer arr.Release()
+
+	slice := array.NewSlice(arr, 1, 3)
+	defer slice.Release()
+
+	out, err := array.Take(slice, []int{1, 0}, mem)
+	if err != nil {
+		t.Fatalf("could not take values: %v", err)
+	}
+	defer out.Release()
+
+	if got, want := out.(*array.String).Value(0), "c"; got != want {
+		t.Fatalf("invalid value: got=%q, want=%q", got, want)
+	}
+
+	for _, indices := range [][]int{{-1}, {2}, {0, 5}} {
+		out, err := array.Take(slice, indices, mem)
+		if err == nil {
+			out.Release()
+			t.Fatalf("expected an error for indices %v", indices)
+		}
+	}
+}
+
+func TestTakeOffsetsOverflow(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	// a single value of 1GiB, whose values buffer is never read as taking
+	// it twice overflows the int32 offsets.
+	offsets := memory.NewBufferBytes(arrow.Int32Traits.CastToBytes([]int32{0, 1 << 30}))
+	values := memory.NewBufferBytes(nil)
+	data := array.NewData(arrow.BinaryTypes.Binary, 1, []*memory.Buffer{nil, offsets, values}, nil, 0, 0)
+	defer data.Release()
+
+	arr := array.MakeFromData(data)
+	defer arr.Release()
+
+	out, err := array.Take(arr, []int{0, 0}, mem)
+	if err == nil {
+		out.Release()
+		t.Fatalf("expected an error on offsets overflow")
+	}
+}
@@ -0,0 +1,407 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array
+
+import (
+	"math/big"
+	"unicode/utf8"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/bitutil"
+	"github.com/apache/arrow/go/arrow/memory"
+	"golang.org/x/xerrors"
+)
+
+// Validate performs cheap structural checks of the array: the number and
+// the sizes of its buffers with respect to its length and offset, and the
+// lengths of its children.
+// Validate returns nil if the array is well-formed.
+func Validate(arr Interface) error {
+	return validateData(arr.Data(), false)
+}
+
+// ValidateFull performs the checks of Validate, and checks the values of the
+// array: offsets must be monotonic and within the bounds of the values,
+// strings must be valid UTF-8, decimals must fit their precision and the null
+// count must match the validity bitmap.
+// ValidateFull is O(n) in the number of values of the array.
+func ValidateFull(arr Interface) error {
+	return validateData(arr.Data(), true)
+}
+
+// Validate performs the checks of the Validate function on the array data.
+// As array constructors trust their input, Validate may be used to check data
+// from untrusted sources before calling MakeFromData.
+func (d *Data) Validate() error {
+	return validateData(d, false)
+}
+
+// ValidateFull performs the checks of the ValidateFull function on the
+// array data.
+func (d *Data) ValidateFull() error {
+	return validateData(d, true)
+}
+
+// ValidateRecord validates the columns of the record with Validate, and checks
+// they are consistent with the schema and the number of rows of the record.
+func ValidateRecord(rec Record) error {
+	return validateRecord(rec, false)
+}
+
+// ValidateRecordFull validates the columns of the record with ValidateFull,
+// and checks they are consistent with the schema and the number of rows of
+// the record.
+func ValidateRecordFull(rec Record) error {
+	return validateRecord(rec, true)
+}
+
+// ValidateTable validates the chunks of the columns of the table with
+// Validate, and checks they are consistent with the schema and the number of
+// rows of the table.
+func ValidateTable(tbl Table) error {
+	return validateTable(tbl, false)
+}
+
+// ValidateTableFull validates the chunks of the columns of the table with
+// ValidateFull, and checks they are consistent with the schema and the
+// number of rows of the table.
+func ValidateTableFull(tbl Table) error {
+	return validateTable(tbl, true)
+}
+
+func validateRecord(rec Record, full bool) error {
+	schema := rec.Schema()
+	if got, want := int(rec.NumCols()), len(schema.Fields()); got != want {
+		return xerrors.Errorf("arrow/array: record has %d columns, schema has %d fields", got, want)
+	}
+	for i, col := range rec.Columns() {
+		field := schema.Field(i)
+		if !arrow.TypeEqual(col.DataType(), field.Type) {
+			return xerrors.Errorf("arrow/array: column %q has type %v, want %v", field.Name, col.DataType(), field.Type)
+		}
+		if int64(col.Len()) != rec.NumRows() {
+			return xerrors.Errorf("arrow/array: column %q has length %d, want %d", field.Name, col.Len(), rec.NumRows())
+		}
+		if err := validateData(col.Data(), full); err != nil {
+			return xerrors.Errorf("arrow/array: invalid column %q: %w", field.Name, err)
+		}
+	}
+	return nil
+}
+
+func validateTable(tbl Table, full bool) error {
+	schema := tbl.Schema()
+	if got, want := int(tbl.NumCols()), len(schema.Fields()); got != want {
+		return xerrors.Errorf("arrow/array: table has %d columns, schema has %d fields", got, want)
+	}
+	for i := 0; i < int(tbl.NumCols()); i++ {
+		var (
+			col   = tbl.Column(i)
+			field = schema.Field(i)
+		)
+		if !col.Field().Equal(field) {
+			return xerrors.Errorf("arrow/array: column %q is inconsistent with schema", col.Name())
+		}
+		if int64(col.Len()) < tbl.NumRows() {
+			return xerrors.Errorf("arrow/array: column %q has length %d, want at least %d", col.Name(), col.Len(), tbl.NumRows())
+		}
+		for j, chunk := range col.Data().Chunks() {
+			if !arrow.TypeEqual(chunk.DataType(), field.Type) {
+				return xerrors.Errorf(
+					"arrow/array: chunk %d of column %q has type %v, want %v",
+					j, col.Name(), chunk.DataType(), field.Type,
+				)
+			}
+			if err := validateData(chunk.Data(), full); err != nil {
+				return xerrors.Errorf("arrow/array: invalid chunk %d of column %q: %w", j, col.Name(), err)
+			}
+		}
+	}
+	return nil
+}
+
+// validateData checks the array data is well-formed. The values are checked
+// too when full is true.
+func validateData(d *Data, full bool) error {
+	if d.length < 0 {
+		return xerrors.Errorf("arrow/array: negative length (%d)", d.length)
+	}
+	if d.offset < 0 {
+		return xerrors.Errorf("arrow/array: negative offset (%d)", d.offset)
+	}
+	if d.nulls > d.length {
+		return xerrors.Errorf("arrow/array: null count (%d) larger than length (%d)", d.nulls, d.length)
+	}
+
+	var (
+		dtype = d.dtype
+		end   = d.offset + d.length
+	)
+
+	if dtype.ID() == arrow.NULL {
+		if d.nulls >= 0 && d.nulls != d.length {
+			return xerrors.Errorf("arrow/array: null count (%d) of null array differs from length (%d)", d.nulls, d.length)
+		}
+		return nil
+	}
+
+	nbufs, nchildren := layoutOf(dtype)
+	if len(d.buffers) < nbufs {
+		return xerrors.Errorf("arrow/array: %v array has %d buffers, want %d", dtype, len(d.buffers), nbufs)
+	}
+	if len(d.childData) != nchildren {
+		return xerrors.Errorf("arrow/array: %v array has %d children, want %d", dtype, len(d.childData), nchildren)
+	}
+	for i, child := range d.childData {
+		if child == nil {
+			return xerrors.Errorf("arrow/array: child %d of %v array is nil", i, dtype)
+		}
+	}
+
+	switch bitmap := d.buffers[0]; {
+	case bitmap == nil:
+		if d.nulls > 0 {
+			return xerrors.Errorf("arrow/array: null count is %d but validity bitmap is missing", d.nulls)
+		}
+	default:
+		if err := checkBufferSize("validity bitmap", bitmap, int(bitutil.BytesForBits(int64(end))), d.length); err != nil {
+			return err
+		}
+		if full && d.nulls >= 0 {
+			nulls := d.length - bitutil.CountSetBits(bitmap.Bytes(), d.offset, d.length)
+			if nulls != d.nulls {
+				return xerrors.Errorf("arrow/array: null count is %d but validity bitmap has %d nulls", d.nulls, nulls)
+			}
+		}
+	}
+
+	switch dt := dtype.(type) {
+	case *arrow.BooleanType:
+		return checkBufferSize("values", d.buffers[1], int(bitutil.BytesForBits(int64(end))), d.length)
+
+	case *arrow.StringType, *arrow.BinaryType:
+		if err := checkBufferSize("offsets", d.buffers[1], arrow.Int32Traits.BytesRequired(end+1), d.length); err != nil {
+			return err
+		}
+		if !full || d.length == 0 {
+			return nil
+		}
+		var values []byte
+		if d.buffers[2] != nil {
+			values = d.buffers[2].Bytes()
+		}
+		offsets := arrow.Int32Traits.CastFromBytes(d.buffers[1].Bytes())[d.offset : end+1]
+		if err := checkOffsets(offsets, len(values)); err != nil {
+			return err
+		}
+		if dtype.ID() == arrow.STRING {
+			for i := 0; i < d.length; i++ {
+				if !utf8.Valid(values[offsets[i]:offsets[i+1]]) {
+					return xerrors.Errorf("arrow/array: invalid UTF-8 string at index %d", i)
+				}
+			}
+		}
+		return nil
+
+	case *arrow.LargeStringType, *arrow.LargeBinaryType:
+		if err := checkBufferSize("offsets", d.buffers[1], arrow.Int64Traits.BytesRequired(end+1), d.length); err != nil {
+			return err
+		}
+		if !full || d.length == 0 {
+			return nil
+		}
+		var values []byte
+		if d.buffers[2] != nil {
+			values = d.buffers[2].Bytes()
+		}
+		offsets := arrow.Int64Traits.CastFromBytes(d.buffers[1].Bytes())[d.offset : end+1]
+		if err := checkLargeOffsets(offsets, len(values)); err != nil {
+			return err
+		}
+		if dtype.ID() == arrow.LARGE_STRING {
+			for i := 0; i < d.length; i++ {
+				if !utf8.Valid(values[offsets[i]:offsets[i+1]]) {
+					return xerrors.Errorf("arrow/array: invalid UTF-8 string at index %d", i)
+				}
+			}
+		}
+		return nil
+
+	case *arrow.ListType:
+		if err := checkBufferSize("offsets", d.buffers[1], arrow.Int32Traits.BytesRequired(end+1), d.length); err != nil {
+			return err
+		}
+		child := d.childData[0]
+		if err := validateChild(child, dt.Elem(), full); err != nil {
+			return err
+		}
+		if !full || d.length == 0 {
+			return nil
+		}
+		offsets := arrow.Int32Traits.CastFromBytes(d.buffers[1].Bytes())[d.offset : end+1]
+		return checkOffsets(offsets, child.length)
+
+	case *arrow.LargeListType:
+		if err := checkBufferSize("offsets", d.buffers[1], arrow.Int64Traits.BytesRequired(end+1), d.length); err != nil {
+			return err
+		}
+		child := d.childData[0]
+		if err := validateChild(child, dt.Elem(), full); err != nil {
+			return err
+		}
+		if !full || d.length == 0 {
+			return nil
+		}
+		offsets := arrow.Int64Traits.CastFromBytes(d.buffers[1].Bytes())[d.offset : end+1]
+		return checkLargeOffsets(offsets, child.length)
+
+	case *arrow.FixedSizeListType:
+		child := d.childData[0]
+		if want := end * int(dt.Len()); child.length < want {
+			return xerrors.Errorf("arrow/array: values of fixed-size list have length %d, want at least %d", child.length, want)
+		}
+		return validateChild(child, dt.Elem(), full)
+
+	case *arrow.StructType:
+		for i, field := range dt.Fields() {
+			child := d.childData[i]
+			if child.length < end {
+				return xerrors.Errorf("arrow/array: struct field %q has length %d, want at least %d", field.Name, child.length, end)
+			}
+			if err := validateChild(child, field.Type, full); err != nil {
+				return xerrors.Errorf("arrow/array: invalid struct field %q: %w", field.Name, err)
+			}
+		}
+		return nil
+
+	case *arrow.Decimal128Type:
+		if err := checkBufferSize("values", d.buffers[1], end*arrow.Decimal128SizeBytes, d.length); err != nil {
+			return err
+		}
+		if full && d.length > 0 {
+			return checkDecimals(d, dt)
+		}
+		return nil
+
+	case arrow.FixedWidthDataType:
+		return checkBufferSize("values", d.buffers[1], end*dt.BitWidth()/8, d.length)
+
+	default:
+		return xerrors.Errorf("arrow/array: validation of arrays of type %v not supported", dtype)
+	}
+}
+
+// layoutOf returns the minimum number of buffers, and the number of children,
+// of arrays of the given data type.
+func layoutOf(dtype arrow.DataType) (nbufs, nchildren int) {
+	switch dt := dtype.(type) {
+	case *arrow.StringType, *arrow.BinaryType, *arrow.LargeStringType, *arrow.LargeBinaryType:
+		return 3, 0
+	case *arrow.ListType, *arrow.LargeListType:
+		return 2, 1
+	case *arrow.FixedSizeListType:
+		return 1, 1
+	case *arrow.StructType:
+		return 1, len(dt.Fields())
+	default:
+		return 2, 0
+	}
+}
+
+func validateChild(child *Data, dtype arrow.DataType, full bool) error {
+	if !arrow.TypeEqual(child.dtype, dtype) {
+		return xerrors.Errorf("arrow/array: child has type %v, want %v", child.dtype, dtype)
+	}
+	return validateData(child, full)
+}
+
+// checkBufferSize checks the buffer holds at least size bytes. Buffers of
+// empty arrays may be missing.
+func checkBufferSize(name string, buf *memory.Buffer, size, length int) error {
+	if buf == nil {
+		if length == 0 {
+			return nil
+		}
+		return xerrors.Errorf("arrow/array: %s buffer is missing", name)
+	}
+	if buf.Len() < size {
+		return xerrors.Errorf("arrow/array: %s buffer has %d bytes, want at least %d", name, buf.Len(), size)
+	}
+	return nil
+}
+
+// checkOffsets checks the offsets are monotonic and within [0, n].
+func checkOffsets(offsets []int32, n int) error {
+	if offsets[0] < 0 {
+		return xerrors.Errorf("arrow/array: negative first offset (%d)", offsets[0])
+	}
+	for i := 1; i < len(offsets); i++ {
+		if offsets[i] < offsets[i-1] {
+			return xerrors.Errorf("arrow/array: offsets not monotonic at index %d (%d < %d)", i, offsets[i], offsets[i-1])
+		}
+	}
+	if last := offsets[len(offsets)-1]; int(last) > n {
+		return xerrors.Errorf("arrow/array: last offset (%d) out of bounds of values (%d)", last, n)
+	}
+	return nil
+}
+
+// checkLargeOffsets is like checkOffsets, for int64 offsets.
+func checkLargeOffsets(offsets []int64, n int) error {
+	if offsets[0] < 0 {
+		return xerrors.Errorf("arrow/array: negative first offset (%d)", offsets[0])
+	}
+	for i := 1; i < len(offsets); i++ {
+		if offsets[i] < offsets[i-1] {
+			return xerrors.Errorf("arrow/array: offsets not monotonic at index %d (%d < %d)", i, offsets[i], offsets[i-1])
+		}
+	}
+	if last := offsets[len(offsets)-1]; last > int64(n) {
+		return xerrors.Errorf("arrow/array: last offset (%d) out of bounds of values (%d)", last, n)
+	}
+	return nil
+}
+
+// checkDecimals checks the valid values of the decimal array data fit the
+// precision of their type.
+func checkDecimals(d *Data, dt *arrow.Decimal128Type) error {
+	if dt.Precision < 1 || dt.Precision > 38 {
+		return xerrors.Errorf("arrow/array: invalid decimal precision (%d)", dt.Precision)
+	}
+
+	var (
+		bitmap []byte
+		values = arrow.Decimal128Traits.CastFromBytes(d.buffers[1].Bytes())[d.offset : d.offset+d.length]
+		bound  = new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(dt.Precision)), nil)
+		abs    = new(big.Int)
+	)
+	if d.buffers[0] != nil {
+		bitmap = d.buffers[0].Bytes()
+	}
+	for i, v := range values {
+		if bitmap != nil && bitutil.BitIsNotSet(bitmap, d.offset+i) {
+			continue
+		}
+		if abs.Abs(v.BigInt()).Cmp(bound) >= 0 {
+			return xerrors.Errorf(
+				"arrow/array: decimal value %s at index %d does not fit precision %d",
+				v.ToString(dt.Scale), i, dt.Precision,
+			)
+		}
+	}
+	return nil
+}
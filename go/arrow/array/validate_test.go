@@ -0,0 +1,246 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/decimal128"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+func TestValidate(t *testing.T) {
+	for _, tc := range concatCases {
+		t.Run(fmt.Sprint(tc.dtype), func(t *testing.T) {
+			mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+			defer mem.AssertSize(t, 0)
+
+			bldr := array.NewBuilder(mem, tc.dtype)
+			defer bldr.Release()
+
+			for i := 0; i < 20; i++ {
+				switch {
+				case i%4 == 1:
+					appendNullTo(bldr)
+				default:
+					tc.append(bldr, i)
+				}
+			}
+			arr := bldr.NewArray()
+			defer arr.Release()
+
+			slice := array.NewSlice(arr, 3, 17)
+			defer slice.Release()
+
+			empty := bldr.NewArray()
+			defer empty.Release()
+
+			for _, a := range []array.Interface{arr, slice, empty} {
+				if err := array.Validate(a); err != nil {
+					t.Fatalf("invalid array %v: %v", a, err)
+				}
+				if err := array.ValidateFull(a); err != nil {
+					t.Fatalf("invalid array values %v: %v", a, err)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateInvalid(t *testing.T) {
+	bytesOf := func(vs ...int32) *memory.Buffer {
+		return memory.NewBufferBytes(arrow.Int32Traits.CastToBytes(vs))
+	}
+
+	for _, tc := range []struct {
+		name string
+		data *array.Data
+		full bool // whether the error is only reported by ValidateFull
+	}{
+		{
+			name: "short-values",
+			data: array.NewData(arrow.PrimitiveTypes.Int32, 4, []*memory.Buffer{nil, bytesOf(1, 2, 3)}, nil, 0, 0),
+		},
+		{
+			name: "short-values-offset",
+			data: array.NewData(arrow.PrimitiveTypes.Int32, 2, []*memory.Buffer{nil, bytesOf(1, 2, 3)}, nil, 0, 2),
+		},
+		{
+			name: "missing-bitmap",
+			data: array.NewData(arrow.PrimitiveTypes.Int32, 3, []*memory.Buffer{nil, bytesOf(1, 2, 3)}, nil, 1, 0),
+		},
+		{
+			name: "short-bitmap",
+			data: array.NewData(arrow.PrimitiveTypes.Int32, 10, []*memory.Buffer{memory.NewBufferBytes([]byte{0xff}), bytesOf(make([]int32, 10)...)}, nil, 0, 0),
+		},
+		{
+			name: "missing-buffers",
+			data: array.NewData(arrow.BinaryTypes.String, 1, []*memory.Buffer{nil, bytesOf(0, 1)}, nil, 0, 0),
+		},
+		{
+			name: "missing-child",
+			data: array.NewData(arrow.ListOf(arrow.PrimitiveTypes.Int32), 1, []*memory.Buffer{nil, bytesOf(0, 1)}, nil, 0, 0),
+		},
+		{
+			name: "short-struct-field",
+			data: array.NewData(
+				arrow.StructOf(arrow.Field{Name: "a", Type: arrow.PrimitiveTypes.Int32}), 3,
+				[]*memory.Buffer{nil},
+				[]*array.Data{array.NewData(arrow.PrimitiveTypes.Int32, 2, []*memory.Buffer{nil, bytesOf(1, 2)}, nil, 0, 0)},
+				0, 0,
+			),
+		},
+		{
+			name: "short-fixed-size-list-values",
+			data: array.NewData(
+				arrow.FixedSizeListOf(2, arrow.PrimitiveTypes.Int32), 2,
+				[]*memory.Buffer{nil},
+				[]*array.Data{array.NewData(arrow.PrimitiveTypes.Int32, 3, []*memory.Buffer{nil, bytesOf(1, 2, 3)}, nil, 0, 0)},
+				0, 0,
+			),
+		},
+		{
+			name: "non-monotonic-offsets",
+			data: array.NewData(arrow.BinaryTypes.Binary, 2, []*memory.Buffer{nil, bytesOf(0, 3, 2), memory.NewBufferBytes([]byte("abc"))}, nil, 0, 0),
+			full: true,
+		},
+		{
+			name: "out-of-bounds-offsets",
+			data: array.NewData(arrow.BinaryTypes.Binary, 1, []*memory.Buffer{nil, bytesOf(0, 4), memory.NewBufferBytes([]byte("abc"))}, nil, 0, 0),
+			full: true,
+		},
+		{
+			name: "out-of-bounds-list-offsets",
+			data: array.NewData(
+				arrow.ListOf(arrow.PrimitiveTypes.Int32), 1,
+				[]*memory.Buffer{nil, bytesOf(0, 3)},
+				[]*array.Data{array.NewData(arrow.PrimitiveTypes.Int32, 2, []*memory.Buffer{nil, bytesOf(1, 2)}, nil, 0, 0)},
+				0, 0,
+			),
+			full: true,
+		},
+		{
+			name: "invalid-utf8",
+			data: array.NewData(arrow.BinaryTypes.String, 1, []*memory.Buffer{nil, bytesOf(0, 2), memory.NewBufferBytes([]byte{0xff, 0xfe})}, nil, 0, 0),
+			full: true,
+		},
+		{
+			name: "null-count-mismatch",
+			data: array.NewData(arrow.PrimitiveTypes.Int32, 3, []*memory.Buffer{memory.NewBufferBytes([]byte{0x05}), bytesOf(1, 2, 3)}, nil, 0, 0),
+			full: true,
+		},
+		{
+			name: "decimal-precision",
+			data: array.NewData(
+				&arrow.Decimal128Type{Precision: 3, Scale: 1}, 2,
+				[]*memory.Buffer{nil, memory.NewBufferBytes(arrow.Decimal128Traits.CastToBytes([]decimal128.Num{
+					decimal128.FromI64(999), decimal128.FromI64(-1000),
+				}))},
+				nil, 0, 0,
+			),
+			full: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			defer tc.data.Release()
+
+			err := tc.data.Validate()
+			switch {
+			case tc.full && err != nil:
+				t.Fatalf("unexpected error from Validate: %v", err)
+			case !tc.full && err == nil:
+				t.Fatalf("expected an error from Validate")
+			}
+
+			err = tc.data.ValidateFull()
+			if err == nil {
+				t.Fatalf("expected an error from ValidateFull")
+			}
+			if !strings.HasPrefix(err.Error(), "arrow/array: ") {
+				t.Fatalf("invalid error message: %v", err)
+			}
+
+			if tc.full {
+				arr := array.MakeFromData(tc.data)
+				defer arr.Release()
+				if err := array.ValidateFull(arr); err == nil {
+					t.Fatalf("expected an error from array ValidateFull")
+				}
+			}
+		})
+	}
+}
+
+func TestValidateRecordAndTable(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	schema := arrow.NewSchema([]arrow.Field{
+		{Name: "str", Type: arrow.BinaryTypes.String},
+	}, nil)
+
+	bld := array.NewRecordBuilder(mem, schema)
+	defer bld.Release()
+
+	bld.Field(0).(*array.StringBuilder).AppendValues([]string{"a", "bc"}, nil)
+	rec := bld.NewRecord()
+	defer rec.Release()
+
+	if err := array.ValidateRecordFull(rec); err != nil {
+		t.Fatalf("invalid record: %v", err)
+	}
+
+	tbl := array.NewTableFromRecords(schema, []array.Record{rec, rec})
+	defer tbl.Release()
+
+	if err := array.ValidateTableFull(tbl); err != nil {
+		t.Fatalf("invalid table: %v", err)
+	}
+
+	data := array.NewData(
+		arrow.BinaryTypes.String, 2,
+		[]*memory.Buffer{nil, memory.NewBufferBytes(arrow.Int32Traits.CastToBytes([]int32{0, 1, 2})), memory.NewBufferBytes([]byte{'a', 0xff})},
+		nil, 0, 0,
+	)
+	defer data.Release()
+	col := array.MakeFromData(data)
+	defer col.Release()
+
+	bad := array.NewRecord(schema, []array.Interface{col}, 2)
+	defer bad.Release()
+
+	if err := array.ValidateRecord(bad); err != nil {
+		t.Fatalf("unexpected error from ValidateRecord: %v", err)
+	}
+	if err := array.ValidateRecordFull(bad); err == nil {
+		t.Fatalf("expected an error from ValidateRecordFull")
+	}
+
+	btbl := array.NewTableFromRecords(schema, []array.Record{rec, bad})
+	defer btbl.Release()
+
+	if err := array.ValidateTable(btbl); err != nil {
+		t.Fatalf("unexpected error from ValidateTable: %v", err)
+	}
+	if err := array.ValidateTableFull(btbl); err == nil {
+		t.Fatalf("expected an error from ValidateTableFull")
+	}
+}
@@ -0,0 +1,294 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package arrio
+
+import (
+	"context"
+	"io"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/memory"
+	"golang.org/x/xerrors"
+)
+
+// The readers returned by the functions below follow the ownership rules of
+// the readers they wrap: a record returned by Read is valid until the next
+// call to Read. Users need to call Retain on that Record to keep it valid for
+// longer. Records created by the readers themselves are released on the next
+// call to Read, or once the reader has returned an error.
+
+// Concat returns a reader that is the logical concatenation of the provided
+// readers. They are read sequentially, and all their records must have the
+// schema of the first record read.
+func Concat(rs ...Reader) Reader {
+	return &concatReader{rs: rs}
+}
+
+type concatReader struct {
+	rs     []Reader
+	schema *arrow.Schema // schema of the first record read.
+}
+
+func (r *concatReader) Read() (array.Record, error) {
+	for len(r.rs) > 0 {
+		rec, err := r.rs[0].Read()
+		if err == io.EOF {
+			r.rs = r.rs[1:]
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		switch {
+		case r.schema == nil:
+			r.schema = rec.Schema()
+		case !rec.Schema().Equal(r.schema):
+			return nil, xerrors.Errorf("arrio: inconsistent schemas: got=%v, want=%v", rec.Schema(), r.schema)
+		}
+		return rec, nil
+	}
+	return nil, io.EOF
+}
+
+// Map returns a reader applying fn to the records read from r.
+//
+// The record returned by fn is owned by the returned reader: fn must call
+// Retain on its input record if it returns it unchanged.
+func Map(r Reader, fn func(rec array.Record) (array.Record, error)) Reader {
+	return &mapReader{r: r, fn: fn}
+}
+
+type mapReader struct {
+	r   Reader
+	fn  func(rec array.Record) (array.Record, error)
+	cur array.Record
+}
+
+func (r *mapReader) Read() (array.Record, error) {
+	if r.cur != nil {
+		r.cur.Release()
+		r.cur = nil
+	}
+
+	rec, err := r.r.Read()
+	if err != nil {
+		return nil, err
+	}
+
+	r.cur, err = r.fn(rec)
+	if err != nil {
+		return nil, err
+	}
+	return r.cur, nil
+}
+
+// Filter returns a reader yielding only the records read from r for which
+// keep returns true.
+func Filter(r Reader, keep func(rec array.Record) bool) Reader {
+	return &filterReader{r: r, keep: keep}
+}
+
+type filterReader struct {
+	r    Reader
+	keep func(rec array.Record) bool
+}
+
+func (r *filterReader) Read() (array.Record, error) {
+	for {
+		rec, err := r.r.Read()
+		if err != nil {
+			return nil, err
+		}
+		if r.keep(rec) {
+			return rec, nil
+		}
+	}
+}
+
+// Rechunk returns a reader yielding records of exactly rows rows, except
+// for the last one which may be shorter. Small records read from r are
+// concatenated and large ones are split.
+//
+// Rechunk panics if rows is not strictly positive.
+func Rechunk(r Reader, rows int64, mem memory.Allocator) Reader {
+	if rows <= 0 {
+		panic("arrio: invalid number of rows")
+	}
+	return &rechunkReader{r: r, rows: rows, mem: mem}
+}
+
+type rechunkReader struct {
+	r    Reader
+	rows int64
+	mem  memory.Allocator
+
+	cur   array.Record
+	queue []array.Record // records read from r and not yet yielded.
+	n     int64          // number of rows in queue.
+	eof   bool
+}
+
+func (r *rechunkReader) Read() (array.Record, error) {
+	if r.cur != nil {
+		r.cur.Release()
+		r.cur = nil
+	}
+
+	for !r.eof && r.n < r.rows {
+		rec, err := r.r.Read()
+		if err == io.EOF {
+			r.eof = true
+			break
+		}
+		if err != nil {
+			r.release()
+			return nil, err
+		}
+		if rec.NumRows() == 0 {
+			continue
+		}
+		rec.Retain()
+		r.queue = append(r.queue, rec)
+		r.n += rec.NumRows()
+	}
+
+	if r.n == 0 {
+		return nil, io.EOF
+	}
+
+	rec := r.queue[0]
+	if len(r.queue) > 1 {
+		var err error
+		rec, err = array.ConcatenateRecords(r.queue, r.mem)
+		if err != nil {
+			r.release()
+			return nil, err
+		}
+		for _, q := range r.queue {
+			q.Release()
+		}
+	}
+	r.queue = r.queue[:0]
+	r.n = 0
+
+	if rec.NumRows() <= r.rows {
+		r.cur = rec
+		return r.cur, nil
+	}
+
+	r.cur = rec.NewSlice(0, r.rows)
+	rest := rec.NewSlice(r.rows, rec.NumRows())
+	rec.Release()
+	r.queue = append(r.queue, rest)
+	r.n = rest.NumRows()
+	return r.cur, nil
+}
+
+func (r *rechunkReader) release() {
+	for _, rec := range r.queue {
+		rec.Release()
+	}
+	r.queue = nil
+	r.n = 0
+}
+
+// Tee returns a reader that writes to all the provided writers the records
+// it reads from r.
+// Any error encountered while writing is reported as a read error.
+func Tee(r Reader, ws ...Writer) Reader {
+	return &teeReader{r: r, ws: ws}
+}
+
+type teeReader struct {
+	r  Reader
+	ws []Writer
+}
+
+func (r *teeReader) Read() (array.Record, error) {
+	rec, err := r.r.Read()
+	if err != nil {
+		return nil, err
+	}
+	for _, w := range r.ws {
+		if err := w.Write(rec); err != nil {
+			return nil, err
+		}
+	}
+	return rec, nil
+}
+
+// Limit returns a reader yielding at most n rows read from r.
+// The last record is sliced if needed.
+func Limit(r Reader, n int64) Reader {
+	return &limitReader{r: r, n: n}
+}
+
+type limitReader struct {
+	r   Reader
+	n   int64 // number of rows remaining.
+	cur array.Record
+}
+
+func (r *limitReader) Read() (array.Record, error) {
+	if r.cur != nil {
+		r.cur.Release()
+		r.cur = nil
+	}
+
+	if r.n <= 0 {
+		return nil, io.EOF
+	}
+
+	rec, err := r.r.Read()
+	if err != nil {
+		return nil, err
+	}
+
+	if rec.NumRows() > r.n {
+		r.cur = rec.NewSlice(0, r.n)
+		rec = r.cur
+	}
+	r.n -= rec.NumRows()
+	return rec, nil
+}
+
+// CopyContext copies all the records available from src to dst, like Copy,
+// until the context is cancelled.
+// CopyContext returns the number of records copied and the first error
+// encountered while copying, if any. If the context is cancelled, the
+// returned error is the error of the context.
+func CopyContext(ctx context.Context, dst Writer, src Reader) (n int64, err error) {
+	for {
+		if err := ctx.Err(); err != nil {
+			return n, err
+		}
+		rec, err := src.Read()
+		if err != nil {
+			if err == io.EOF {
+				return n, nil
+			}
+			return n, err
+		}
+		err = dst.Write(rec)
+		if err != nil {
+			return n, err
+		}
+		n++
+	}
+}
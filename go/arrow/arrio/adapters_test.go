@@ -0,0 +1,242 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package arrio_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/arrio"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+// sliceReader is a reader over a slice of records.
+type sliceReader struct {
+	recs []array.Record
+}
+
+func (r *sliceReader) Read() (array.Record, error) {
+	if len(r.recs) == 0 {
+		return nil, io.EOF
+	}
+	rec := r.recs[0]
+	r.recs = r.recs[1:]
+	return rec, nil
+}
+
+// recordsWriter collects the string representation of the records written.
+type recordsWriter struct {
+	recs []string
+}
+
+func (w *recordsWriter) Write(rec array.Record) error {
+	w.recs = append(w.recs, fmt.Sprint(rec.Column(0)))
+	return nil
+}
+
+// makeRecords returns records of consecutive int64 values, with the
+// provided number of rows.
+func makeRecords(mem memory.Allocator, name string, rows ...int) []array.Record {
+	schema := arrow.NewSchema([]arrow.Field{{Name: name, Type: arrow.PrimitiveTypes.Int64}}, nil)
+	b := array.NewRecordBuilder(mem, schema)
+	defer b.Release()
+
+	var (
+		v    int64
+		recs = make([]array.Record, len(rows))
+	)
+	for i, n := range rows {
+		for j := 0; j < n; j++ {
+			b.Field(0).(*array.Int64Builder).Append(v)
+			v++
+		}
+		recs[i] = b.NewRecord()
+	}
+	return recs
+}
+
+func releaseRecords(recs []array.Record) {
+	for _, rec := range recs {
+		rec.Release()
+	}
+}
+
+func readAll(t *testing.T, r arrio.Reader) []string {
+	t.Helper()
+
+	w := new(recordsWriter)
+	_, err := arrio.Copy(w, r)
+	if err != nil {
+		t.Fatalf("could not read records: %v", err)
+	}
+	return w.recs
+}
+
+func TestAdapters(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	recs := makeRecords(mem, "x", 3, 1, 5, 0, 2)
+	defer releaseRecords(recs)
+
+	for _, tc := range []struct {
+		name string
+		r    func() arrio.Reader
+		want []string
+	}{
+		{
+			name: "concat",
+			r: func() arrio.Reader {
+				return arrio.Concat(&sliceReader{recs[:2]}, &sliceReader{}, &sliceReader{recs[2:3]})
+			},
+			want: []string{"[0 1 2]", "[3]", "[4 5 6 7 8]"},
+		},
+		{
+			name: "map",
+			r: func() arrio.Reader {
+				return arrio.Map(&sliceReader{recs[:3]}, func(rec array.Record) (array.Record, error) {
+					return rec.NewSlice(0, 1), nil
+				})
+			},
+			want: []string{"[0]", "[3]", "[4]"},
+		},
+		{
+			name: "filter",
+			r: func() arrio.Reader {
+				return arrio.Filter(&sliceReader{recs}, func(rec array.Record) bool {
+					return rec.NumRows() > 2
+				})
+			},
+			want: []string{"[0 1 2]", "[4 5 6 7 8]"},
+		},
+		{
+			name: "rechunk-2",
+			r: func() arrio.Reader {
+				return arrio.Rechunk(&sliceReader{recs}, 2, mem)
+			},
+			want: []string{"[0 1]", "[2 3]", "[4 5]", "[6 7]", "[8 9]", "[10]"},
+		},
+		{
+			name: "rechunk-4",
+			r: func() arrio.Reader {
+				return arrio.Rechunk(&sliceReader{recs}, 4, mem)
+			},
+			want: []string{"[0 1 2 3]", "[4 5 6 7]", "[8 9 10]"},
+		},
+		{
+			name: "rechunk-100",
+			r: func() arrio.Reader {
+				return arrio.Rechunk(&sliceReader{recs}, 100, mem)
+			},
+			want: []string{"[0 1 2 3 4 5 6 7 8 9 10]"},
+		},
+		{
+			name: "limit",
+			r: func() arrio.Reader {
+				return arrio.Limit(&sliceReader{recs}, 6)
+			},
+			want: []string{"[0 1 2]", "[3]", "[4 5]"},
+		},
+		{
+			name: "limit-0",
+			r: func() arrio.Reader {
+				return arrio.Limit(&sliceReader{recs}, 0)
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			got := readAll(t, tc.r())
+			if fmt.Sprint(got) != fmt.Sprint(tc.want) {
+				t.Fatalf("invalid records:\ngot= %q\nwant=%q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestConcatSchemaMismatch(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	xs := makeRecords(mem, "x", 1)
+	defer releaseRecords(xs)
+	ys := makeRecords(mem, "y", 1)
+	defer releaseRecords(ys)
+
+	r := arrio.Concat(&sliceReader{xs}, &sliceReader{ys})
+	_, err := arrio.Copy(new(recordsWriter), r)
+	if err == nil {
+		t.Fatalf("expected an error")
+	}
+}
+
+func TestTee(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	recs := makeRecords(mem, "x", 2, 1)
+	defer releaseRecords(recs)
+
+	var (
+		w1   = new(recordsWriter)
+		w2   = new(recordsWriter)
+		want = []string{"[0 1]", "[2]"}
+	)
+
+	got := readAll(t, arrio.Tee(&sliceReader{recs}, w1, w2))
+	for i, recs := range [][]string{got, w1.recs, w2.recs} {
+		if fmt.Sprint(recs) != fmt.Sprint(want) {
+			t.Fatalf("invalid records %d: got=%q, want=%q", i, recs, want)
+		}
+	}
+}
+
+func TestCopyContext(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	recs := makeRecords(mem, "x", 1, 1, 1)
+	defer releaseRecords(recs)
+
+	n, err := arrio.CopyContext(context.Background(), new(recordsWriter), &sliceReader{recs})
+	if err != nil {
+		t.Fatalf("could not copy records: %v", err)
+	}
+	if got, want := n, int64(3); got != want {
+		t.Fatalf("invalid number of records: got=%d, want=%d", got, want)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	w := writerFunc(func(rec array.Record) error {
+		cancel()
+		return nil
+	})
+	n, err = arrio.CopyContext(ctx, w, &sliceReader{recs})
+	if err != context.Canceled {
+		t.Fatalf("invalid error: got=%v, want=%v", err, context.Canceled)
+	}
+	if got, want := n, int64(1); got != want {
+		t.Fatalf("invalid number of records: got=%d, want=%d", got, want)
+	}
+}
+
+type writerFunc func(rec array.Record) error
+
+func (f writerFunc) Write(rec array.Record) error { return f(rec) }
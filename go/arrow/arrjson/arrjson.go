@@ -15,17 +15,29 @@
 // limitations under the License.
 
 // Package arrjson provides types and functions to encode and decode ARROW types and data
-// to and from JSON files.
-package arrjson // import "github.com/apache/arrow/go/arrow/internal/arrjson"
+// to and from JSON files, following the JSON format of the Arrow integration tests.
+//
+// Whole files, holding a schema and a list of record batches, are read and
+// written with NewReader and NewWriter.
+// Schemas and single record batches may also be encoded and decoded on their
+// own, with MarshalSchema, UnmarshalSchema, MarshalRecord and UnmarshalRecord.
+//
+// The JSON format is meant for human-readable test fixtures, not for
+// efficient storage.
+package arrjson // import "github.com/apache/arrow/go/arrow/arrjson"
 
 import (
+	"bytes"
 	"encoding/hex"
 	"encoding/json"
+	"math/big"
 	"strconv"
 	"strings"
 
 	"github.com/apache/arrow/go/arrow"
 	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/bitutil"
+	"github.com/apache/arrow/go/arrow/decimal128"
 	"github.com/apache/arrow/go/arrow/float16"
 	"github.com/apache/arrow/go/arrow/memory"
 	"golang.org/x/xerrors"
@@ -40,27 +52,78 @@ const (
 	kYearMonth    = "YEAR_MONTH"
 )
 
+// Schema is the JSON representation of an arrow.Schema.
 type Schema struct {
-	Fields []Field `json:"fields"`
+	Fields   []Field    `json:"fields"`
+	Metadata []metadata `json:"metadata,omitempty"`
 }
 
+// Field is the JSON representation of an arrow.Field.
 type Field struct {
-	Name     string   `json:"name"`
-	Type     dataType `json:"type"`
-	Nullable bool     `json:"nullable"`
-	Children []Field  `json:"children"`
+	Name     string     `json:"name"`
+	Type     dataType   `json:"type"`
+	Nullable bool       `json:"nullable"`
+	Children []Field    `json:"children"`
+	Metadata []metadata `json:"metadata,omitempty"`
 }
 
 type dataType struct {
-	Name      string `json:"name"`
-	Signed    bool   `json:"isSigned,omitempty"`
-	BitWidth  int    `json:"bitWidth,omitempty"`
-	Precision string `json:"precision,omitempty"`
-	ByteWidth int    `json:"byteWidth,omitempty"`
-	ListSize  int32  `json:"listSize,omitempty"`
-	Unit      string `json:"unit,omitempty"`
-	TimeZone  string `json:"timezone,omitempty"`
-	Scale     int    `json:"scale,omitempty"` // for Decimal128
+	Name      string      `json:"name"`
+	Signed    bool        `json:"isSigned,omitempty"`
+	BitWidth  int         `json:"bitWidth,omitempty"`
+	Precision interface{} `json:"precision,omitempty"` // "HALF", "SINGLE" or "DOUBLE" for floats, a number for Decimal128
+	ByteWidth int         `json:"byteWidth,omitempty"`
+	ListSize  int32       `json:"listSize,omitempty"`
+	Unit      string      `json:"unit,omitempty"`
+	TimeZone  string      `json:"timezone,omitempty"`
+	Scale     int         `json:"scale,omitempty"` // for Decimal128
+}
+
+type metadata struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+func metadataToJSON(md arrow.Metadata) []metadata {
+	if md.Len() == 0 {
+		return nil
+	}
+	o := make([]metadata, md.Len())
+	for i, k := range md.Keys() {
+		o[i] = metadata{Key: k, Value: md.Values()[i]}
+	}
+	return o
+}
+
+func metadataFromJSON(md []metadata) arrow.Metadata {
+	if len(md) == 0 {
+		return arrow.Metadata{}
+	}
+	keys := make([]string, len(md))
+	vals := make([]string, len(md))
+	for i, kv := range md {
+		keys[i] = kv.Key
+		vals[i] = kv.Value
+	}
+	return arrow.NewMetadata(keys, vals)
+}
+
+// precisionFromJSON returns the precision of a Decimal128 data type, as
+// decoded from JSON with or without json.Decoder.UseNumber.
+func precisionFromJSON(v interface{}) int32 {
+	switch v := v.(type) {
+	case json.Number:
+		n, err := v.Int64()
+		if err != nil {
+			panic(err)
+		}
+		return int32(n)
+	case float64:
+		return int32(v)
+	case int32:
+		return v
+	}
+	panic(xerrors.Errorf("invalid decimal precision %v (%T)", v, v))
 }
 
 func dtypeToJSON(dt arrow.DataType) dataType {
@@ -95,6 +158,10 @@ func dtypeToJSON(dt arrow.DataType) dataType {
 		return dataType{Name: "binary"}
 	case *arrow.StringType:
 		return dataType{Name: "utf8"}
+	case *arrow.LargeBinaryType:
+		return dataType{Name: "largebinary"}
+	case *arrow.LargeStringType:
+		return dataType{Name: "largeutf8"}
 	case *arrow.Date32Type:
 		return dataType{Name: "date", Unit: "DAY"}
 	case *arrow.Date64Type:
@@ -142,6 +209,8 @@ func dtypeToJSON(dt arrow.DataType) dataType {
 
 	case *arrow.ListType:
 		return dataType{Name: "list"}
+	case *arrow.LargeListType:
+		return dataType{Name: "largelist"}
 	case *arrow.StructType:
 		return dataType{Name: "struct"}
 	case *arrow.FixedSizeListType:
@@ -151,6 +220,8 @@ func dtypeToJSON(dt arrow.DataType) dataType {
 			Name:      "fixedsizebinary",
 			ByteWidth: dt.ByteWidth,
 		}
+	case *arrow.Decimal128Type:
+		return dataType{Name: "decimal", Precision: dt.Precision, Scale: int(dt.Scale)}
 	}
 	panic(xerrors.Errorf("unknown arrow.DataType %v", dt))
 }
@@ -199,6 +270,10 @@ func dtypeFromJSON(dt dataType, children []Field) arrow.DataType {
 		return arrow.BinaryTypes.Binary
 	case "utf8":
 		return arrow.BinaryTypes.String
+	case "largebinary":
+		return arrow.BinaryTypes.LargeBinary
+	case "largeutf8":
+		return arrow.BinaryTypes.LargeString
 	case "date":
 		switch dt.Unit {
 		case "DAY":
@@ -235,13 +310,15 @@ func dtypeFromJSON(dt dataType, children []Field) arrow.DataType {
 			return &arrow.TimestampType{TimeZone: dt.TimeZone, Unit: arrow.Nanosecond}
 		}
 	case "list":
-		return arrow.ListOf(dtypeFromJSON(children[0].Type, nil))
+		return arrow.ListOf(dtypeFromJSON(children[0].Type, children[0].Children))
+	case "largelist":
+		return arrow.LargeListOf(dtypeFromJSON(children[0].Type, children[0].Children))
 	case "struct":
 		return arrow.StructOf(fieldsFromJSON(children)...)
 	case "fixedsizebinary":
 		return &arrow.FixedSizeBinaryType{ByteWidth: dt.ByteWidth}
 	case "fixedsizelist":
-		return arrow.FixedSizeListOf(dt.ListSize, dtypeFromJSON(children[0].Type, nil))
+		return arrow.FixedSizeListOf(dt.ListSize, dtypeFromJSON(children[0].Type, children[0].Children))
 	case "interval":
 		switch dt.Unit {
 		case "YEAR_MONTH":
@@ -260,18 +337,22 @@ func dtypeFromJSON(dt dataType, children []Field) arrow.DataType {
 		case "NANOSECOND":
 			return arrow.FixedWidthTypes.Duration_ns
 		}
+	case "decimal":
+		return &arrow.Decimal128Type{Precision: precisionFromJSON(dt.Precision), Scale: int32(dt.Scale)}
 	}
 	panic(xerrors.Errorf("unknown DataType %#v", dt))
 }
 
 func schemaToJSON(schema *arrow.Schema) Schema {
 	return Schema{
-		Fields: fieldsToJSON(schema.Fields()),
+		Fields:   fieldsToJSON(schema.Fields()),
+		Metadata: metadataToJSON(schema.Metadata()),
 	}
 }
 
 func schemaFromJSON(schema Schema) *arrow.Schema {
-	return arrow.NewSchema(fieldsFromJSON(schema.Fields), nil)
+	md := metadataFromJSON(schema.Metadata)
+	return arrow.NewSchema(fieldsFromJSON(schema.Fields), &md)
 }
 
 func fieldsToJSON(fields []arrow.Field) []Field {
@@ -282,10 +363,13 @@ func fieldsToJSON(fields []arrow.Field) []Field {
 			Type:     dtypeToJSON(f.Type),
 			Nullable: f.Nullable,
 			Children: []Field{},
+			Metadata: metadataToJSON(f.Metadata),
 		}
 		switch dt := f.Type.(type) {
 		case *arrow.ListType:
 			o[i].Children = fieldsToJSON([]arrow.Field{{Name: "item", Type: dt.Elem(), Nullable: f.Nullable}})
+		case *arrow.LargeListType:
+			o[i].Children = fieldsToJSON([]arrow.Field{{Name: "item", Type: dt.Elem(), Nullable: f.Nullable}})
 		case *arrow.FixedSizeListType:
 			o[i].Children = fieldsToJSON([]arrow.Field{{Name: "item", Type: dt.Elem(), Nullable: f.Nullable}})
 		case *arrow.StructType:
@@ -308,6 +392,7 @@ func fieldFromJSON(f Field) arrow.Field {
 		Name:     f.Name,
 		Type:     dtypeFromJSON(f.Type, f.Children),
 		Nullable: f.Nullable,
+		Metadata: metadataFromJSON(f.Metadata),
 	}
 }
 
@@ -318,6 +403,17 @@ type Record struct {
 
 func recordsFromJSON(mem memory.Allocator, schema *arrow.Schema, recs []Record) []array.Record {
 	vs := make([]array.Record, len(recs))
+	defer func() {
+		// release the records already decoded when decoding fails.
+		if e := recover(); e != nil {
+			for _, rec := range vs {
+				if rec != nil {
+					rec.Release()
+				}
+			}
+			panic(e)
+		}
+	}()
 	for i, rec := range recs {
 		vs[i] = recordFromJSON(mem, schema, rec)
 	}
@@ -346,12 +442,23 @@ type Array struct {
 	Count    int           `json:"count"`
 	Valids   []int         `json:"VALIDITY,omitempty"`
 	Data     []interface{} `json:"DATA,omitempty"`
-	Offset   []int32       `json:"OFFSET,omitempty"`
+	Offset   []interface{} `json:"OFFSET,omitempty"`
 	Children []Array       `json:"children,omitempty"`
 }
 
 func arraysFromJSON(mem memory.Allocator, schema *arrow.Schema, arrs []Array) []array.Interface {
 	o := make([]array.Interface, len(arrs))
+	defer func() {
+		// release the arrays already decoded when decoding fails.
+		if e := recover(); e != nil {
+			for _, arr := range o {
+				if arr != nil {
+					arr.Release()
+				}
+			}
+			panic(e)
+		}
+	}()
 	for i, v := range arrs {
 		o[i] = arrayFromJSON(mem, schema.Field(i).Type, v)
 	}
@@ -483,56 +590,73 @@ func arrayFromJSON(mem memory.Allocator, dt arrow.DataType, arr Array) array.Int
 		bldr.AppendValues(data, valids)
 		return bldr.NewArray()
 
-	case *arrow.ListType:
-		bldr := array.NewListBuilder(mem, dt.Elem())
+	case *arrow.LargeStringType:
+		bldr := array.NewLargeStringBuilder(mem)
+		defer bldr.Release()
+		data := strFromJSON(arr.Data)
+		valids := validsFromJSON(arr.Valids)
+		bldr.AppendValues(data, valids)
+		return bldr.NewArray()
+
+	case *arrow.LargeBinaryType:
+		bldr := array.NewLargeBinaryBuilder(mem, dt)
 		defer bldr.Release()
+		data := bytesFromJSON(arr.Data)
+		valids := validsFromJSON(arr.Valids)
+		bldr.AppendValues(data, valids)
+		return bldr.NewArray()
+
+	case *arrow.ListType:
 		valids := validsFromJSON(arr.Valids)
 		elems := arrayFromJSON(mem, dt.Elem(), arr.Children[0])
 		defer elems.Release()
-		for i, v := range valids {
-			bldr.Append(v)
-			beg := int64(arr.Offset[i])
-			end := int64(arr.Offset[i+1])
-			slice := array.NewSlice(elems, beg, end)
-			buildArray(bldr.ValueBuilder(), slice)
-			slice.Release()
+		if len(arr.Offset) != len(valids)+1 {
+			panic(xerrors.Errorf("arrow/arrjson: invalid number of list offsets (got=%d, want=%d)", len(arr.Offset), len(valids)+1))
 		}
-		return bldr.NewArray()
+		bitmap, nulls := validsToBitmap(mem, valids)
+		defer bitmap.Release()
+		offsets := memory.NewBufferBytes(arrow.Int32Traits.CastToBytes(offsetsFromJSON(arr.Offset)))
+		data := array.NewData(dt, len(valids), []*memory.Buffer{bitmap, offsets}, []*array.Data{elems.Data()}, nulls, 0)
+		defer data.Release()
+		return array.MakeFromData(data)
 
-	case *arrow.FixedSizeListType:
-		bldr := array.NewFixedSizeListBuilder(mem, dt.Len(), dt.Elem())
-		defer bldr.Release()
+	case *arrow.LargeListType:
 		valids := validsFromJSON(arr.Valids)
 		elems := arrayFromJSON(mem, dt.Elem(), arr.Children[0])
 		defer elems.Release()
-		size := int64(dt.Len())
-		for i, v := range valids {
-			bldr.Append(v)
-			beg := int64(i) * size
-			end := int64(i+1) * size
-			slice := array.NewSlice(elems, beg, end)
-			buildArray(bldr.ValueBuilder(), slice)
-			slice.Release()
+		if len(arr.Offset) != len(valids)+1 {
+			panic(xerrors.Errorf("arrow/arrjson: invalid number of list offsets (got=%d, want=%d)", len(arr.Offset), len(valids)+1))
 		}
-		return bldr.NewArray()
+		bitmap, nulls := validsToBitmap(mem, valids)
+		defer bitmap.Release()
+		offsets := memory.NewBufferBytes(arrow.Int64Traits.CastToBytes(largeOffsetsFromJSON(arr.Offset)))
+		data := array.NewData(dt, len(valids), []*memory.Buffer{bitmap, offsets}, []*array.Data{elems.Data()}, nulls, 0)
+		defer data.Release()
+		return array.MakeFromData(data)
+
+	case *arrow.FixedSizeListType:
+		valids := validsFromJSON(arr.Valids)
+		elems := arrayFromJSON(mem, dt.Elem(), arr.Children[0])
+		defer elems.Release()
+		bitmap, nulls := validsToBitmap(mem, valids)
+		defer bitmap.Release()
+		data := array.NewData(dt, len(valids), []*memory.Buffer{bitmap}, []*array.Data{elems.Data()}, nulls, 0)
+		defer data.Release()
+		return array.MakeFromData(data)
 
 	case *arrow.StructType:
-		bldr := array.NewStructBuilder(mem, dt)
-		defer bldr.Release()
 		valids := validsFromJSON(arr.Valids)
-		fields := make([]array.Interface, len(dt.Fields()))
+		fields := make([]*array.Data, len(dt.Fields()))
 		for i := range fields {
-			fields[i] = arrayFromJSON(mem, dt.Field(i).Type, arr.Children[i])
+			field := arrayFromJSON(mem, dt.Field(i).Type, arr.Children[i])
+			defer field.Release()
+			fields[i] = field.Data()
 		}
-
-		bldr.AppendValues(valids)
-		for i := range dt.Fields() {
-			fbldr := bldr.FieldBuilder(i)
-			buildArray(fbldr, fields[i])
-			fields[i].Release()
-		}
-
-		return bldr.NewArray()
+		bitmap, nulls := validsToBitmap(mem, valids)
+		defer bitmap.Release()
+		data := array.NewData(dt, len(valids), []*memory.Buffer{bitmap}, fields, nulls, 0)
+		defer data.Release()
+		return array.MakeFromData(data)
 
 	case *arrow.FixedSizeBinaryType:
 		bldr := array.NewFixedSizeBinaryBuilder(mem, dt)
@@ -541,7 +665,7 @@ func arrayFromJSON(mem memory.Allocator, dt arrow.DataType, arr Array) array.Int
 		data := make([][]byte, len(strdata))
 		for i, v := range strdata {
 			if len(v) != 2*dt.ByteWidth {
-				panic(xerrors.Errorf("arrjson: invalid hex-string length (got=%d, want=%d)", len(v), 2*dt.ByteWidth))
+				panic(xerrors.Errorf("arrow/arrjson: invalid hex-string length (got=%d, want=%d)", len(v), 2*dt.ByteWidth))
 			}
 			vv, err := hex.DecodeString(v)
 			if err != nil {
@@ -617,6 +741,14 @@ func arrayFromJSON(mem memory.Allocator, dt arrow.DataType, arr Array) array.Int
 		bldr.AppendValues(data, valids)
 		return bldr.NewArray()
 
+	case *arrow.Decimal128Type:
+		bldr := array.NewDecimal128Builder(mem, dt)
+		defer bldr.Release()
+		data := decimal128FromJSON(arr.Data)
+		valids := validsFromJSON(arr.Valids)
+		bldr.AppendValues(data, valids)
+		return bldr.NewArray()
+
 	default:
 		panic(xerrors.Errorf("unknown data type %v %T", dt, dt))
 	}
@@ -741,7 +873,24 @@ func arrayToJSON(field arrow.Field, arr array.Interface) Array {
 			Count:  arr.Len(),
 			Data:   bytesToJSON(arr),
 			Valids: validsToJSON(arr),
-			Offset: arr.ValueOffsets(),
+			Offset: offsetsToJSON(arr.ValueOffsets()),
+		}
+
+	case *array.LargeString:
+		return Array{
+			Name:   field.Name,
+			Count:  arr.Len(),
+			Data:   largeStrToJSON(arr),
+			Valids: validsToJSON(arr),
+		}
+
+	case *array.LargeBinary:
+		return Array{
+			Name:   field.Name,
+			Count:  arr.Len(),
+			Data:   largeBytesToJSON(arr),
+			Valids: validsToJSON(arr),
+			Offset: largeOffsetsToJSON(arr.ValueOffsets()),
 		}
 
 	case *array.List:
@@ -749,13 +898,25 @@ func arrayToJSON(field arrow.Field, arr array.Interface) Array {
 			Name:   field.Name,
 			Count:  arr.Len(),
 			Valids: validsToJSON(arr),
-			Offset: arr.Offsets(),
+			Offset: offsetsToJSON(arr.Offsets()[arr.Data().Offset() : arr.Data().Offset()+arr.Len()+1]),
 			Children: []Array{
 				arrayToJSON(arrow.Field{Name: "item", Type: arr.DataType().(*arrow.ListType).Elem()}, arr.ListValues()),
 			},
 		}
 		return o
 
+	case *array.LargeList:
+		o := Array{
+			Name:   field.Name,
+			Count:  arr.Len(),
+			Valids: validsToJSON(arr),
+			Offset: largeOffsetsToJSON(arr.Offsets()[arr.Data().Offset() : arr.Data().Offset()+arr.Len()+1]),
+			Children: []Array{
+				arrayToJSON(arrow.Field{Name: "item", Type: arr.DataType().(*arrow.LargeListType).Elem()}, arr.ListValues()),
+			},
+		}
+		return o
+
 	case *array.FixedSizeList:
 		o := Array{
 			Name:   field.Name,
@@ -791,7 +952,7 @@ func arrayToJSON(field arrow.Field, arr array.Interface) Array {
 		for i := range o.Data {
 			v := []byte(strings.ToUpper(hex.EncodeToString(arr.Value(i))))
 			if len(v) != 2*dt.ByteWidth {
-				panic(xerrors.Errorf("arrjson: invalid hex-string length (got=%d, want=%d)", len(v), 2*dt.ByteWidth))
+				panic(xerrors.Errorf("arrow/arrjson: invalid hex-string length (got=%d, want=%d)", len(v), 2*dt.ByteWidth))
 			}
 			o.Data[i] = string(v) // re-convert as string to prevent json.Marshal from base64-encoding it.
 		}
@@ -857,6 +1018,13 @@ func arrayToJSON(field arrow.Field, arr array.Interface) Array {
 			Data:   durationToJSON(arr),
 			Valids: validsToJSON(arr),
 		}
+	case *array.Decimal128:
+		return Array{
+			Name:   field.Name,
+			Count:  arr.Len(),
+			Data:   decimal128ToJSON(arr),
+			Valids: validsToJSON(arr),
+		}
 
 	default:
 		panic(xerrors.Errorf("unknown array type %T", arr))
@@ -874,6 +1042,23 @@ func validsFromJSON(vs []int) []bool {
 	return o
 }
 
+// validsToBitmap returns the validity bitmap and the number of nulls of the
+// given validity flags.
+func validsToBitmap(mem memory.Allocator, valids []bool) (*memory.Buffer, int) {
+	buf := memory.NewResizableBuffer(mem)
+	buf.Resize(int(bitutil.BytesForBits(int64(len(valids)))))
+	memory.Set(buf.Bytes(), 0)
+	nulls := 0
+	for i, v := range valids {
+		if v {
+			bitutil.SetBit(buf.Bytes(), i)
+		} else {
+			nulls++
+		}
+	}
+	return buf, nulls
+}
+
 func validsToJSON(arr array.Interface) []int {
 	o := make([]int, arr.Len())
 	for i := range o {
@@ -1178,6 +1363,64 @@ func bytesToJSON(arr *array.Binary) []interface{} {
 	return o
 }
 
+func largeStrToJSON(arr *array.LargeString) []interface{} {
+	o := make([]interface{}, arr.Len())
+	for i := range o {
+		o[i] = arr.Value(i)
+	}
+	return o
+}
+
+func largeBytesToJSON(arr *array.LargeBinary) []interface{} {
+	o := make([]interface{}, arr.Len())
+	for i := range o {
+		o[i] = strings.ToUpper(hex.EncodeToString(arr.Value(i)))
+	}
+	return o
+}
+
+func offsetsFromJSON(vs []interface{}) []int32 {
+	o := make([]int32, len(vs))
+	for i, v := range vs {
+		vv, err := v.(json.Number).Int64()
+		if err != nil {
+			panic(err)
+		}
+		o[i] = int32(vv)
+	}
+	return o
+}
+
+func offsetsToJSON(offsets []int32) []interface{} {
+	o := make([]interface{}, len(offsets))
+	for i, v := range offsets {
+		o[i] = v
+	}
+	return o
+}
+
+// largeOffsetsFromJSON decodes 64-bit offsets, which the JSON integration
+// format stores as strings.
+func largeOffsetsFromJSON(vs []interface{}) []int64 {
+	o := make([]int64, len(vs))
+	for i, v := range vs {
+		vv, err := strconv.ParseInt(v.(string), 10, 64)
+		if err != nil {
+			panic(err)
+		}
+		o[i] = vv
+	}
+	return o
+}
+
+func largeOffsetsToJSON(offsets []int64) []interface{} {
+	o := make([]interface{}, len(offsets))
+	for i, v := range offsets {
+		o[i] = strconv.FormatInt(v, 10)
+	}
+	return o
+}
+
 func date32FromJSON(vs []interface{}) []arrow.Date32 {
 	o := make([]arrow.Date32, len(vs))
 	for i, v := range vs {
@@ -1359,143 +1602,85 @@ func durationToJSON(arr *array.Duration) []interface{} {
 	return o
 }
 
-func buildArray(bldr array.Builder, data array.Interface) {
-	defer data.Release()
-
-	switch bldr := bldr.(type) {
-	default:
-		panic(xerrors.Errorf("unknown builder %T", bldr))
-
-	case *array.BooleanBuilder:
-		data := data.(*array.Boolean)
-		for i := 0; i < data.Len(); i++ {
-			switch {
-			case data.IsValid(i):
-				bldr.Append(data.Value(i))
-			default:
-				bldr.AppendNull()
-			}
-		}
-
-	case *array.Int8Builder:
-		data := data.(*array.Int8)
-		for i := 0; i < data.Len(); i++ {
-			switch {
-			case data.IsValid(i):
-				bldr.Append(data.Value(i))
-			default:
-				bldr.AppendNull()
-			}
+func decimal128FromJSON(vs []interface{}) []decimal128.Num {
+	o := make([]decimal128.Num, len(vs))
+	for i, v := range vs {
+		var n big.Int
+		if _, ok := n.SetString(v.(string), 10); !ok {
+			panic(xerrors.Errorf("could not parse decimal128 value %q", v))
 		}
+		o[i] = decimal128.FromBigInt(&n)
+	}
+	return o
+}
 
-	case *array.Int16Builder:
-		data := data.(*array.Int16)
-		for i := 0; i < data.Len(); i++ {
-			switch {
-			case data.IsValid(i):
-				bldr.Append(data.Value(i))
-			default:
-				bldr.AppendNull()
-			}
-		}
+func decimal128ToJSON(arr *array.Decimal128) []interface{} {
+	o := make([]interface{}, arr.Len())
+	for i := range o {
+		o[i] = arr.Value(i).BigInt().String()
+	}
+	return o
+}
 
-	case *array.Int32Builder:
-		data := data.(*array.Int32)
-		for i := 0; i < data.Len(); i++ {
-			switch {
-			case data.IsValid(i):
-				bldr.Append(data.Value(i))
-			default:
-				bldr.AppendNull()
-			}
-		}
+// MarshalSchema returns the JSON encoding of the schema.
+func MarshalSchema(schema *arrow.Schema) ([]byte, error) {
+	return json.MarshalIndent(schemaToJSON(schema), "", jsonIndent)
+}
 
-	case *array.Int64Builder:
-		data := data.(*array.Int64)
-		for i := 0; i < data.Len(); i++ {
-			switch {
-			case data.IsValid(i):
-				bldr.Append(data.Value(i))
-			default:
-				bldr.AppendNull()
-			}
-		}
+// UnmarshalSchema decodes a schema from its JSON encoding.
+func UnmarshalSchema(data []byte) (schema *arrow.Schema, err error) {
+	defer recoverError(&err)
 
-	case *array.Uint8Builder:
-		data := data.(*array.Uint8)
-		for i := 0; i < data.Len(); i++ {
-			switch {
-			case data.IsValid(i):
-				bldr.Append(data.Value(i))
-			default:
-				bldr.AppendNull()
-			}
-		}
+	var raw Schema
+	err = unmarshal(data, &raw)
+	if err != nil {
+		return nil, err
+	}
+	return schemaFromJSON(raw), nil
+}
 
-	case *array.Uint16Builder:
-		data := data.(*array.Uint16)
-		for i := 0; i < data.Len(); i++ {
-			switch {
-			case data.IsValid(i):
-				bldr.Append(data.Value(i))
-			default:
-				bldr.AppendNull()
-			}
-		}
+// MarshalRecord returns the JSON encoding of a single record batch.
+// The schema of the record is not encoded.
+func MarshalRecord(rec array.Record) ([]byte, error) {
+	return json.MarshalIndent(recordToJSON(rec), "", jsonIndent)
+}
 
-	case *array.Uint32Builder:
-		data := data.(*array.Uint32)
-		for i := 0; i < data.Len(); i++ {
-			switch {
-			case data.IsValid(i):
-				bldr.Append(data.Value(i))
-			default:
-				bldr.AppendNull()
-			}
-		}
+// UnmarshalRecord decodes a record batch with the given schema from its JSON
+// encoding.
+func UnmarshalRecord(data []byte, schema *arrow.Schema, opts ...Option) (rec array.Record, err error) {
+	defer recoverError(&err)
 
-	case *array.Uint64Builder:
-		data := data.(*array.Uint64)
-		for i := 0; i < data.Len(); i++ {
-			switch {
-			case data.IsValid(i):
-				bldr.Append(data.Value(i))
-			default:
-				bldr.AppendNull()
-			}
-		}
+	var raw Record
+	err = unmarshal(data, &raw)
+	if err != nil {
+		return nil, err
+	}
 
-	case *array.Float32Builder:
-		data := data.(*array.Float32)
-		for i := 0; i < data.Len(); i++ {
-			switch {
-			case data.IsValid(i):
-				bldr.Append(data.Value(i))
-			default:
-				bldr.AppendNull()
-			}
-		}
+	cfg := newConfig(opts...)
+	return recordFromJSON(cfg.alloc, schema, raw), nil
+}
 
-	case *array.Float64Builder:
-		data := data.(*array.Float64)
-		for i := 0; i < data.Len(); i++ {
-			switch {
-			case data.IsValid(i):
-				bldr.Append(data.Value(i))
-			default:
-				bldr.AppendNull()
-			}
-		}
+func unmarshal(data []byte, v interface{}) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	err := dec.Decode(v)
+	if err != nil {
+		return xerrors.Errorf("arrow/arrjson: could not decode JSON: %w", err)
+	}
+	return nil
+}
 
-	case *array.StringBuilder:
-		data := data.(*array.String)
-		for i := 0; i < data.Len(); i++ {
-			switch {
-			case data.IsValid(i):
-				bldr.Append(data.Value(i))
-			default:
-				bldr.AppendNull()
-			}
-		}
+// recoverError recovers from panics raised while decoding invalid JSON data,
+// and reports them as errors.
+func recoverError(err *error) {
+	e := recover()
+	if e == nil {
+		return
+	}
+	switch e := e.(type) {
+	case error:
+		*err = xerrors.Errorf("arrow/arrjson: invalid JSON data: %w", e)
+	default:
+		*err = xerrors.Errorf("arrow/arrjson: invalid JSON data: %v", e)
 	}
 }
@@ -14,16 +14,21 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-package arrjson // import "github.com/apache/arrow/go/arrow/internal/arrjson"
+package arrjson // import "github.com/apache/arrow/go/arrow/arrjson"
 
 import (
+	"bytes"
 	"io"
 	"io/ioutil"
 	"os"
+	"reflect"
+	"strings"
 	"testing"
 
+	"github.com/apache/arrow/go/arrow"
 	"github.com/apache/arrow/go/arrow/array"
 	"github.com/apache/arrow/go/arrow/internal/arrdata"
+	"github.com/apache/arrow/go/arrow/json"
 	"github.com/apache/arrow/go/arrow/memory"
 )
 
@@ -40,6 +45,7 @@ func TestReadWrite(t *testing.T) {
 	wantJSONs["intervals"] = makeIntervalsWantJSONs()
 	wantJSONs["durations"] = makeDurationsWantJSONs()
 	wantJSONs["decimal128"] = makeDecimal128sWantJSONs()
+	wantJSONs["large"] = makeLargeWantJSONs()
 
 	tempDir, err := ioutil.TempDir("", "go-arrow-read-write-")
 	if err != nil {
@@ -49,9 +55,6 @@ func TestReadWrite(t *testing.T) {
 
 	for name, recs := range arrdata.Records {
 		t.Run(name, func(t *testing.T) {
-			if name == "decimal128" {
-				t.Skip() // FIXME(sbinet): implement full decimal128 support
-			}
 			mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
 			defer mem.AssertSize(t, 0)
 
@@ -135,6 +138,139 @@ func TestReadWrite(t *testing.T) {
 	}
 }
 
+func TestMarshalNested(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	md := arrow.NewMetadata([]string{"k"}, []string{"v"})
+	schema := arrow.NewSchema(
+		[]arrow.Field{
+			{Name: "timestamps", Type: arrow.ListOf(arrow.FixedWidthTypes.Timestamp_ms), Nullable: true, Metadata: md},
+			{Name: "lists", Type: arrow.ListOf(arrow.ListOf(arrow.PrimitiveTypes.Int8)), Nullable: true},
+			{Name: "strings", Type: arrow.FixedSizeListOf(2, arrow.BinaryTypes.String), Nullable: true},
+			{Name: "struct", Type: arrow.StructOf(
+				arrow.Field{Name: "fsb", Type: &arrow.FixedSizeBinaryType{ByteWidth: 2}, Nullable: true},
+				arrow.Field{Name: "dec", Type: &arrow.Decimal128Type{Precision: 5, Scale: 2}, Nullable: true},
+				arrow.Field{Name: "intervals", Type: arrow.ListOf(arrow.FixedWidthTypes.DayTimeInterval), Nullable: true},
+			), Nullable: true},
+		},
+		&md,
+	)
+
+	r := json.NewReader(strings.NewReader(`{"timestamps": [1, null, 3], "lists": [[1], [], null, [2, 3]], "strings": ["a", "b"], "struct": {"fsb": "AQI=", "dec": "1.5", "intervals": [{"days": 1, "milliseconds": 2}]}}
+{"timestamps": null, "lists": [], "strings": [null, "c"], "struct": null}
+{"timestamps": [], "lists": null, "strings": null, "struct": {"fsb": null, "dec": "-100.25", "intervals": null}}
+`), schema, json.WithAllocator(mem), json.WithChunk(-1))
+	defer r.Release()
+
+	if !r.Next() {
+		t.Fatalf("could not read record: %v", r.Err())
+	}
+	want := r.Record()
+
+	raw, err := MarshalSchema(schema)
+	if err != nil {
+		t.Fatalf("could not marshal schema: %v", err)
+	}
+	got, err := UnmarshalSchema(raw)
+	if err != nil {
+		t.Fatalf("could not unmarshal schema: %v", err)
+	}
+	if !got.Equal(schema) {
+		t.Fatalf("invalid schema\ngot:\n%v\nwant:\n%v\n", got, schema)
+	}
+	if got, want := got.Field(0).Metadata, md; !reflect.DeepEqual(got, want) {
+		t.Fatalf("invalid field metadata: got=%v, want=%v", got, want)
+	}
+	if got, want := got.Metadata(), md; !reflect.DeepEqual(got, want) {
+		t.Fatalf("invalid schema metadata: got=%v, want=%v", got, want)
+	}
+
+	raw, err = MarshalRecord(want)
+	if err != nil {
+		t.Fatalf("could not marshal record: %v", err)
+	}
+	rec, err := UnmarshalRecord(raw, schema, WithAllocator(mem))
+	if err != nil {
+		t.Fatalf("could not unmarshal record: %v", err)
+	}
+	defer rec.Release()
+
+	if !array.RecordEqual(rec, want) {
+		t.Fatalf("records differ\ngot:\n%s\nwant:\n%s\n", mustMarshal(t, rec), raw)
+	}
+}
+
+func mustMarshal(t *testing.T, rec array.Record) []byte {
+	raw, err := MarshalRecord(rec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return raw
+}
+
+func TestWriteEmpty(t *testing.T) {
+	schema := arrow.NewSchema([]arrow.Field{{Name: "i", Type: arrow.PrimitiveTypes.Int32}}, nil)
+
+	buf := new(bytes.Buffer)
+	w, err := NewWriter(buf, schema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := NewReader(buf)
+	if err != nil {
+		t.Fatalf("could not read empty JSON file: %v\n%s", err, buf.Bytes())
+	}
+	defer r.Release()
+
+	if got, want := r.NumRecords(), 0; got != want {
+		t.Fatalf("invalid number of records: got=%d, want=%d", got, want)
+	}
+	if !r.Schema().Equal(schema) {
+		t.Fatalf("invalid schema: got=%v, want=%v", r.Schema(), schema)
+	}
+}
+
+func TestInvalidJSON(t *testing.T) {
+	schema := arrow.NewSchema([]arrow.Field{{Name: "i", Type: arrow.PrimitiveTypes.Int32, Nullable: true}}, nil)
+
+	for _, tc := range []struct {
+		name string
+		fct  func() error
+	}{
+		{"syntax", func() error {
+			_, err := NewReader(strings.NewReader(`{"schema": `))
+			return err
+		}},
+		{"unknown-type", func() error {
+			_, err := UnmarshalSchema([]byte(`{"fields": [{"name": "f", "type": {"name": "unknown"}, "nullable": true, "children": []}]}`))
+			return err
+		}},
+		{"invalid-data", func() error {
+			_, err := UnmarshalRecord([]byte(`{"count": 1, "columns": [{"name": "i", "count": 1, "VALIDITY": [1], "DATA": ["x"]}]}`), schema)
+			return err
+		}},
+		{"missing-column", func() error {
+			_, err := UnmarshalRecord([]byte(`{"count": 1, "columns": []}`), schema)
+			return err
+		}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.fct()
+			if err == nil {
+				t.Fatalf("expected an error")
+			}
+			if !strings.HasPrefix(err.Error(), "arrow/arrjson: ") {
+				t.Fatalf("invalid error: %v", err)
+			}
+		})
+	}
+}
+
 func makeNullWantJSONs() string {
 	return `{
   "schema": {
@@ -147,6 +283,20 @@ func makeNullWantJSONs() string {
         "nullable": true,
         "children": []
       }
+    ],
+    "metadata": [
+      {
+        "key": "k1",
+        "value": "v1"
+      },
+      {
+        "key": "k2",
+        "value": "v2"
+      },
+      {
+        "key": "k3",
+        "value": "v3"
+      }
     ]
   },
   "batches": [
@@ -287,6 +437,20 @@ func makePrimitiveWantJSONs() string {
         "nullable": true,
         "children": []
       }
+    ],
+    "metadata": [
+      {
+        "key": "k1",
+        "value": "v1"
+      },
+      {
+        "key": "k2",
+        "value": "v2"
+      },
+      {
+        "key": "k3",
+        "value": "v3"
+      }
     ]
   },
   "batches": [
@@ -3100,5 +3264,287 @@ func makeDurationsWantJSONs() string {
 }
 
 func makeDecimal128sWantJSONs() string {
-	return `` // FIXME(fredgan): implement full decimal128 JSON support
-}
\ No newline at end of file
+	return `{
+  "schema": {
+    "fields": [
+      {
+        "name": "dec128s",
+        "type": {
+          "name": "decimal",
+          "precision": 10,
+          "scale": 1
+        },
+        "nullable": true,
+        "children": []
+      }
+    ]
+  },
+  "batches": [
+    {
+      "count": 5,
+      "columns": [
+        {
+          "name": "dec128s",
+          "count": 5,
+          "VALIDITY": [
+            1,
+            0,
+            0,
+            1,
+            1
+          ],
+          "DATA": [
+            "571849066284996100127",
+            "590295810358705651744",
+            "608742554432415203361",
+            "627189298506124754978",
+            "645636042579834306595"
+          ]
+        }
+      ]
+    },
+    {
+      "count": 5,
+      "columns": [
+        {
+          "name": "dec128s",
+          "count": 5,
+          "VALIDITY": [
+            1,
+            0,
+            0,
+            1,
+            1
+          ],
+          "DATA": [
+            "756316507022091616297",
+            "774763251095801167914",
+            "793209995169510719531",
+            "811656739243220271148",
+            "830103483316929822765"
+          ]
+        }
+      ]
+    },
+    {
+      "count": 5,
+      "columns": [
+        {
+          "name": "dec128s",
+          "count": 5,
+          "VALIDITY": [
+            1,
+            0,
+            0,
+            1,
+            1
+          ],
+          "DATA": [
+            "940783947759187132467",
+            "959230691832896684084",
+            "977677435906606235701",
+            "996124179980315787318",
+            "1014570924054025338935"
+          ]
+        }
+      ]
+    }
+  ]
+}`
+}
+
+func makeLargeWantJSONs() string {
+	return `{
+  "schema": {
+    "fields": [
+      {
+        "name": "large_strings",
+        "type": {
+          "name": "largeutf8"
+        },
+        "nullable": true,
+        "children": []
+      },
+      {
+        "name": "large_bytes",
+        "type": {
+          "name": "largebinary"
+        },
+        "nullable": true,
+        "children": []
+      },
+      {
+        "name": "large_list",
+        "type": {
+          "name": "largelist"
+        },
+        "nullable": true,
+        "children": [
+          {
+            "name": "item",
+            "type": {
+              "name": "int",
+              "isSigned": true,
+              "bitWidth": 32
+            },
+            "nullable": true,
+            "children": []
+          }
+        ]
+      }
+    ]
+  },
+  "batches": [
+    {
+      "count": 3,
+      "columns": [
+        {
+          "name": "large_strings",
+          "count": 3,
+          "VALIDITY": [
+            1,
+            0,
+            1
+          ],
+          "DATA": [
+            "1é",
+            "2",
+            "3"
+          ]
+        },
+        {
+          "name": "large_bytes",
+          "count": 3,
+          "VALIDITY": [
+            1,
+            0,
+            1
+          ],
+          "DATA": [
+            "31C3A9",
+            "32",
+            "33"
+          ],
+          "OFFSET": [
+            "0",
+            "3",
+            "4",
+            "5"
+          ]
+        },
+        {
+          "name": "large_list",
+          "count": 3,
+          "VALIDITY": [
+            1,
+            0,
+            1
+          ],
+          "OFFSET": [
+            "0",
+            "3",
+            "5",
+            "5"
+          ],
+          "children": [
+            {
+              "name": "item",
+              "count": 5,
+              "VALIDITY": [
+                1,
+                0,
+                1,
+                1,
+                1
+              ],
+              "DATA": [
+                1,
+                0,
+                3,
+                11,
+                12
+              ]
+            }
+          ]
+        }
+      ]
+    },
+    {
+      "count": 3,
+      "columns": [
+        {
+          "name": "large_strings",
+          "count": 3,
+          "VALIDITY": [
+            1,
+            1,
+            1
+          ],
+          "DATA": [
+            "11",
+            "",
+            "33"
+          ]
+        },
+        {
+          "name": "large_bytes",
+          "count": 3,
+          "VALIDITY": [
+            1,
+            1,
+            1
+          ],
+          "DATA": [
+            "3131",
+            "",
+            "3333"
+          ],
+          "OFFSET": [
+            "0",
+            "2",
+            "2",
+            "4"
+          ]
+        },
+        {
+          "name": "large_list",
+          "count": 3,
+          "VALIDITY": [
+            1,
+            1,
+            1
+          ],
+          "OFFSET": [
+            "0",
+            "3",
+            "5",
+            "6"
+          ],
+          "children": [
+            {
+              "name": "item",
+              "count": 6,
+              "VALIDITY": [
+                1,
+                1,
+                1,
+                0,
+                1,
+                1
+              ],
+              "DATA": [
+                -1,
+                -2,
+                -3,
+                0,
+                -12,
+                -21
+              ]
+            }
+          ]
+        }
+      ]
+    }
+  ]
+}`
+}
@@ -14,7 +14,7 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-package arrjson // import "github.com/apache/arrow/go/arrow/internal/arrjson"
+package arrjson // import "github.com/apache/arrow/go/arrow/arrjson"
 
 import (
 	"encoding/json"
@@ -25,8 +25,10 @@ import (
 	"github.com/apache/arrow/go/arrow/array"
 	"github.com/apache/arrow/go/arrow/arrio"
 	"github.com/apache/arrow/go/arrow/internal/debug"
+	"golang.org/x/xerrors"
 )
 
+// Reader reads the schema and the record batches of a JSON file.
 type Reader struct {
 	refs int64
 
@@ -36,16 +38,20 @@ type Reader struct {
 	irec int // current record index. used for the arrio.Reader interface.
 }
 
-func NewReader(r io.Reader, opts ...Option) (*Reader, error) {
+// NewReader decodes the whole JSON file from r and returns a reader over its
+// record batches.
+func NewReader(r io.Reader, opts ...Option) (rr *Reader, err error) {
+	defer recoverError(&err)
+
 	dec := json.NewDecoder(r)
 	dec.UseNumber()
 	var raw struct {
 		Schema  Schema   `json:"schema"`
 		Records []Record `json:"batches"`
 	}
-	err := dec.Decode(&raw)
+	err = dec.Decode(&raw)
 	if err != nil {
-		return nil, err
+		return nil, xerrors.Errorf("arrow/arrjson: could not decode JSON: %w", err)
 	}
 
 	cfg := newConfig()
@@ -54,7 +60,7 @@ func NewReader(r io.Reader, opts ...Option) (*Reader, error) {
 	}
 
 	schema := schemaFromJSON(raw.Schema)
-	rr := &Reader{
+	rr = &Reader{
 		refs:   1,
 		schema: schema,
 		recs:   recordsFromJSON(cfg.alloc, schema, raw.Records),
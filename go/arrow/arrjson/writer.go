@@ -14,7 +14,7 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-package arrjson // import "github.com/apache/arrow/go/arrow/internal/arrjson"
+package arrjson // import "github.com/apache/arrow/go/arrow/arrjson"
 
 import (
 	"encoding/json"
@@ -31,6 +31,8 @@ const (
 	jsonRecPrefix = "    "
 )
 
+// Writer writes a schema and record batches as a JSON file.
+// The JSON file is complete once Close has been called.
 type Writer struct {
 	w io.Writer
 
@@ -38,6 +40,8 @@ type Writer struct {
 	nrecs  int64
 }
 
+// NewWriter returns a writer that writes the schema and then the record
+// batches to w.
 func NewWriter(w io.Writer, schema *arrow.Schema) (*Writer, error) {
 	ww := &Writer{
 		w:      w,
@@ -100,11 +104,16 @@ func (w *Writer) writeSchema() error {
 	return nil
 }
 
+// Close terminates the JSON file. It does not close the underlying io.Writer.
 func (w *Writer) Close() error {
 	if w.w == nil {
 		return nil
 	}
-	_, err := w.w.Write([]byte("\n  ]\n}"))
+	end := "\n  ]\n}"
+	if w.nrecs == 0 {
+		end = ",\n" + jsonPrefix + `"batches": []` + "\n}"
+	}
+	_, err := w.w.Write([]byte(end))
 	if err == nil {
 		w.w = nil
 	}
@@ -0,0 +1,214 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package arrstruct converts Go structs to and from records, using
+// reflection.
+//
+// Each exported field of a struct is a field of the schema, with a data type
+// derived from its Go type:
+//   - booleans, integers, floating-point values and strings are mapped to the
+//     corresponding Arrow types. int and uint are mapped to int64 and uint64.
+//   - float16.Num and decimal128.Num are mapped to float16 and decimal128.
+//   - []byte is mapped to binary and [N]byte to fixed-size binary.
+//   - time.Time is mapped to timestamp and time.Duration to duration, in
+//     nanoseconds unless configured otherwise.
+//   - arrow.Date32, arrow.Date64, arrow.MonthInterval and arrow.DayTimeInterval
+//     are mapped to their Arrow types.
+//   - other slices are mapped to lists, and other arrays to fixed-size lists.
+//   - structs are mapped to structs.
+//   - maps are mapped to lists of struct<key, value>, with entries sorted by
+//     key.
+//   - pointers are mapped to the type they point to, and are nullable: nil
+//     pointers are null values.
+//
+// The mapping of a field may be configured with an "arrow" struct tag,
+// holding the name of the field followed by comma-separated options:
+//   - "nullable" makes the field nullable. nil slices and maps are then null
+//     values.
+//   - "unit=s", "unit=ms", "unit=us" or "unit=ns" specify the unit of
+//     timestamps and durations.
+//   - "tz=Europe/Paris" specifies the time zone of timestamps.
+//   - "precision=10" and "scale=2" specify the precision and scale of
+//     decimals. The default precision is 38 and the default scale is 0.
+//
+// Options of lists, fixed-size lists and maps apply to their elements.
+// Fields with the "-" tag are ignored.
+//
+// For example:
+//
+//	type Row struct {
+//		ID      int64
+//		Name    string            `arrow:"name"`
+//		Created time.Time         `arrow:"created,unit=ms,tz=UTC"`
+//		Price   *decimal128.Num   `arrow:"price,precision=10,scale=2"`
+//		Tags    []string          `arrow:"tags,nullable"`
+//		Attrs   map[string]string `arrow:"attrs"`
+//		Cache   []byte            `arrow:"-"`
+//	}
+package arrstruct // import "github.com/apache/arrow/go/arrow/arrstruct"
+
+import (
+	"reflect"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/memory"
+	"golang.org/x/xerrors"
+)
+
+// SchemaOf returns the schema of the records holding values of the Go struct
+// type of v.
+// v may be a struct, a pointer to a struct, or a slice of structs or of
+// pointers to structs.
+func SchemaOf(v interface{}) (*arrow.Schema, error) {
+	t, err := rowType(reflect.TypeOf(v))
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := newStructCodec(t, make(typeSet))
+	if err != nil {
+		return nil, err
+	}
+	return arrow.NewSchema(c.fields(), nil), nil
+}
+
+// Append appends the values of v, as rows, to the record builder.
+// v may be a struct, a pointer to a struct, or a slice of structs or of
+// pointers to structs. nil pointers to structs are appended as rows of nulls.
+//
+// The schema of the record builder must be the schema returned by SchemaOf.
+func Append(bld *array.RecordBuilder, v interface{}) error {
+	t, err := rowType(reflect.TypeOf(v))
+	if err != nil {
+		return err
+	}
+	rv := reflect.ValueOf(v)
+
+	c, err := newStructCodec(t, make(typeSet))
+	if err != nil {
+		return err
+	}
+	if schema := arrow.NewSchema(c.fields(), nil); !schema.Equal(bld.Schema()) {
+		return xerrors.Errorf("arrow/arrstruct: schema of %v does not match schema of record builder", t)
+	}
+
+	switch rv.Kind() {
+	case reflect.Slice:
+		for i := 0; i < rv.Len(); i++ {
+			c.appendRow(bld, rv.Index(i))
+		}
+	default:
+		c.appendRow(bld, rv)
+	}
+	return nil
+}
+
+// NewRecord returns a record holding the values of v, as rows, with the
+// schema returned by SchemaOf.
+// v may be a struct, a pointer to a struct, or a slice of structs or of
+// pointers to structs.
+func NewRecord(mem memory.Allocator, v interface{}) (array.Record, error) {
+	schema, err := SchemaOf(v)
+	if err != nil {
+		return nil, err
+	}
+
+	bld := array.NewRecordBuilder(mem, schema)
+	defer bld.Release()
+
+	err = Append(bld, v)
+	if err != nil {
+		return nil, err
+	}
+	return bld.NewRecord(), nil
+}
+
+// Decode decodes the rows of the record into the slice pointed to by v, whose
+// elements are structs or pointers to structs.
+// The slice is resized to the number of rows of the record.
+//
+// Columns are matched by name with the fields of the struct. Fields without
+// a column are left to their zero value, and columns without a field are
+// ignored. Null values are decoded as nil pointers, slices and maps, or as
+// zero values.
+//
+// Decode returns an error if the type of a column does not match the type of
+// its field.
+func Decode(rec array.Record, v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Slice {
+		return xerrors.Errorf("arrow/arrstruct: invalid value of type %T, want pointer to slice", v)
+	}
+	slice := rv.Elem()
+
+	t, err := rowType(slice.Type())
+	if err != nil {
+		return err
+	}
+
+	c, err := newStructCodec(t, make(typeSet))
+	if err != nil {
+		return err
+	}
+
+	cols := make([]array.Interface, len(c.names))
+	for i, name := range c.names {
+		idx := rec.Schema().FieldIndices(name)
+		if len(idx) == 0 {
+			continue
+		}
+		col := rec.Column(idx[0])
+		if !arrow.TypeEqual(col.DataType(), c.codecs[i].dtype) {
+			return xerrors.Errorf(
+				"arrow/arrstruct: column %q has type %v, want %v",
+				name, col.DataType(), c.codecs[i].dtype,
+			)
+		}
+		cols[i] = col
+	}
+
+	n := int(rec.NumRows())
+	slice.Set(reflect.MakeSlice(slice.Type(), n, n))
+	for i := 0; i < n; i++ {
+		row := slice.Index(i)
+		if row.Kind() == reflect.Ptr {
+			row.Set(reflect.New(t))
+			row = row.Elem()
+		}
+		c.decodeRow(cols, i, row)
+	}
+	return nil
+}
+
+// rowType returns the struct type of t, after dereferencing pointers and
+// slices.
+func rowType(t reflect.Type) (reflect.Type, error) {
+	if t == nil {
+		return nil, xerrors.Errorf("arrow/arrstruct: invalid nil value")
+	}
+	rt := t
+	if rt.Kind() == reflect.Slice {
+		rt = rt.Elem()
+	}
+	if rt.Kind() == reflect.Ptr {
+		rt = rt.Elem()
+	}
+	if rt.Kind() != reflect.Struct {
+		return nil, xerrors.Errorf("arrow/arrstruct: invalid type %v, want struct", t)
+	}
+	return rt, nil
+}
@@ -0,0 +1,315 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package arrstruct_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/arrstruct"
+	"github.com/apache/arrow/go/arrow/decimal128"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+type point struct {
+	X, Y float64
+}
+
+type row struct {
+	ID       int64
+	Name     string           `arrow:"name"`
+	Active   bool             `arrow:"active"`
+	Score    *float32         `arrow:"score"`
+	Created  time.Time        `arrow:"created,unit=ms"`
+	Elapsed  time.Duration    `arrow:"elapsed,unit=us"`
+	Price    decimal128.Num   `arrow:"price,precision=10,scale=2"`
+	Data     []byte           `arrow:"data"`
+	Hash     [4]byte          `arrow:"hash"`
+	Tags     []string         `arrow:"tags,nullable"`
+	Pos      point            `arrow:"pos"`
+	Path     *[2]point        `arrow:"path"`
+	Attrs    map[string]int32 `arrow:"attrs"`
+	Ignored  string           `arrow:"-"`
+	internal int
+}
+
+func TestSchemaOf(t *testing.T) {
+	pointType := arrow.StructOf(
+		arrow.Field{Name: "X", Type: arrow.PrimitiveTypes.Float64},
+		arrow.Field{Name: "Y", Type: arrow.PrimitiveTypes.Float64},
+	)
+	want := arrow.NewSchema([]arrow.Field{
+		{Name: "ID", Type: arrow.PrimitiveTypes.Int64},
+		{Name: "name", Type: arrow.BinaryTypes.String},
+		{Name: "active", Type: arrow.FixedWidthTypes.Boolean},
+		{Name: "score", Type: arrow.PrimitiveTypes.Float32, Nullable: true},
+		{Name: "created", Type: &arrow.TimestampType{Unit: arrow.Millisecond}},
+		{Name: "elapsed", Type: &arrow.DurationType{Unit: arrow.Microsecond}},
+		{Name: "price", Type: &arrow.Decimal128Type{Precision: 10, Scale: 2}},
+		{Name: "data", Type: arrow.BinaryTypes.Binary},
+		{Name: "hash", Type: &arrow.FixedSizeBinaryType{ByteWidth: 4}},
+		{Name: "tags", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true},
+		{Name: "pos", Type: pointType},
+		{Name: "path", Type: arrow.FixedSizeListOf(2, pointType), Nullable: true},
+		{Name: "attrs", Type: arrow.ListOf(arrow.StructOf(
+			arrow.Field{Name: "key", Type: arrow.BinaryTypes.String},
+			arrow.Field{Name: "value", Type: arrow.PrimitiveTypes.Int32},
+		))},
+	}, nil)
+
+	for _, v := range []interface{}{row{}, &row{}, []row{}, []*row{}} {
+		got, err := arrstruct.SchemaOf(v)
+		if err != nil {
+			t.Fatalf("could not infer schema of %T: %v", v, err)
+		}
+		if !got.Equal(want) {
+			t.Fatalf("invalid schema of %T:\ngot=%v\nwant=%v", v, got, want)
+		}
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	score := float32(1.5)
+	want := []row{
+		{
+			ID:      1,
+			Name:    "one",
+			Active:  true,
+			Score:   &score,
+			Created: time.Date(2020, 1, 2, 3, 4, 5, 6e6, time.UTC),
+			Elapsed: 3 * time.Millisecond,
+			Price:   decimal128.FromI64(12345),
+			Data:    []byte("data"),
+			Hash:    [4]byte{1, 2, 3, 4},
+			Tags:    []string{"a", "b"},
+			Pos:     point{1, 2},
+			Path:    &[2]point{{1, 2}, {3, 4}},
+			Attrs:   map[string]int32{"b": 2, "a": 1},
+		},
+		{
+			ID:      2,
+			Created: time.Unix(0, 0).UTC(),
+			Data:    []byte{},
+			Tags:    []string{},
+			Attrs:   map[string]int32{},
+		},
+	}
+
+	rec, err := arrstruct.NewRecord(mem, want)
+	if err != nil {
+		t.Fatalf("could not create record: %v", err)
+	}
+	defer rec.Release()
+
+	if got, want := rec.NumRows(), int64(len(want)); got != want {
+		t.Fatalf("invalid number of rows: got=%d, want=%d", got, want)
+	}
+	if got, want := rec.Column(3).NullN(), 1; got != want {
+		t.Fatalf("invalid number of null scores: got=%d, want=%d", got, want)
+	}
+	if got, want := rec.Column(9).NullN(), 0; got != want {
+		t.Fatalf("invalid number of null tags: got=%d, want=%d", got, want)
+	}
+
+	var got []row
+	err = arrstruct.Decode(rec, &got)
+	if err != nil {
+		t.Fatalf("could not decode record: %v", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("invalid rows:\ngot= %+v\nwant=%+v", got, want)
+	}
+
+	slice := rec.NewSlice(1, 2)
+	defer slice.Release()
+
+	var ptrs []*row
+	err = arrstruct.Decode(slice, &ptrs)
+	if err != nil {
+		t.Fatalf("could not decode sliced record: %v", err)
+	}
+	if len(ptrs) != 1 || !reflect.DeepEqual(*ptrs[0], want[1]) {
+		t.Fatalf("invalid sliced rows:\ngot= %+v\nwant=%+v", ptrs, want[1:])
+	}
+}
+
+func TestAppendNulls(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	type nullable struct {
+		A *int32            `arrow:"a"`
+		B []int32           `arrow:"b,nullable"`
+		C *point            `arrow:"c"`
+		D map[string]string `arrow:"d,nullable"`
+		E *[2]*int8         `arrow:"e"`
+	}
+
+	schema, err := arrstruct.SchemaOf([]*nullable{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bld := array.NewRecordBuilder(mem, schema)
+	defer bld.Release()
+
+	err = arrstruct.Append(bld, []*nullable{nil, {}})
+	if err != nil {
+		t.Fatalf("could not append rows: %v", err)
+	}
+	rec := bld.NewRecord()
+	defer rec.Release()
+
+	for i, col := range rec.Columns() {
+		if got, want := col.NullN(), 2; got != want {
+			t.Fatalf("invalid number of nulls in column %d: got=%d, want=%d", i, got, want)
+		}
+	}
+
+	var got []nullable
+	err = arrstruct.Decode(rec, &got)
+	if err != nil {
+		t.Fatalf("could not decode record: %v", err)
+	}
+	if want := make([]nullable, 2); !reflect.DeepEqual(got, want) {
+		t.Fatalf("invalid rows:\ngot= %+v\nwant=%+v", got, want)
+	}
+}
+
+func TestAppendNullStruct(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	type path struct {
+		Name  string    `arrow:"name"`
+		Steps *[2]point `arrow:"steps"`
+	}
+	type item struct {
+		P *path `arrow:"p"`
+	}
+
+	want := []item{
+		{},
+		{P: &path{Name: "a", Steps: &[2]point{{1, 2}, {3, 4}}}},
+		{P: &path{Name: "b"}},
+	}
+
+	rec, err := arrstruct.NewRecord(mem, want)
+	if err != nil {
+		t.Fatalf("could not create record: %v", err)
+	}
+	defer rec.Release()
+
+	if got, want := rec.Column(0).NullN(), 1; got != want {
+		t.Fatalf("invalid number of null structs: got=%d, want=%d", got, want)
+	}
+
+	var got []item
+	err = arrstruct.Decode(rec, &got)
+	if err != nil {
+		t.Fatalf("could not decode record: %v", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("invalid rows:\ngot= %+v\nwant=%+v", got, want)
+	}
+}
+
+// node, tree and forest are recursive types, which have no Arrow data type.
+type (
+	node struct {
+		Children []node
+	}
+	tree struct {
+		Forest *forest
+	}
+	forest struct {
+		Trees map[string]tree
+	}
+)
+
+func TestErrors(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	type (
+		unsupported struct {
+			C chan int
+		}
+		invalidTag struct {
+			T time.Time `arrow:"t,unit=h"`
+		}
+		duplicate struct {
+			A int32
+			B int32 `arrow:"A"`
+		}
+		other struct {
+			ID string
+		}
+	)
+
+	for _, v := range []interface{}{
+		nil,
+		42,
+		[]int{},
+		unsupported{},
+		invalidTag{},
+		duplicate{},
+		node{},
+		[]*tree{},
+	} {
+		_, err := arrstruct.SchemaOf(v)
+		if err == nil {
+			t.Fatalf("expected an error for %T", v)
+		}
+	}
+
+	rec, err := arrstruct.NewRecord(mem, []row{{ID: 1}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rec.Release()
+
+	var rows []other
+	err = arrstruct.Decode(rec, &rows)
+	if err == nil {
+		t.Fatalf("expected an error on mismatching types")
+	}
+
+	err = arrstruct.Decode(rec, rows)
+	if err == nil {
+		t.Fatalf("expected an error on non-pointer value")
+	}
+
+	bld := array.NewRecordBuilder(mem, rec.Schema())
+	defer bld.Release()
+
+	err = arrstruct.Append(bld, []other{{ID: "1"}})
+	if err == nil {
+		t.Fatalf("expected an error on mismatching schema")
+	}
+
+	err = arrstruct.Append(bld, nil)
+	if err == nil {
+		t.Fatalf("expected an error on nil value")
+	}
+}
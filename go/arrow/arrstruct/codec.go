@@ -0,0 +1,731 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package arrstruct
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/decimal128"
+	"github.com/apache/arrow/go/arrow/float16"
+	"github.com/apache/arrow/go/arrow/internal/arrtime"
+	"golang.org/x/xerrors"
+)
+
+var (
+	timeType            = reflect.TypeOf(time.Time{})
+	durationType        = reflect.TypeOf(time.Duration(0))
+	float16Type         = reflect.TypeOf(float16.Num{})
+	decimal128Type      = reflect.TypeOf(decimal128.Num{})
+	date32Type          = reflect.TypeOf(arrow.Date32(0))
+	date64Type          = reflect.TypeOf(arrow.Date64(0))
+	monthIntervalType   = reflect.TypeOf(arrow.MonthInterval(0))
+	dayTimeIntervalType = reflect.TypeOf(arrow.DayTimeInterval{})
+)
+
+// tagOptions are the options of the "arrow" struct tag of a field.
+type tagOptions struct {
+	name      string
+	skip      bool
+	nullable  bool
+	unit      arrow.TimeUnit
+	tz        string
+	precision int32
+	scale     int32
+}
+
+func parseTag(f reflect.StructField) (tagOptions, error) {
+	opts := tagOptions{
+		name:      f.Name,
+		unit:      arrow.Nanosecond,
+		precision: 38,
+	}
+
+	tag, ok := f.Tag.Lookup("arrow")
+	if !ok {
+		return opts, nil
+	}
+	if tag == "-" {
+		opts.skip = true
+		return opts, nil
+	}
+
+	toks := strings.Split(tag, ",")
+	if toks[0] != "" {
+		opts.name = toks[0]
+	}
+	for _, tok := range toks[1:] {
+		var (
+			key = tok
+			val string
+		)
+		if i := strings.Index(tok, "="); i >= 0 {
+			key, val = tok[:i], tok[i+1:]
+		}
+
+		var err error
+		switch key {
+		case "nullable":
+			opts.nullable = true
+		case "unit":
+			switch val {
+			case "s":
+				opts.unit = arrow.Second
+			case "ms":
+				opts.unit = arrow.Millisecond
+			case "us":
+				opts.unit = arrow.Microsecond
+			case "ns":
+				opts.unit = arrow.Nanosecond
+			default:
+				err = xerrors.Errorf("invalid time unit %q", val)
+			}
+		case "tz":
+			_, err = time.LoadLocation(val)
+			opts.tz = val
+		case "precision":
+			opts.precision, err = parseInt32(val)
+		case "scale":
+			opts.scale, err = parseInt32(val)
+		default:
+			err = xerrors.Errorf("unknown option %q", tok)
+		}
+		if err != nil {
+			return opts, xerrors.Errorf("arrow/arrstruct: invalid tag of field %s: %w", f.Name, err)
+		}
+	}
+	return opts, nil
+}
+
+func parseInt32(s string) (int32, error) {
+	v, err := strconv.ParseInt(s, 10, 32)
+	return int32(v), err
+}
+
+// codec converts values of a Go type to and from values of an array.
+type codec struct {
+	dtype    arrow.DataType
+	nullable bool
+
+	// append appends the Go value v to b.
+	append func(b array.Builder, v reflect.Value)
+	// appendNull appends a null value to b, along with the child values
+	// expected by struct and fixed-size list arrays.
+	appendNull func(b array.Builder)
+	// decode sets the Go value v to the valid i-th value of arr.
+	decode func(arr array.Interface, i int, v reflect.Value)
+}
+
+// decodeValue sets the Go value v to the i-th value of arr, or to its zero
+// value if it is null.
+func (c *codec) decodeValue(arr array.Interface, i int, v reflect.Value) {
+	if arr.IsNull(i) {
+		v.Set(reflect.Zero(v.Type()))
+		return
+	}
+	c.decode(arr, i, v)
+}
+
+func appendNull(b array.Builder) { b.AppendNull() }
+
+// structCodec converts Go structs to and from rows of records.
+type structCodec struct {
+	index  [][]int // indices of the Go fields, as used by reflect.Value.FieldByIndex
+	names  []string
+	codecs []*codec
+}
+
+// typeSet is a set of Go types.
+type typeSet map[reflect.Type]bool
+
+// newStructCodec returns the codec of the Go struct type t.
+// parents holds the struct types whose codecs are being built and contain t,
+// as recursive types have no Arrow data type.
+func newStructCodec(t reflect.Type, parents typeSet) (*structCodec, error) {
+	if parents[t] {
+		return nil, xerrors.Errorf("arrow/arrstruct: recursive type %v is not supported", t)
+	}
+	parents[t] = true
+	defer delete(parents, t)
+
+	c := &structCodec{}
+	seen := make(map[string]bool)
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" {
+			// unexported field.
+			continue
+		}
+
+		opts, err := parseTag(f)
+		if err != nil {
+			return nil, err
+		}
+		if opts.skip {
+			continue
+		}
+		if seen[opts.name] {
+			return nil, xerrors.Errorf("arrow/arrstruct: duplicate field name %q in %v", opts.name, t)
+		}
+		seen[opts.name] = true
+
+		fc, err := newCodec(f.Type, opts, parents)
+		if err != nil {
+			return nil, xerrors.Errorf("arrow/arrstruct: field %s of %v: %w", f.Name, t, err)
+		}
+
+		c.index = append(c.index, f.Index)
+		c.names = append(c.names, opts.name)
+		c.codecs = append(c.codecs, fc)
+	}
+	return c, nil
+}
+
+func (c *structCodec) fields() []arrow.Field {
+	fields := make([]arrow.Field, len(c.codecs))
+	for i, fc := range c.codecs {
+		fields[i] = arrow.Field{Name: c.names[i], Type: fc.dtype, Nullable: fc.nullable}
+	}
+	return fields
+}
+
+// appendRow appends the Go struct, or pointer to struct, v to the fields of
+// bld.
+func (c *structCodec) appendRow(bld *array.RecordBuilder, v reflect.Value) {
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			for i, fc := range c.codecs {
+				fc.appendNull(bld.Field(i))
+			}
+			return
+		}
+		v = v.Elem()
+	}
+	for i, fc := range c.codecs {
+		fc.append(bld.Field(i), v.FieldByIndex(c.index[i]))
+	}
+}
+
+// decodeRow sets the fields of the Go struct v to the i-th values of cols.
+// Fields with a nil column are left untouched.
+func (c *structCodec) decodeRow(cols []array.Interface, i int, v reflect.Value) {
+	for k, fc := range c.codecs {
+		if cols[k] == nil {
+			continue
+		}
+		fc.decodeValue(cols[k], i, v.FieldByIndex(c.index[k]))
+	}
+}
+
+// newCodec returns the codec of the Go type t, configured with opts.
+func newCodec(t reflect.Type, opts tagOptions, parents typeSet) (*codec, error) {
+	if t.Kind() == reflect.Ptr {
+		elem, err := newCodec(t.Elem(), opts, parents)
+		if err != nil {
+			return nil, err
+		}
+		return &codec{
+			dtype:    elem.dtype,
+			nullable: true,
+			append: func(b array.Builder, v reflect.Value) {
+				if v.IsNil() {
+					elem.appendNull(b)
+					return
+				}
+				elem.append(b, v.Elem())
+			},
+			appendNull: elem.appendNull,
+			decode: func(arr array.Interface, i int, v reflect.Value) {
+				if v.IsNil() {
+					v.Set(reflect.New(t.Elem()))
+				}
+				elem.decode(arr, i, v.Elem())
+			},
+		}, nil
+	}
+
+	c, err := newValueCodec(t, opts, parents)
+	if err != nil {
+		return nil, err
+	}
+	if c.appendNull == nil {
+		c.appendNull = appendNull
+	}
+
+	c.nullable = opts.nullable
+	switch t.Kind() {
+	case reflect.Slice, reflect.Map:
+		if opts.nullable {
+			app := c.append
+			c.append = func(b array.Builder, v reflect.Value) {
+				if v.IsNil() {
+					b.AppendNull()
+					return
+				}
+				app(b, v)
+			}
+		}
+	}
+	return c, nil
+}
+
+// elemOptions returns the options of the elements of lists and maps.
+func elemOptions(opts tagOptions) tagOptions {
+	opts.nullable = false
+	return opts
+}
+
+// newValueCodec returns the codec of the non-pointer Go type t.
+func newValueCodec(t reflect.Type, opts tagOptions, parents typeSet) (*codec, error) {
+	switch t {
+	case timeType:
+		dt := &arrow.TimestampType{Unit: opts.unit, TimeZone: opts.tz}
+		loc := time.UTC
+		if opts.tz != "" {
+			// time zone validity has been checked by parseTag.
+			loc, _ = time.LoadLocation(opts.tz)
+		}
+		return &codec{
+			dtype: dt,
+			append: func(b array.Builder, v reflect.Value) {
+				t := v.Interface().(time.Time)
+				b.(*array.TimestampBuilder).Append(arrow.Timestamp(arrtime.FromTime(t, dt.Unit)))
+			},
+			decode: func(arr array.Interface, i int, v reflect.Value) {
+				t := arrtime.ToTime(int64(arr.(*array.Timestamp).Value(i)), dt.Unit)
+				v.Set(reflect.ValueOf(t.In(loc)))
+			},
+		}, nil
+	case durationType:
+		dt := &arrow.DurationType{Unit: opts.unit}
+		return &codec{
+			dtype: dt,
+			append: func(b array.Builder, v reflect.Value) {
+				d := time.Duration(v.Int())
+				b.(*array.DurationBuilder).Append(arrow.Duration(d / dt.Unit.Multiplier()))
+			},
+			decode: func(arr array.Interface, i int, v reflect.Value) {
+				d := time.Duration(arr.(*array.Duration).Value(i))
+				v.SetInt(int64(d * dt.Unit.Multiplier()))
+			},
+		}, nil
+	case float16Type:
+		return &codec{
+			dtype: arrow.FixedWidthTypes.Float16,
+			append: func(b array.Builder, v reflect.Value) {
+				b.(*array.Float16Builder).Append(v.Interface().(float16.Num))
+			},
+			decode: func(arr array.Interface, i int, v reflect.Value) {
+				v.Set(reflect.ValueOf(arr.(*array.Float16).Value(i)))
+			},
+		}, nil
+	case decimal128Type:
+		return &codec{
+			dtype: &arrow.Decimal128Type{Precision: opts.precision, Scale: opts.scale},
+			append: func(b array.Builder, v reflect.Value) {
+				b.(*array.Decimal128Builder).Append(v.Interface().(decimal128.Num))
+			},
+			decode: func(arr array.Interface, i int, v reflect.Value) {
+				v.Set(reflect.ValueOf(arr.(*array.Decimal128).Value(i)))
+			},
+		}, nil
+	case date32Type:
+		return &codec{
+			dtype: arrow.FixedWidthTypes.Date32,
+			append: func(b array.Builder, v reflect.Value) {
+				b.(*array.Date32Builder).Append(arrow.Date32(v.Int()))
+			},
+			decode: func(arr array.Interface, i int, v reflect.Value) {
+				v.SetInt(int64(arr.(*array.Date32).Value(i)))
+			},
+		}, nil
+	case date64Type:
+		return &codec{
+			dtype: arrow.FixedWidthTypes.Date64,
+			append: func(b array.Builder, v reflect.Value) {
+				b.(*array.Date64Builder).Append(arrow.Date64(v.Int()))
+			},
+			decode: func(arr array.Interface, i int, v reflect.Value) {
+				v.SetInt(int64(arr.(*array.Date64).Value(i)))
+			},
+		}, nil
+	case monthIntervalType:
+		return &codec{
+			dtype: arrow.FixedWidthTypes.MonthInterval,
+			append: func(b array.Builder, v reflect.Value) {
+				b.(*array.MonthIntervalBuilder).Append(arrow.MonthInterval(v.Int()))
+			},
+			decode: func(arr array.Interface, i int, v reflect.Value) {
+				v.SetInt(int64(arr.(*array.MonthInterval).Value(i)))
+			},
+		}, nil
+	case dayTimeIntervalType:
+		return &codec{
+			dtype: arrow.FixedWidthTypes.DayTimeInterval,
+			append: func(b array.Builder, v reflect.Value) {
+				b.(*array.DayTimeIntervalBuilder).Append(v.Interface().(arrow.DayTimeInterval))
+			},
+			decode: func(arr array.Interface, i int, v reflect.Value) {
+				v.Set(reflect.ValueOf(arr.(*array.DayTimeInterval).Value(i)))
+			},
+		}, nil
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return &codec{
+			dtype: arrow.FixedWidthTypes.Boolean,
+			append: func(b array.Builder, v reflect.Value) {
+				b.(*array.BooleanBuilder).Append(v.Bool())
+			},
+			decode: func(arr array.Interface, i int, v reflect.Value) {
+				v.SetBool(arr.(*array.Boolean).Value(i))
+			},
+		}, nil
+	case reflect.Int8:
+		return &codec{
+			dtype: arrow.PrimitiveTypes.Int8,
+			append: func(b array.Builder, v reflect.Value) {
+				b.(*array.Int8Builder).Append(int8(v.Int()))
+			},
+			decode: func(arr array.Interface, i int, v reflect.Value) {
+				v.SetInt(int64(arr.(*array.Int8).Value(i)))
+			},
+		}, nil
+	case reflect.Int16:
+		return &codec{
+			dtype: arrow.PrimitiveTypes.Int16,
+			append: func(b array.Builder, v reflect.Value) {
+				b.(*array.Int16Builder).Append(int16(v.Int()))
+			},
+			decode: func(arr array.Interface, i int, v reflect.Value) {
+				v.SetInt(int64(arr.(*array.Int16).Value(i)))
+			},
+		}, nil
+	case reflect.Int32:
+		return &codec{
+			dtype: arrow.PrimitiveTypes.Int32,
+			append: func(b array.Builder, v reflect.Value) {
+				b.(*array.Int32Builder).Append(int32(v.Int()))
+			},
+			decode: func(arr array.Interface, i int, v reflect.Value) {
+				v.SetInt(int64(arr.(*array.Int32).Value(i)))
+			},
+		}, nil
+	case reflect.Int64, reflect.Int:
+		return &codec{
+			dtype: arrow.PrimitiveTypes.Int64,
+			append: func(b array.Builder, v reflect.Value) {
+				b.(*array.Int64Builder).Append(v.Int())
+			},
+			decode: func(arr array.Interface, i int, v reflect.Value) {
+				v.SetInt(arr.(*array.Int64).Value(i))
+			},
+		}, nil
+	case reflect.Uint8:
+		return &codec{
+			dtype: arrow.PrimitiveTypes.Uint8,
+			append: func(b array.Builder, v reflect.Value) {
+				b.(*array.Uint8Builder).Append(uint8(v.Uint()))
+			},
+			decode: func(arr array.Interface, i int, v reflect.Value) {
+				v.SetUint(uint64(arr.(*array.Uint8).Value(i)))
+			},
+		}, nil
+	case reflect.Uint16:
+		return &codec{
+			dtype: arrow.PrimitiveTypes.Uint16,
+			append: func(b array.Builder, v reflect.Value) {
+				b.(*array.Uint16Builder).Append(uint16(v.Uint()))
+			},
+			decode: func(arr array.Interface, i int, v reflect.Value) {
+				v.SetUint(uint64(arr.(*array.Uint16).Value(i)))
+			},
+		}, nil
+	case reflect.Uint32:
+		return &codec{
+			dtype: arrow.PrimitiveTypes.Uint32,
+			append: func(b array.Builder, v reflect.Value) {
+				b.(*array.Uint32Builder).Append(uint32(v.Uint()))
+			},
+			decode: func(arr array.Interface, i int, v reflect.Value) {
+				v.SetUint(uint64(arr.(*array.Uint32).Value(i)))
+			},
+		}, nil
+	case reflect.Uint64, reflect.Uint:
+		return &codec{
+			dtype: arrow.PrimitiveTypes.Uint64,
+			append: func(b array.Builder, v reflect.Value) {
+				b.(*array.Uint64Builder).Append(v.Uint())
+			},
+			decode: func(arr array.Interface, i int, v reflect.Value) {
+				v.SetUint(arr.(*array.Uint64).Value(i))
+			},
+		}, nil
+	case reflect.Float32:
+		return &codec{
+			dtype: arrow.PrimitiveTypes.Float32,
+			append: func(b array.Builder, v reflect.Value) {
+				b.(*array.Float32Builder).Append(float32(v.Float()))
+			},
+			decode: func(arr array.Interface, i int, v reflect.Value) {
+				v.SetFloat(float64(arr.(*array.Float32).Value(i)))
+			},
+		}, nil
+	case reflect.Float64:
+		return &codec{
+			dtype: arrow.PrimitiveTypes.Float64,
+			append: func(b array.Builder, v reflect.Value) {
+				b.(*array.Float64Builder).Append(v.Float())
+			},
+			decode: func(arr array.Interface, i int, v reflect.Value) {
+				v.SetFloat(arr.(*array.Float64).Value(i))
+			},
+		}, nil
+	case reflect.String:
+		return &codec{
+			dtype: arrow.BinaryTypes.String,
+			append: func(b array.Builder, v reflect.Value) {
+				b.(*array.StringBuilder).Append(v.String())
+			},
+			decode: func(arr array.Interface, i int, v reflect.Value) {
+				v.SetString(arr.(*array.String).Value(i))
+			},
+		}, nil
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return newBinaryCodec(t), nil
+		}
+		return newListCodec(t, opts, parents)
+	case reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return newFixedSizeBinaryCodec(t), nil
+		}
+		return newFixedSizeListCodec(t, opts, parents)
+	case reflect.Struct:
+		return newNestedStructCodec(t, parents)
+	case reflect.Map:
+		return newMapCodec(t, opts, parents)
+	}
+
+	return nil, xerrors.Errorf("unsupported Go type %v", t)
+}
+
+func newBinaryCodec(t reflect.Type) *codec {
+	return &codec{
+		dtype: arrow.BinaryTypes.Binary,
+		append: func(b array.Builder, v reflect.Value) {
+			b.(*array.BinaryBuilder).Append(v.Bytes())
+		},
+		decode: func(arr array.Interface, i int, v reflect.Value) {
+			// values of the array are copied, as they share its memory.
+			buf := arr.(*array.Binary).Value(i)
+			o := reflect.MakeSlice(t, len(buf), len(buf))
+			reflect.Copy(o, reflect.ValueOf(buf))
+			v.Set(o)
+		},
+	}
+}
+
+func newFixedSizeBinaryCodec(t reflect.Type) *codec {
+	n := t.Len()
+	return &codec{
+		dtype: &arrow.FixedSizeBinaryType{ByteWidth: n},
+		append: func(b array.Builder, v reflect.Value) {
+			buf := make([]byte, n)
+			reflect.Copy(reflect.ValueOf(buf), v)
+			b.(*array.FixedSizeBinaryBuilder).Append(buf)
+		},
+		decode: func(arr array.Interface, i int, v reflect.Value) {
+			reflect.Copy(v, reflect.ValueOf(arr.(*array.FixedSizeBinary).Value(i)))
+		},
+	}
+}
+
+func newListCodec(t reflect.Type, opts tagOptions, parents typeSet) (*codec, error) {
+	elem, err := newCodec(t.Elem(), elemOptions(opts), parents)
+	if err != nil {
+		return nil, err
+	}
+
+	return &codec{
+		dtype: arrow.ListOf(elem.dtype),
+		append: func(b array.Builder, v reflect.Value) {
+			lb := b.(*array.ListBuilder)
+			lb.Append(true)
+			for i := 0; i < v.Len(); i++ {
+				elem.append(lb.ValueBuilder(), v.Index(i))
+			}
+		},
+		decode: func(arr array.Interface, i int, v reflect.Value) {
+			list := arr.(*array.List)
+			j := i + list.Data().Offset()
+			beg, end := int(list.Offsets()[j]), int(list.Offsets()[j+1])
+			o := reflect.MakeSlice(t, end-beg, end-beg)
+			for k := beg; k < end; k++ {
+				elem.decodeValue(list.ListValues(), k, o.Index(k-beg))
+			}
+			v.Set(o)
+		},
+	}, nil
+}
+
+func newFixedSizeListCodec(t reflect.Type, opts tagOptions, parents typeSet) (*codec, error) {
+	elem, err := newCodec(t.Elem(), elemOptions(opts), parents)
+	if err != nil {
+		return nil, err
+	}
+	n := t.Len()
+
+	return &codec{
+		dtype: arrow.FixedSizeListOf(int32(n), elem.dtype),
+		append: func(b array.Builder, v reflect.Value) {
+			lb := b.(*array.FixedSizeListBuilder)
+			lb.Append(true)
+			for i := 0; i < n; i++ {
+				elem.append(lb.ValueBuilder(), v.Index(i))
+			}
+		},
+		appendNull: func(b array.Builder) {
+			lb := b.(*array.FixedSizeListBuilder)
+			lb.AppendNull()
+			for i := 0; i < n; i++ {
+				elem.appendNull(lb.ValueBuilder())
+			}
+		},
+		decode: func(arr array.Interface, i int, v reflect.Value) {
+			list := arr.(*array.FixedSizeList)
+			beg := (i + list.Data().Offset()) * n
+			for k := 0; k < n; k++ {
+				elem.decodeValue(list.ListValues(), beg+k, v.Index(k))
+			}
+		},
+	}, nil
+}
+
+func newNestedStructCodec(t reflect.Type, parents typeSet) (*codec, error) {
+	sc, err := newStructCodec(t, parents)
+	if err != nil {
+		return nil, err
+	}
+
+	return &codec{
+		dtype: arrow.StructOf(sc.fields()...),
+		append: func(b array.Builder, v reflect.Value) {
+			sb := b.(*array.StructBuilder)
+			sb.Append(true)
+			for k, fc := range sc.codecs {
+				fc.append(sb.FieldBuilder(k), v.FieldByIndex(sc.index[k]))
+			}
+		},
+		appendNull: func(b array.Builder) {
+			sb := b.(*array.StructBuilder)
+			// Only mark the slot as null: the codecs below append the
+			// field nulls, including the values of fixed-size lists.
+			sb.AppendValues([]bool{false})
+			for k, fc := range sc.codecs {
+				fc.appendNull(sb.FieldBuilder(k))
+			}
+		},
+		decode: func(arr array.Interface, i int, v reflect.Value) {
+			st := arr.(*array.Struct)
+			for k, fc := range sc.codecs {
+				fc.decodeValue(st.Field(k), i, v.FieldByIndex(sc.index[k]))
+			}
+		},
+	}, nil
+}
+
+// newMapCodec returns the codec of maps, represented as lists of
+// struct<key, value> entries sorted by key.
+func newMapCodec(t reflect.Type, opts tagOptions, parents typeSet) (*codec, error) {
+	key, err := newCodec(t.Key(), elemOptions(opts), parents)
+	if err != nil {
+		return nil, err
+	}
+	val, err := newCodec(t.Elem(), elemOptions(opts), parents)
+	if err != nil {
+		return nil, err
+	}
+	less, err := keyLess(t.Key())
+	if err != nil {
+		return nil, err
+	}
+
+	entry := arrow.StructOf(
+		arrow.Field{Name: "key", Type: key.dtype, Nullable: key.nullable},
+		arrow.Field{Name: "value", Type: val.dtype, Nullable: val.nullable},
+	)
+	return &codec{
+		dtype: arrow.ListOf(entry),
+		append: func(b array.Builder, v reflect.Value) {
+			keys := v.MapKeys()
+			sort.Slice(keys, func(i, j int) bool { return less(keys[i], keys[j]) })
+
+			lb := b.(*array.ListBuilder)
+			sb := lb.ValueBuilder().(*array.StructBuilder)
+			lb.Append(true)
+			for _, k := range keys {
+				sb.Append(true)
+				key.append(sb.FieldBuilder(0), k)
+				val.append(sb.FieldBuilder(1), v.MapIndex(k))
+			}
+		},
+		decode: func(arr array.Interface, i int, v reflect.Value) {
+			list := arr.(*array.List)
+			st := list.ListValues().(*array.Struct)
+			j := i + list.Data().Offset()
+			beg, end := int(list.Offsets()[j]), int(list.Offsets()[j+1])
+			o := reflect.MakeMapWithSize(t, end-beg)
+			for k := beg; k < end; k++ {
+				kv := reflect.New(t.Key()).Elem()
+				vv := reflect.New(t.Elem()).Elem()
+				key.decodeValue(st.Field(0), k, kv)
+				val.decodeValue(st.Field(1), k, vv)
+				o.SetMapIndex(kv, vv)
+			}
+			v.Set(o)
+		},
+	}, nil
+}
+
+// keyLess returns the function ordering map keys of type t.
+func keyLess(t reflect.Type) (func(a, b reflect.Value) bool, error) {
+	switch t.Kind() {
+	case reflect.String:
+		return func(a, b reflect.Value) bool { return a.String() < b.String() }, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return func(a, b reflect.Value) bool { return a.Int() < b.Int() }, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return func(a, b reflect.Value) bool { return a.Uint() < b.Uint() }, nil
+	case reflect.Float32, reflect.Float64:
+		return func(a, b reflect.Value) bool { return a.Float() < b.Float() }, nil
+	case reflect.Bool:
+		return func(a, b reflect.Value) bool { return !a.Bool() && b.Bool() }, nil
+	default:
+		// other comparable keys are ordered by their textual representation.
+		return func(a, b reflect.Value) bool {
+			return fmt.Sprint(a.Interface()) < fmt.Sprint(b.Interface())
+		}, nil
+	}
+}
@@ -21,15 +21,61 @@ package csv
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/internal/arrtime"
 	"github.com/apache/arrow/go/arrow/memory"
+	"golang.org/x/xerrors"
 )
 
 var (
+	// ErrMismatchFields is reported for rows that do not have the number of
+	// fields of the schema.
+	//
+	// Reader.Err reports it wrapped in a *ParseError giving the line of the
+	// row, so it no longer compares equal to the error: use
+	// xerrors.Is(err, ErrMismatchFields) instead.
 	ErrMismatchFields = errors.New("arrow/csv: number of records mismatch")
 )
 
+// ParseError is the error reported by Reader for rows of the CSV file that
+// could not be parsed.
+type ParseError struct {
+	Line   int   // line of the row in the CSV file, starting at 1
+	Column int   // field of the row, starting at 1, or 0 if the whole row is invalid
+	Err    error // the actual error
+}
+
+func (e *ParseError) Error() string {
+	if e.Column == 0 {
+		return fmt.Sprintf("arrow/csv: line %d: %v", e.Line, e.Err)
+	}
+	return fmt.Sprintf("arrow/csv: line %d, column %d: %v", e.Line, e.Column, e.Err)
+}
+
+func (e *ParseError) Unwrap() error { return e.Err }
+
+// InvalidRowPolicy specifies how a Reader handles rows that could not be
+// parsed, either because of an invalid value or because they do not have
+// the expected number of fields.
+type InvalidRowPolicy int
+
+const (
+	// FailOnInvalidRow stops reading at the first invalid row. The row is
+	// part of the current record, with nulls for invalid values, and the
+	// error is reported by Err.
+	FailOnInvalidRow InvalidRowPolicy = iota
+
+	// SkipInvalidRow skips invalid rows. The number of skipped rows is
+	// reported by InvalidRows.
+	SkipInvalidRow
+
+	// NullInvalidRow replaces invalid values with nulls, and rows that do not
+	// have the expected number of fields with rows of nulls.
+	NullInvalidRow
+)
+
 // Option configures a CSV reader/writer.
 type Option func(config)
 type config interface{}
@@ -90,6 +136,26 @@ func WithChunk(n int) Option {
 	}
 }
 
+// WithConcurrency specifies the number of goroutines used while parsing CSV
+// files.
+//
+// If n is greater than 1, the CSV file is split into blocks of lines, as
+// configured by WithChunk, and the blocks are parsed and converted into
+// records by up to n goroutines.
+// Records are still returned in order by Next.
+// As blocks are split on line boundaries before parsing, comment lines and
+// empty lines count towards the chunk size.
+func WithConcurrency(n int) Option {
+	return func(cfg config) {
+		switch cfg := cfg.(type) {
+		case *Reader:
+			cfg.conc = n
+		default:
+			panic(fmt.Errorf("arrow/csv: unknown config type %T", cfg))
+		}
+	}
+}
+
 // WithCRLF specifies the line terminator used while writing CSV files.
 // If useCRLF is true, \r\n is used as the line terminator, otherwise \n is used.
 // The default value is false.
@@ -146,6 +212,209 @@ func WithNullReader(stringsCanBeNull bool, nullValues ...string) Option {
 	}
 }
 
+// WithColumns specifies the names of the columns read from the CSV file.
+// The schema of the records only describes these columns, in the given order.
+func WithColumns(names ...string) Option {
+	return func(cfg config) {
+		switch cfg := cfg.(type) {
+		case *Reader:
+			cfg.columns = make([]string, len(names))
+			copy(cfg.columns, names)
+			cfg.indices = nil
+		default:
+			panic(fmt.Errorf("arrow/csv: unknown config type %T", cfg))
+		}
+	}
+}
+
+// WithColumnIndices specifies the indices, starting at 0, of the columns read
+// from the CSV file.
+// The schema of the records only describes these columns, in the given order.
+func WithColumnIndices(indices ...int) Option {
+	return func(cfg config) {
+		switch cfg := cfg.(type) {
+		case *Reader:
+			cfg.indices = make([]int, len(indices))
+			copy(cfg.indices, indices)
+			cfg.columns = nil
+		default:
+			panic(fmt.Errorf("arrow/csv: unknown config type %T", cfg))
+		}
+	}
+}
+
+// WithSkipRows specifies the number of lines skipped at the beginning of the
+// CSV file, before the header if any.
+func WithSkipRows(n int) Option {
+	return func(cfg config) {
+		switch cfg := cfg.(type) {
+		case *Reader:
+			cfg.skipRows = n
+		default:
+			panic(fmt.Errorf("arrow/csv: unknown config type %T", cfg))
+		}
+	}
+}
+
+// WithMaxRows specifies the maximum number of rows, excluding the header,
+// read from the CSV file.
+// If n is negative, which is the default, all rows are read.
+func WithMaxRows(n int) Option {
+	return func(cfg config) {
+		switch cfg := cfg.(type) {
+		case *Reader:
+			cfg.maxRows = n
+		default:
+			panic(fmt.Errorf("arrow/csv: unknown config type %T", cfg))
+		}
+	}
+}
+
+// WithInvalidRowPolicy specifies how rows that could not be parsed are
+// handled. The default is FailOnInvalidRow.
+func WithInvalidRowPolicy(p InvalidRowPolicy) Option {
+	return func(cfg config) {
+		switch cfg := cfg.(type) {
+		case *Reader:
+			cfg.policy = p
+		default:
+			panic(fmt.Errorf("arrow/csv: unknown config type %T", cfg))
+		}
+	}
+}
+
+// DefaultInferRows is the number of rows sampled, by default, by
+// NewInferringReader to infer the schema of a CSV file.
+const DefaultInferRows = 1000
+
+// WithInferRows specifies the number of rows sampled by NewInferringReader to
+// infer the schema of a CSV file.
+// If n is negative, the whole file is sampled.
+func WithInferRows(n int) Option {
+	return func(cfg config) {
+		switch cfg := cfg.(type) {
+		case *Reader:
+			cfg.inferRows = n
+		default:
+			panic(fmt.Errorf("arrow/csv: unknown config type %T", cfg))
+		}
+	}
+}
+
+// WithColumnTypes specifies the data types of the named columns, overriding
+// the types inferred by NewInferringReader.
+// Names not present in the CSV file are ignored.
+//
+// WithColumnTypes panics if a data type is not supported by the CSV reader.
+func WithColumnTypes(types map[string]arrow.DataType) Option {
+	return func(cfg config) {
+		switch cfg := cfg.(type) {
+		case *Reader:
+			cfg.columnTypes = make(map[string]arrow.DataType, len(types))
+			for name, dt := range types {
+				if !validType(dt) {
+					panic(fmt.Errorf("arrow/csv: column %q has invalid data type %T", name, dt))
+				}
+				cfg.columnTypes[name] = dt
+			}
+		default:
+			panic(fmt.Errorf("arrow/csv: unknown config type %T", cfg))
+		}
+	}
+}
+
+// DefaultTimestampLayouts is the list of layouts tried in turn, by default,
+// when parsing timestamp values.
+// Values without time zone information are interpreted in the time zone of
+// the timestamp data type, or as UTC if it has none.
+var DefaultTimestampLayouts = append([]string(nil), arrtime.TimestampLayouts...)
+
+// WithTimestampLayouts specifies the layouts, as understood by time.Parse,
+// tried in turn when parsing timestamp values.
+// Writers format timestamp values with the first layout.
+// The default is DefaultTimestampLayouts.
+func WithTimestampLayouts(layouts ...string) Option {
+	return func(cfg config) {
+		switch cfg := cfg.(type) {
+		case *Reader:
+			cfg.tsLayouts = make([]string, len(layouts))
+			copy(cfg.tsLayouts, layouts)
+		case *Writer:
+			if len(layouts) > 0 {
+				cfg.tsLayout = layouts[0]
+			}
+		default:
+			panic(fmt.Errorf("arrow/csv: unknown config type %T", cfg))
+		}
+	}
+}
+
+// BinaryEncoding specifies how binary values are encoded in CSV fields.
+type BinaryEncoding int
+
+const (
+	Base64 BinaryEncoding = iota // standard base64 encoding, as defined in RFC 4648
+	Hex                          // hexadecimal encoding
+)
+
+// WithBinaryEncoding specifies the encoding of binary and fixed-size binary
+// values. The default is Base64.
+func WithBinaryEncoding(enc BinaryEncoding) Option {
+	return func(cfg config) {
+		switch cfg := cfg.(type) {
+		case *Reader:
+			cfg.binEnc = enc
+		case *Writer:
+			cfg.binEnc = enc
+		default:
+			panic(fmt.Errorf("arrow/csv: unknown config type %T", cfg))
+		}
+	}
+}
+
+// WithLocation specifies the time zone in which timestamp values are written.
+// The default is the time zone of the timestamp data type, or UTC if it has
+// none.
+func WithLocation(loc *time.Location) Option {
+	return func(cfg config) {
+		switch cfg := cfg.(type) {
+		case *Writer:
+			cfg.loc = loc
+		default:
+			panic(fmt.Errorf("arrow/csv: unknown config type %T", cfg))
+		}
+	}
+}
+
+// WithFormatter registers a function formatting the values of the named
+// column, overriding the default formatting of its data type.
+func WithFormatter(name string, f FormatFunc) Option {
+	return func(cfg config) {
+		switch cfg := cfg.(type) {
+		case *Writer:
+			if cfg.formatters == nil {
+				cfg.formatters = make(map[string]FormatFunc)
+			}
+			cfg.formatters[name] = f
+		default:
+			panic(fmt.Errorf("arrow/csv: unknown config type %T", cfg))
+		}
+	}
+}
+
+// WithListDelimiter specifies the character separating the elements of list
+// values within a CSV field. The default is ';'.
+func WithListDelimiter(c rune) Option {
+	return func(cfg config) {
+		switch cfg := cfg.(type) {
+		case *Reader:
+			cfg.listDelim = c
+		default:
+			panic(fmt.Errorf("arrow/csv: unknown config type %T", cfg))
+		}
+	}
+}
+
 // WithNullWriter sets the null string written for NULL values. The default is
 // set in NewWriter().
 func WithNullWriter(null string) Option {
@@ -161,14 +430,35 @@ func WithNullWriter(null string) Option {
 
 func validate(schema *arrow.Schema) {
 	for i, f := range schema.Fields() {
-		switch ft := f.Type.(type) {
-		case *arrow.BooleanType:
-		case *arrow.Int8Type, *arrow.Int16Type, *arrow.Int32Type, *arrow.Int64Type:
-		case *arrow.Uint8Type, *arrow.Uint16Type, *arrow.Uint32Type, *arrow.Uint64Type:
-		case *arrow.Float32Type, *arrow.Float64Type:
-		case *arrow.StringType:
-		default:
-			panic(fmt.Errorf("arrow/csv: field %d (%s) has invalid data type %T", i, f.Name, ft))
+		if !validType(f.Type) {
+			panic(fmt.Errorf("arrow/csv: field %d (%s) has invalid data type %T", i, f.Name, f.Type))
+		}
+		if ft, ok := f.Type.(*arrow.TimestampType); ok && ft.TimeZone != "" {
+			if _, err := time.LoadLocation(ft.TimeZone); err != nil {
+				panic(xerrors.Errorf("arrow/csv: field %d (%s) has invalid time zone %q: %w", i, f.Name, ft.TimeZone, err))
+			}
+		}
+	}
+}
+
+func validType(dt arrow.DataType) bool {
+	switch dt := dt.(type) {
+	case *arrow.BooleanType:
+	case *arrow.Int8Type, *arrow.Int16Type, *arrow.Int32Type, *arrow.Int64Type:
+	case *arrow.Uint8Type, *arrow.Uint16Type, *arrow.Uint32Type, *arrow.Uint64Type:
+	case *arrow.Float16Type, *arrow.Float32Type, *arrow.Float64Type:
+	case *arrow.Decimal128Type:
+	case *arrow.StringType, *arrow.BinaryType, *arrow.FixedSizeBinaryType:
+	case *arrow.TimestampType, *arrow.Date32Type, *arrow.Date64Type:
+	case *arrow.Time32Type, *arrow.Time64Type, *arrow.DurationType:
+	case *arrow.ListType:
+		// list elements share a single delimiter: nested lists are not supported.
+		if dt.Elem().ID() == arrow.LIST {
+			return false
 		}
+		return validType(dt.Elem())
+	default:
+		return false
 	}
+	return true
 }
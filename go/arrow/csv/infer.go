@@ -0,0 +1,175 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package csv
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/internal/arrtime"
+	"golang.org/x/xerrors"
+)
+
+// inferKind is the kind of a column, as inferred from its values.
+// Kinds are ordered from the most to the least specific one.
+type inferKind int
+
+const (
+	inferNull inferKind = iota
+	inferBool
+	inferInt
+	inferFloat
+	inferDate
+	inferTimestamp
+	inferString
+)
+
+// merge returns the most specific kind able to represent values of both
+// kinds k and o.
+func (k inferKind) merge(o inferKind) inferKind {
+	if k > o {
+		k, o = o, k
+	}
+	switch {
+	case k == o, k == inferNull:
+		return o
+	case k == inferInt && o == inferFloat:
+		return inferFloat
+	case k == inferDate && o == inferTimestamp:
+		return inferTimestamp
+	default:
+		return inferString
+	}
+}
+
+func (k inferKind) dataType() arrow.DataType {
+	switch k {
+	case inferBool:
+		return arrow.FixedWidthTypes.Boolean
+	case inferInt:
+		return arrow.PrimitiveTypes.Int64
+	case inferFloat:
+		return arrow.PrimitiveTypes.Float64
+	case inferDate:
+		return arrow.FixedWidthTypes.Date32
+	case inferTimestamp:
+		return arrow.FixedWidthTypes.Timestamp_ns
+	default:
+		// columns with only NULL values are read as strings.
+		return arrow.BinaryTypes.String
+	}
+}
+
+// inferValue returns the most specific kind able to represent str.
+func (r *Reader) inferValue(str string) inferKind {
+	switch str {
+	case "false", "False", "true", "True":
+		return inferBool
+	}
+
+	if _, err := strconv.ParseInt(str, 10, 64); err == nil {
+		return inferInt
+	}
+
+	if _, err := strconv.ParseFloat(str, 64); err == nil {
+		return inferFloat
+	}
+
+	if _, err := time.Parse(arrtime.DateLayout, str); err == nil {
+		return inferDate
+	}
+
+	for _, layout := range r.tsLayouts {
+		if _, err := time.Parse(layout, str); err == nil {
+			return inferTimestamp
+		}
+	}
+
+	return inferString
+}
+
+// inferSchema infers the schema of the CSV file from its header and its
+// first rows.
+// The sampled rows are kept to be read back by readRow.
+func (r *Reader) inferSchema() (*arrow.Schema, error) {
+	var names []string
+	if r.header {
+		rec, err := r.readCSV()
+		if err != nil {
+			return nil, xerrors.Errorf("arrow/csv: could not read header from file: %w", err)
+		}
+		names = make([]string, len(rec))
+		copy(names, rec)
+	}
+
+	for i := 0; r.inferRows < 0 || i < r.inferRows; i++ {
+		rec, err := r.readCSV()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		row := sampledRow{fields: make([]string, len(rec)), line: r.rowLine}
+		copy(row.fields, rec)
+		r.sample = append(r.sample, row)
+	}
+
+	if !r.header {
+		if len(r.sample) == 0 {
+			return nil, xerrors.Errorf("arrow/csv: could not infer schema from empty file")
+		}
+		names = make([]string, len(r.sample[0].fields))
+		for i := range names {
+			names[i] = fmt.Sprintf("f%d", i)
+		}
+	}
+
+	kinds := make([]inferKind, len(names))
+	for _, row := range r.sample {
+		if len(row.fields) != len(names) {
+			if r.policy == FailOnInvalidRow {
+				return nil, &ParseError{Line: row.line, Err: ErrMismatchFields}
+			}
+			// the row is handled when read back, according to the
+			// invalid row policy.
+			continue
+		}
+		for i, str := range row.fields {
+			if r.isNull(str) {
+				continue
+			}
+			kinds[i] = kinds[i].merge(r.inferValue(str))
+		}
+	}
+
+	fields := make([]arrow.Field, len(names))
+	for i, name := range names {
+		dt, ok := r.columnTypes[name]
+		if !ok {
+			dt = kinds[i].dataType()
+		}
+		fields[i] = arrow.Field{Name: name, Type: dt, Nullable: true}
+	}
+
+	schema := arrow.NewSchema(fields, nil)
+	validate(schema)
+	return schema, nil
+}
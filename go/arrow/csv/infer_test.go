@@ -0,0 +1,157 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package csv_test
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/csv"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+func TestInferringReader(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	raw := `null,bool,i64,f64,mixed,date,ts,str,override
+,true,1,1,1,2020-01-02,2020-01-02,a,1
+NULL,False,-2,2.5,true,2020-01-03,2020-01-02T03:04:05Z,2,2
+null,,3,,3,,,,
+`
+
+	r := csv.NewInferringReader(strings.NewReader(raw),
+		csv.WithAllocator(mem),
+		csv.WithChunk(-1),
+		csv.WithColumnTypes(map[string]arrow.DataType{
+			"override": arrow.PrimitiveTypes.Uint8,
+			"missing":  arrow.PrimitiveTypes.Uint8,
+		}),
+	)
+	defer r.Release()
+
+	want := arrow.NewSchema(
+		[]arrow.Field{
+			{Name: "null", Type: arrow.BinaryTypes.String, Nullable: true},
+			{Name: "bool", Type: arrow.FixedWidthTypes.Boolean, Nullable: true},
+			{Name: "i64", Type: arrow.PrimitiveTypes.Int64, Nullable: true},
+			{Name: "f64", Type: arrow.PrimitiveTypes.Float64, Nullable: true},
+			{Name: "mixed", Type: arrow.BinaryTypes.String, Nullable: true},
+			{Name: "date", Type: arrow.FixedWidthTypes.Date32, Nullable: true},
+			{Name: "ts", Type: arrow.FixedWidthTypes.Timestamp_ns, Nullable: true},
+			{Name: "str", Type: arrow.BinaryTypes.String, Nullable: true},
+			{Name: "override", Type: arrow.PrimitiveTypes.Uint8, Nullable: true},
+		},
+		nil,
+	)
+
+	if got := r.Schema(); !got.Equal(want) {
+		t.Fatalf("invalid schema:\ngot= %v\nwant=%v", got, want)
+	}
+
+	if !r.Next() {
+		t.Fatalf("could not read record: %v", r.Err())
+	}
+
+	out := new(bytes.Buffer)
+	rec := r.Record()
+	for i, col := range rec.Columns() {
+		fmt.Fprintf(out, "%s: %v\n", rec.ColumnName(i), col)
+	}
+
+	if got, want := out.String(), `null: [(null) (null) (null)]
+bool: [true false (null)]
+i64: [1 -2 3]
+f64: [1 2.5 (null)]
+mixed: ["1" "true" "3"]
+date: [18263 18264 (null)]
+ts: [1577923200000000000 1577934245000000000 (null)]
+str: ["a" "2" (null)]
+override: [1 2 (null)]
+`; got != want {
+		t.Fatalf("invalid output:\ngot:\n%s\nwant:\n%s\n", got, want)
+	}
+
+	if r.Next() {
+		t.Fatalf("unexpected record")
+	}
+
+	if r.Err() != nil {
+		t.Fatalf("unexpected error: %v", r.Err())
+	}
+}
+
+func TestInferringReaderSample(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	raw := "1;2\n3;4\n5.5;x\n"
+
+	r := csv.NewInferringReader(strings.NewReader(raw),
+		csv.WithAllocator(mem),
+		csv.WithComma(';'),
+		csv.WithHeader(false),
+		csv.WithInferRows(2),
+	)
+	defer r.Release()
+
+	want := arrow.NewSchema(
+		[]arrow.Field{
+			{Name: "f0", Type: arrow.PrimitiveTypes.Int64, Nullable: true},
+			{Name: "f1", Type: arrow.PrimitiveTypes.Int64, Nullable: true},
+		},
+		nil,
+	)
+
+	if got := r.Schema(); !got.Equal(want) {
+		t.Fatalf("invalid schema:\ngot= %v\nwant=%v", got, want)
+	}
+
+	n := 0
+	for r.Next() {
+		n++
+	}
+
+	if got, want := n, 3; got != want {
+		t.Fatalf("invalid number of records: got=%d, want=%d", got, want)
+	}
+
+	// rows past the sample may not match the inferred schema.
+	if r.Err() == nil {
+		t.Fatalf("expected an error")
+	}
+}
+
+func TestInferringReaderEmpty(t *testing.T) {
+	r := csv.NewInferringReader(strings.NewReader(""))
+	defer r.Release()
+
+	if r.Schema() != nil {
+		t.Fatalf("unexpected schema: %v", r.Schema())
+	}
+
+	if r.Next() {
+		t.Fatalf("unexpected record")
+	}
+
+	if r.Err() == nil {
+		t.Fatalf("expected an error")
+	}
+}
@@ -0,0 +1,289 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package csv_test
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/csv"
+	"github.com/apache/arrow/go/arrow/memory"
+	"golang.org/x/xerrors"
+)
+
+const optionsCSV = `exported by some tool
+on some date
+a,b,c
+1,x,1.5
+2,"multi
+line",2.5
+3,z
+4,w,bad
+5,v,5.5
+`
+
+var optionsSchema = arrow.NewSchema(
+	[]arrow.Field{
+		{Name: "a", Type: arrow.PrimitiveTypes.Int64, Nullable: true},
+		{Name: "b", Type: arrow.BinaryTypes.String, Nullable: true},
+		{Name: "c", Type: arrow.PrimitiveTypes.Float64, Nullable: true},
+	},
+	nil,
+)
+
+func readOptionsCSV(t *testing.T, opts ...csv.Option) (string, *csv.Reader) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	opts = append([]csv.Option{
+		csv.WithAllocator(mem),
+		csv.WithSkipRows(2),
+		csv.WithHeader(true),
+	}, opts...)
+	r := csv.NewReader(strings.NewReader(optionsCSV), optionsSchema, opts...)
+	defer r.Release()
+
+	out := new(bytes.Buffer)
+	for r.Next() {
+		rec := r.Record()
+		for i, col := range rec.Columns() {
+			fmt.Fprintf(out, "%s: %v\n", rec.ColumnName(i), col)
+		}
+	}
+	return out.String(), r
+}
+
+func TestReaderOptions(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		opts    []csv.Option
+		want    string
+		invalid int
+		err     string
+	}{
+		{
+			name: "fail",
+			opts: []csv.Option{csv.WithChunk(-1)},
+			want: `a: [1 2]
+b: ["x" "multi\nline"]
+c: [1.5 2.5]
+`,
+			err: "arrow/csv: line 7: arrow/csv: number of records mismatch",
+		},
+		{
+			name: "skip",
+			opts: []csv.Option{
+				csv.WithChunk(-1),
+				csv.WithInvalidRowPolicy(csv.SkipInvalidRow),
+			},
+			want: `a: [1 2 5]
+b: ["x" "multi\nline" "v"]
+c: [1.5 2.5 5.5]
+`,
+			invalid: 2,
+		},
+		{
+			name: "null",
+			opts: []csv.Option{
+				csv.WithChunk(-1),
+				csv.WithInvalidRowPolicy(csv.NullInvalidRow),
+			},
+			want: `a: [1 2 (null) 4 5]
+b: ["x" "multi\nline" (null) "w" "v"]
+c: [1.5 2.5 (null) (null) 5.5]
+`,
+		},
+		{
+			name: "columns",
+			opts: []csv.Option{
+				csv.WithChunk(-1),
+				csv.WithInvalidRowPolicy(csv.SkipInvalidRow),
+				csv.WithColumns("c", "a"),
+			},
+			want: `c: [1.5 2.5 5.5]
+a: [1 2 5]
+`,
+			invalid: 2,
+		},
+		{
+			name: "column-indices",
+			opts: []csv.Option{
+				csv.WithChunk(-1),
+				csv.WithInvalidRowPolicy(csv.SkipInvalidRow),
+				csv.WithColumnIndices(1),
+			},
+			want: `b: ["x" "multi\nline" "w" "v"]
+`,
+			invalid: 1,
+		},
+		{
+			name: "max-rows",
+			opts: []csv.Option{
+				csv.WithChunk(2),
+				csv.WithInvalidRowPolicy(csv.SkipInvalidRow),
+				csv.WithMaxRows(3),
+			},
+			want: `a: [1 2]
+b: ["x" "multi\nline"]
+c: [1.5 2.5]
+a: [5]
+b: ["v"]
+c: [5.5]
+`,
+			invalid: 2,
+		},
+		{
+			name: "max-rows-parallel",
+			opts: []csv.Option{
+				csv.WithChunk(2),
+				csv.WithConcurrency(4),
+				csv.WithInvalidRowPolicy(csv.NullInvalidRow),
+				csv.WithMaxRows(3),
+			},
+			want: `a: [1 2]
+b: ["x" "multi\nline"]
+c: [1.5 2.5]
+a: [(null)]
+b: [(null)]
+c: [(null)]
+`,
+		},
+		{
+			name: "fail-parallel",
+			opts: []csv.Option{
+				csv.WithChunk(3),
+				csv.WithConcurrency(4),
+				csv.WithColumns("c"),
+			},
+			want: `c: [1.5 2.5]
+`,
+			err: "arrow/csv: line 7: arrow/csv: number of records mismatch",
+		},
+		{
+			name: "unknown-column",
+			opts: []csv.Option{csv.WithColumns("d")},
+			err:  `arrow/csv: unknown column "d"`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			got, r := readOptionsCSV(t, tc.opts...)
+			if got != tc.want {
+				t.Fatalf("invalid output:\ngot:\n%s\nwant:\n%s\n", got, tc.want)
+			}
+
+			if got, want := r.InvalidRows(), tc.invalid; got != want {
+				t.Fatalf("invalid number of invalid rows: got=%d, want=%d", got, want)
+			}
+
+			switch {
+			case tc.err == "" && r.Err() != nil:
+				t.Fatalf("unexpected error: %v", r.Err())
+			case tc.err != "" && r.Err() == nil:
+				t.Fatalf("expected an error: %s", tc.err)
+			case tc.err != "" && r.Err().Error() != tc.err:
+				t.Fatalf("invalid error: got=%q, want=%q", r.Err(), tc.err)
+			}
+		})
+	}
+}
+
+func TestReaderParseError(t *testing.T) {
+	_, r := readOptionsCSV(t, csv.WithChunk(-1))
+
+	var perr *csv.ParseError
+	if !xerrors.As(r.Err(), &perr) {
+		t.Fatalf("invalid error type: %T", r.Err())
+	}
+
+	if perr.Line != 7 || perr.Column != 0 {
+		t.Fatalf("invalid error position: line=%d, column=%d", perr.Line, perr.Column)
+	}
+
+	if !xerrors.Is(r.Err(), csv.ErrMismatchFields) {
+		t.Fatalf("invalid error: %v", r.Err())
+	}
+}
+
+func TestReaderErrorLines(t *testing.T) {
+	const rows = "# comment\n1,x\n\n2,\"multi\n\nline\"\n# comment\n3,y\n"
+
+	for _, tc := range []struct {
+		input string
+		line  int
+	}{
+		{rows + "4\n5,z\n", 9},
+		{rows + "4,w\n5", 10},
+	} {
+		for _, conc := range []int{1, 2} {
+			t.Run(fmt.Sprintf("line=%d/concurrency=%d", tc.line, conc), func(t *testing.T) {
+				r := csv.NewReader(strings.NewReader(tc.input), arrow.NewSchema(
+					[]arrow.Field{
+						{Name: "a", Type: arrow.PrimitiveTypes.Int64},
+						{Name: "b", Type: arrow.BinaryTypes.String},
+					},
+					nil,
+				), csv.WithComment('#'), csv.WithChunk(2), csv.WithConcurrency(conc))
+				defer r.Release()
+
+				for r.Next() {
+				}
+
+				var perr *csv.ParseError
+				if !xerrors.As(r.Err(), &perr) {
+					t.Fatalf("invalid error: %v", r.Err())
+				}
+				if perr.Line != tc.line {
+					t.Fatalf("invalid error line: got=%d, want=%d", perr.Line, tc.line)
+				}
+			})
+		}
+	}
+}
+
+func TestReaderValueError(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	r := csv.NewReader(strings.NewReader("1,1.5\n2,bad\n3,3.5\n"), arrow.NewSchema(
+		[]arrow.Field{
+			{Name: "a", Type: arrow.PrimitiveTypes.Int64},
+			{Name: "c", Type: arrow.PrimitiveTypes.Float64},
+		},
+		nil,
+	), csv.WithAllocator(mem), csv.WithChunk(-1))
+	defer r.Release()
+
+	if !r.Next() {
+		t.Fatalf("could not read record: %v", r.Err())
+	}
+
+	if got, want := fmt.Sprintf("%v", r.Record().Column(1)), "[1.5 (null)]"; got != want {
+		t.Fatalf("invalid output: got=%s, want=%s", got, want)
+	}
+
+	want := `arrow/csv: line 2, column 2: strconv.ParseFloat: parsing "bad": invalid syntax`
+	if r.Err() == nil || r.Err().Error() != want {
+		t.Fatalf("invalid error: got=%v, want=%s", r.Err(), want)
+	}
+
+	if r.Next() {
+		t.Fatalf("unexpected record")
+	}
+}
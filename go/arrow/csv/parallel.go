@@ -0,0 +1,238 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package csv
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"io"
+
+	"github.com/apache/arrow/go/arrow/array"
+)
+
+// parsed is a record parsed from a block of the CSV file.
+type parsed struct {
+	rec     array.Record
+	invalid int // number of invalid rows skipped
+	err     error
+}
+
+// startParallel starts a goroutine splitting the CSV file into blocks and
+// dispatching their parsing, with at most r.conc blocks parsed concurrently
+// or waiting to be consumed.
+func (r *Reader) startParallel() {
+	n := r.chunk
+	if n < 1 {
+		n = 1
+	}
+	if r.chunk < 0 {
+		n = -1
+	}
+
+	sample := r.sample
+	r.sample = nil
+
+	r.quit = make(chan struct{})
+	r.pipe = make(chan chan parsed, r.conc)
+	go func() {
+		defer close(r.pipe)
+		line := r.lastLine
+		for {
+			// rows sampled while inferring the schema are parsed first.
+			var rows []sampledRow
+			lines := n
+			if len(sample) > 0 {
+				k := len(sample)
+				if n > 0 && k > n {
+					k = n
+				}
+				rows, sample = sample[:k], sample[k:]
+				if n > 0 {
+					lines -= k
+				}
+			}
+
+			var (
+				block []byte
+				err   error
+			)
+			if lines != 0 {
+				block, err = r.readBlock(lines)
+			}
+			if err == io.EOF {
+				if len(rows) == 0 {
+					return
+				}
+				err = nil
+			}
+
+			res := make(chan parsed, 1)
+			select {
+			case r.pipe <- res:
+			case <-r.quit:
+				return
+			}
+
+			if err != nil {
+				res <- parsed{err: err}
+				return
+			}
+
+			go func(offset int) {
+				res <- r.parseBlock(block, rows, offset)
+			}(line)
+			line += bytes.Count(block, []byte{'\n'})
+		}
+	}()
+}
+
+// readBlock reads n lines from the underlying CSV file, or the whole file if
+// n is negative.
+// Newlines within quoted fields do not end a line.
+// readBlock returns io.EOF if no data could be read.
+func (r *Reader) readBlock(n int) ([]byte, error) {
+	var (
+		block  []byte
+		quoted bool
+	)
+	for i := 0; n < 0 || i < n; {
+		line, err := r.buf.ReadBytes('\n')
+		comment := !quoted && r.r.Comment != 0 && bytes.HasPrefix(line, []byte(string(r.r.Comment)))
+		if !comment {
+			quoted = quoted != (bytes.Count(line, []byte{'"'})%2 == 1)
+		}
+		block = append(block, line...)
+		if err != nil {
+			if err == io.EOF && len(block) > 0 {
+				break
+			}
+			return nil, err
+		}
+		if !quoted {
+			i++
+		}
+	}
+	return block, nil
+}
+
+// parseBlock converts the given rows, followed by the rows of the block of
+// CSV data, into a record.
+// offset is the number of lines of the CSV file before the block.
+// parseBlock may be called simultaneously from multiple goroutines.
+func (r *Reader) parseBlock(block []byte, rows []sampledRow, offset int) parsed {
+	lines := &lineReader{r: bufio.NewReader(bytes.NewReader(block))}
+	br := &Reader{
+		r:                csv.NewReader(lines),
+		lines:            lines,
+		schema:           r.schema,
+		refs:             1,
+		chunk:            -1,
+		maxRows:          -1,
+		mem:              r.mem,
+		sample:           rows,
+		proj:             r.proj,
+		nfields:          r.nfields,
+		policy:           r.policy,
+		lineOffset:       offset,
+		stringsCanBeNull: r.stringsCanBeNull,
+		nulls:            r.nulls,
+		tsLayouts:        r.tsLayouts,
+		binEnc:           r.binEnc,
+		listDelim:        r.listDelim,
+	}
+	br.r.Comma = r.r.Comma
+	br.r.Comment = r.r.Comment
+	br.r.FieldsPerRecord = -1
+	br.r.ReuseRecord = true
+	br.setSchema(r.schema)
+	defer br.bld.Release()
+	if br.check != nil {
+		defer br.check.Release()
+	}
+
+	var p parsed
+	if br.nextRows() {
+		p.rec = br.cur
+	}
+	p.invalid, p.err = br.invalid, br.err
+	return p
+}
+
+// nextParallel returns the next record parsed by the parallel reader.
+func (r *Reader) nextParallel() bool {
+	if r.pipe == nil {
+		r.startParallel()
+	}
+
+	for res := range r.pipe {
+		p := <-res
+		r.invalid += p.invalid
+		if p.rec == nil {
+			if p.err != nil {
+				r.err = p.err
+				return false
+			}
+			// blocks made of comments, empty lines or skipped rows only.
+			continue
+		}
+
+		r.cur, r.err = p.rec, p.err
+		if r.maxRows >= 0 {
+			r.limit()
+		}
+		return r.cur != nil
+	}
+
+	r.done = true
+	return false
+}
+
+// limit truncates the current record to the maximum number of rows.
+func (r *Reader) limit() {
+	n := int64(r.maxRows - r.nrows)
+	switch {
+	case n <= 0:
+		r.cur.Release()
+		r.cur = nil
+		r.done = true
+		return
+	case r.cur.NumRows() >= n:
+		rec := r.cur.NewSlice(0, n)
+		r.cur.Release()
+		r.cur = rec
+		r.done = true
+	}
+	r.nrows += int(r.cur.NumRows())
+}
+
+// stopParallel stops the dispatching goroutine, if any, and releases the
+// records parsed ahead of time but not consumed.
+// stopParallel waits for the parsing in progress to complete.
+func (r *Reader) stopParallel() {
+	if r.pipe == nil {
+		return
+	}
+	close(r.quit)
+	for res := range r.pipe {
+		p := <-res
+		if p.rec != nil {
+			p.rec.Release()
+		}
+	}
+	r.pipe = nil
+}
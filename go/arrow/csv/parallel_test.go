@@ -0,0 +1,205 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package csv_test
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/csv"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+func genParallelCSV(rows int) string {
+	var buf strings.Builder
+	buf.WriteString("i64,f64,str\n")
+	for i := 0; i < rows; i++ {
+		switch {
+		case i%7 == 0:
+			fmt.Fprintf(&buf, "%d,%d.5,\"multi\nline \"\"%d\"\"\"\n", i, i, i)
+		case i%5 == 0:
+			fmt.Fprintf(&buf, "# comment \"%d\n%d,,\n", i, i)
+		default:
+			fmt.Fprintf(&buf, "%d,%d.5,str-%d\n", i, i, i)
+		}
+	}
+	return buf.String()
+}
+
+// readAllCSV returns the rows read from r, one per line, and the number of
+// records.
+func readAllCSV(t *testing.T, r *csv.Reader) (string, int) {
+	out := new(bytes.Buffer)
+	n := 0
+	for r.Next() {
+		rec := r.Record()
+		for i := int64(0); i < rec.NumRows(); i++ {
+			for _, col := range rec.Columns() {
+				v := array.NewSlice(col, i, i+1)
+				fmt.Fprintf(out, "%v ", v)
+				v.Release()
+			}
+			fmt.Fprintf(out, "\n")
+		}
+		n++
+	}
+	if r.Err() != nil {
+		t.Fatalf("unexpected error: %v", r.Err())
+	}
+	return out.String(), n
+}
+
+func TestParallelReader(t *testing.T) {
+	schema := arrow.NewSchema(
+		[]arrow.Field{
+			{Name: "i64", Type: arrow.PrimitiveTypes.Int64, Nullable: true},
+			{Name: "f64", Type: arrow.PrimitiveTypes.Float64, Nullable: true},
+			{Name: "str", Type: arrow.BinaryTypes.String, Nullable: true},
+		},
+		nil,
+	)
+
+	raw := genParallelCSV(1000)
+	opts := []csv.Option{
+		csv.WithHeader(true),
+		csv.WithComment('#'),
+		csv.WithNullReader(true),
+	}
+
+	for _, chunk := range []int{1, 10, 333, -1} {
+		r := csv.NewReader(strings.NewReader(raw), schema, append(opts, csv.WithChunk(chunk))...)
+		want, nrecs := readAllCSV(t, r)
+		r.Release()
+
+		for _, conc := range []int{2, 4, 16} {
+			t.Run(fmt.Sprintf("chunk=%d-conc=%d", chunk, conc), func(t *testing.T) {
+				mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+				defer mem.AssertSize(t, 0)
+
+				r := csv.NewReader(strings.NewReader(raw), schema,
+					append(opts, csv.WithChunk(chunk), csv.WithConcurrency(conc), csv.WithAllocator(mem))...,
+				)
+				defer r.Release()
+
+				got, n := readAllCSV(t, r)
+				if got != want {
+					t.Fatalf("invalid output:\ngot:\n%s\nwant:\n%s\n", got, want)
+				}
+
+				// comment lines count towards the chunk size of blocks.
+				if chunk > 1 {
+					return
+				}
+				if n != nrecs {
+					t.Fatalf("invalid number of records: got=%d, want=%d", n, nrecs)
+				}
+			})
+		}
+	}
+}
+
+func TestParallelReaderInferred(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	raw := genParallelCSV(100)
+
+	r := csv.NewInferringReader(strings.NewReader(raw),
+		csv.WithAllocator(mem),
+		csv.WithComment('#'),
+		csv.WithChunk(7),
+		csv.WithInferRows(10),
+		csv.WithConcurrency(3),
+	)
+	defer r.Release()
+
+	got, _ := readAllCSV(t, r)
+
+	s := csv.NewInferringReader(strings.NewReader(raw),
+		csv.WithComment('#'),
+		csv.WithChunk(-1),
+		csv.WithInferRows(10),
+	)
+	defer s.Release()
+
+	want, _ := readAllCSV(t, s)
+	if got != want {
+		t.Fatalf("invalid output:\ngot:\n%s\nwant:\n%s\n", got, want)
+	}
+}
+
+func TestParallelReaderError(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	schema := arrow.NewSchema(
+		[]arrow.Field{
+			{Name: "i64", Type: arrow.PrimitiveTypes.Int64},
+		},
+		nil,
+	)
+
+	raw := strings.Repeat("1\n", 100) + "x\n" + strings.Repeat("2\n", 100)
+
+	r := csv.NewReader(strings.NewReader(raw), schema,
+		csv.WithAllocator(mem),
+		csv.WithChunk(10),
+		csv.WithConcurrency(4),
+	)
+	defer r.Release()
+
+	n := 0
+	for r.Next() {
+		n++
+	}
+
+	if got, want := n, 11; got != want {
+		t.Fatalf("invalid number of records: got=%d, want=%d", got, want)
+	}
+
+	if r.Err() == nil {
+		t.Fatalf("expected an error")
+	}
+}
+
+func TestParallelReaderRelease(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	schema := arrow.NewSchema(
+		[]arrow.Field{
+			{Name: "i64", Type: arrow.PrimitiveTypes.Int64},
+		},
+		nil,
+	)
+
+	r := csv.NewReader(strings.NewReader(strings.Repeat("1\n", 1000)), schema,
+		csv.WithAllocator(mem),
+		csv.WithChunk(10),
+		csv.WithConcurrency(4),
+	)
+
+	if !r.Next() {
+		t.Fatalf("could not read record: %v", r.Err())
+	}
+
+	r.Release()
+}
@@ -17,15 +17,23 @@
 package csv
 
 import (
+	"bufio"
+	"encoding/base64"
 	"encoding/csv"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"strconv"
+	"strings"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/apache/arrow/go/arrow"
 	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/decimal128"
+	"github.com/apache/arrow/go/arrow/float16"
+	"github.com/apache/arrow/go/arrow/internal/arrtime"
 	"github.com/apache/arrow/go/arrow/internal/debug"
 	"github.com/apache/arrow/go/arrow/memory"
 	"golang.org/x/xerrors"
@@ -34,6 +42,8 @@ import (
 // Reader wraps encoding/csv.Reader and creates array.Records from a schema.
 type Reader struct {
 	r      *csv.Reader
+	buf    *bufio.Reader // input of r, read directly by the parallel reader
+	lines  *lineReader   // counts the lines read by r from buf
 	schema *arrow.Schema
 
 	refs int64
@@ -50,28 +60,109 @@ type Reader struct {
 	header bool
 	once   sync.Once
 
-	fieldConverter []func(field array.Builder, val string)
+	infer       bool
+	inferRows   int
+	columnTypes map[string]arrow.DataType
+	sample      []sampledRow // rows read while inferring the schema, not yet consumed
+
+	conc int
+	pipe chan chan parsed
+	quit chan struct{}
+
+	columns []string // names of the projected columns
+	indices []int    // indices of the projected columns
+	proj    []int    // indices in the CSV file of the fields of the schema
+	nfields int      // number of fields of the CSV file
+
+	skipRows int
+	maxRows  int
+	nrows    int // number of rows appended to records
+
+	policy  InvalidRowPolicy
+	invalid int
+	check   *array.RecordBuilder // scratch builder validating rows before they are appended
+
+	lineOffset int // number of lines before the first line read by r
+	lastLine   int // last line read by r
+	rowLine    int // line of the current row
+
+	fieldConverter []func(field array.Builder, val string) error
 
 	stringsCanBeNull bool
 	nulls            []string
+
+	tsLayouts []string
+	binEnc    BinaryEncoding
+	listDelim rune
+}
+
+// sampledRow is a row read while inferring the schema of a CSV file.
+type sampledRow struct {
+	fields []string
+	line   int
 }
 
 // NewReader returns a reader that reads from the CSV file and creates
 // array.Records from the given schema.
 //
+// The schema describes all the fields of the CSV file. When the reader is
+// configured with WithColumns or WithColumnIndices, the schema of the records
+// only describes the selected fields.
+//
 // NewReader panics if the given schema contains fields that have types that are not
 // primitive types.
 func NewReader(r io.Reader, schema *arrow.Schema, opts ...Option) *Reader {
 	validate(schema)
 
+	rr := newReader(r, opts...)
+	rr.schema = schema
+	return rr
+}
+
+// NewInferringReader returns a reader that reads from the CSV file and creates
+// array.Records whose schema is inferred from the first rows of the file.
+//
+// Column names are taken from the CSV header, or are named f0, f1, ... when
+// the reader is configured with WithHeader(false).
+// Column types are inferred from the first rows, as configured with
+// WithInferRows, and may be overridden with WithColumnTypes.
+// Values matching DefaultNullValues, including in string columns, are
+// considered as NULL, unless configured otherwise with WithNullReader.
+//
+// The schema is inferred on the first call to Schema or Next.
+func NewInferringReader(r io.Reader, opts ...Option) *Reader {
+	opts = append([]Option{
+		WithHeader(true),
+		WithNullReader(true),
+		WithInferRows(DefaultInferRows),
+	}, opts...)
+
+	rr := newReader(r, opts...)
+	rr.infer = true
+	return rr
+}
+
+func newReader(r io.Reader, opts ...Option) *Reader {
+	buf := bufio.NewReader(r)
+	lines := &lineReader{r: buf}
 	rr := &Reader{
-		r:                csv.NewReader(r),
-		schema:           schema,
+		// lines hands out one line at a time, so that csv.Reader never
+		// buffers more than the rows it returned.
+		r:                csv.NewReader(lines),
+		buf:              buf,
+		lines:            lines,
 		refs:             1,
 		chunk:            1,
+		maxRows:          -1,
 		stringsCanBeNull: false,
+		tsLayouts:        DefaultTimestampLayouts,
+		binEnc:           Base64,
+		listDelim:        ';',
 	}
 	rr.r.ReuseRecord = true
+	// the number of fields is checked by the reader, according to its
+	// invalid row policy.
+	rr.r.FieldsPerRecord = -1
 	for _, opt := range opts {
 		opt(rr)
 	}
@@ -80,36 +171,84 @@ func NewReader(r io.Reader, schema *arrow.Schema, opts ...Option) *Reader {
 		rr.mem = memory.DefaultAllocator
 	}
 
-	rr.bld = array.NewRecordBuilder(rr.mem, rr.schema)
-
 	switch {
-	case rr.chunk < 0:
-		rr.next = rr.nextall
-	case rr.chunk > 1:
-		rr.next = rr.nextn
+	case rr.conc > 1:
+		rr.next = rr.nextParallel
 	default:
-		rr.next = rr.next1
+		rr.next = rr.nextRows
+	}
+
+	return rr
+}
+
+func (r *Reader) setSchema(schema *arrow.Schema) {
+	r.schema = schema
+	r.bld = array.NewRecordBuilder(r.mem, r.schema)
+	if r.policy == SkipInvalidRow {
+		r.check = array.NewRecordBuilder(r.mem, r.schema)
 	}
 
 	// Create a table of functions that will parse columns. This optimization
 	// allows us to specialize the implementation of each column's decoding
 	// and hoist type-based branches outside the inner loop.
-	rr.fieldConverter = make([]func(array.Builder, string), len(schema.Fields()))
+	r.fieldConverter = make([]func(array.Builder, string) error, len(schema.Fields()))
 	for idx, field := range schema.Fields() {
-		rr.fieldConverter[idx] = rr.initFieldConverter(&field)
+		r.fieldConverter[idx] = r.initFieldConverter(&field)
 	}
+}
 
-	return rr
+func (r *Reader) init() {
+	r.once.Do(func() {
+		r.err = r.initSchema()
+	})
+}
+
+// initSchema skips the leading rows of the CSV file, reads its header or
+// infers its schema, as configured, and applies the column projection.
+func (r *Reader) initSchema() error {
+	err := r.skip()
+	if err != nil {
+		return err
+	}
+
+	schema := r.schema
+	switch {
+	case r.infer:
+		schema, err = r.inferSchema()
+	case r.header:
+		schema, err = r.readHeader()
+	}
+	if err != nil {
+		return err
+	}
+
+	return r.project(schema)
+}
+
+// skip skips the leading rows of the CSV file, before its header.
+func (r *Reader) skip() error {
+	for i := 0; i < r.skipRows; i++ {
+		_, err := r.buf.ReadBytes('\n')
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return xerrors.Errorf("arrow/csv: could not skip rows: %w", err)
+		}
+		r.lineOffset++
+	}
+	r.lastLine = r.lineOffset
+	return nil
 }
 
-func (r *Reader) readHeader() error {
-	records, err := r.r.Read()
+func (r *Reader) readHeader() (*arrow.Schema, error) {
+	records, err := r.readCSV()
 	if err != nil {
-		return xerrors.Errorf("arrow/csv: could not read header from file: %w", err)
+		return nil, xerrors.Errorf("arrow/csv: could not read header from file: %w", err)
 	}
 
 	if len(records) != len(r.schema.Fields()) {
-		return ErrMismatchFields
+		return nil, ErrMismatchFields
 	}
 
 	fields := make([]arrow.Field, len(records))
@@ -119,32 +258,81 @@ func (r *Reader) readHeader() error {
 	}
 
 	meta := r.schema.Metadata()
-	r.schema = arrow.NewSchema(fields, &meta)
-	r.bld = array.NewRecordBuilder(r.mem, r.schema)
+	return arrow.NewSchema(fields, &meta), nil
+}
+
+// project selects the fields of the schema of the CSV file described by
+// the records, as configured with WithColumns or WithColumnIndices.
+func (r *Reader) project(schema *arrow.Schema) error {
+	r.nfields = len(schema.Fields())
+
+	switch {
+	case r.columns != nil:
+		r.proj = make([]int, len(r.columns))
+		for i, name := range r.columns {
+			idx := schema.FieldIndices(name)
+			if len(idx) == 0 {
+				return xerrors.Errorf("arrow/csv: unknown column %q", name)
+			}
+			r.proj[i] = idx[0]
+		}
+	case r.indices != nil:
+		r.proj = make([]int, len(r.indices))
+		for i, idx := range r.indices {
+			if idx < 0 || idx >= r.nfields {
+				return xerrors.Errorf("arrow/csv: column index %d out of range [0, %d)", idx, r.nfields)
+			}
+			r.proj[i] = idx
+		}
+	default:
+		r.proj = make([]int, r.nfields)
+		for i := range r.proj {
+			r.proj[i] = i
+		}
+		r.setSchema(schema)
+		return nil
+	}
+
+	fields := make([]arrow.Field, len(r.proj))
+	for i, idx := range r.proj {
+		fields[i] = schema.Field(idx)
+	}
+	meta := schema.Metadata()
+	r.setSchema(arrow.NewSchema(fields, &meta))
 	return nil
 }
 
 // Err returns the last error encountered during the iteration over the
 // underlying CSV file.
+// Errors about a specific row of the CSV file are reported as a *ParseError,
+// including ErrMismatchFields, which must be checked with xerrors.Is rather
+// than compared with ==.
 func (r *Reader) Err() error { return r.err }
 
-func (r *Reader) Schema() *arrow.Schema { return r.schema }
+// Schema returns the schema of the records extracted from the underlying CSV
+// file.
+//
+// Schema reads the header of the CSV file, or infers its schema, if it has not
+// been done yet.
+// For readers inferring their schema, Schema returns nil if the schema could
+// not be inferred.
+func (r *Reader) Schema() *arrow.Schema {
+	r.init()
+	return r.schema
+}
 
 // Record returns the current record that has been extracted from the
 // underlying CSV file.
 // It is valid until the next call to Next.
 func (r *Reader) Record() array.Record { return r.cur }
 
+// InvalidRows returns the number of rows skipped so far because they could
+// not be parsed, when the reader is configured with SkipInvalidRow.
+func (r *Reader) InvalidRows() int { return r.invalid }
+
 // Next returns whether a Record could be extracted from the underlying CSV file.
-//
-// Next panics if the number of records extracted from a CSV row does not match
-// the number of fields of the associated schema.
 func (r *Reader) Next() bool {
-	if r.header {
-		r.once.Do(func() {
-			r.err = r.readHeader()
-		})
-	}
+	r.init()
 
 	if r.cur != nil {
 		r.cur.Release()
@@ -158,91 +346,131 @@ func (r *Reader) Next() bool {
 	return r.next()
 }
 
-// next1 reads one row from the CSV file and creates a single Record
-// from that row.
-func (r *Reader) next1() bool {
-	var recs []string
-	recs, r.err = r.r.Read()
-	if r.err != nil {
-		r.done = true
-		if r.err == io.EOF {
-			r.err = nil
+// nextRows reads rows from the CSV file, up to the chunk size or the whole
+// file if the chunk size is negative, and creates a Record from these rows.
+//
+// With FailOnInvalidRow, reading stops after the first invalid row.
+func (r *Reader) nextRows() bool {
+	n := r.chunk
+	if n == 0 {
+		n = 1
+	}
+
+	start := r.nrows
+	for (n < 0 || r.nrows-start < n) && r.err == nil {
+		recs, err := r.readRow()
+		if err != nil {
+			r.done = true
+			if err != io.EOF {
+				r.err = err
+			}
+			break
 		}
-		return false
+		r.read(recs)
 	}
 
-	r.validate(recs)
-	r.read(recs)
-	r.cur = r.bld.NewRecord()
+	rec := r.newRecord()
+	if r.nrows == start {
+		rec.Release()
+		return false
+	}
 
+	r.cur = rec
 	return true
 }
 
-// nextall reads the whole CSV file into memory and creates one single
-// Record from all the CSV rows.
-func (r *Reader) nextall() bool {
-	defer func() {
-		r.done = true
-	}()
-
-	var (
-		recs [][]string
-	)
-
-	recs, r.err = r.r.ReadAll()
-	if r.err != nil {
-		return false
+func (r *Reader) newRecord() array.Record {
+	if r.check != nil {
+		r.check.NewRecord().Release()
 	}
+	return r.bld.NewRecord()
+}
 
-	for _, rec := range recs {
-		r.validate(rec)
-		r.read(rec)
+// readRow returns the next row, either from the rows sampled while
+// inferring the schema or from the underlying CSV file.
+// readRow returns io.EOF once the maximum number of rows has been read.
+func (r *Reader) readRow() ([]string, error) {
+	if r.maxRows >= 0 && r.nrows >= r.maxRows {
+		return nil, io.EOF
 	}
-	r.cur = r.bld.NewRecord()
 
-	return true
+	if len(r.sample) > 0 {
+		row := r.sample[0]
+		r.sample[0] = sampledRow{}
+		r.sample = r.sample[1:]
+		r.rowLine = row.line
+		return row.fields, nil
+	}
+	return r.readCSV()
 }
 
-// nextn reads n rows from the CSV file, where n is the chunk size, and creates
-// a Record from these rows.
-func (r *Reader) nextn() bool {
-	var (
-		recs []string
-		n    = 0
-	)
-
-	for i := 0; i < r.chunk && !r.done; i++ {
-		recs, r.err = r.r.Read()
-		if r.err != nil {
-			r.done = true
-			break
+// readCSV reads a row from the underlying CSV file, keeping track of the
+// lines of the file.
+func (r *Reader) readCSV() ([]string, error) {
+	recs, err := r.r.Read()
+	if err != nil {
+		if e, ok := err.(*csv.ParseError); ok {
+			pe := *e
+			pe.StartLine += r.lineOffset
+			pe.Line += r.lineOffset
+			err = &pe
 		}
-
-		r.validate(recs)
-		r.read(recs)
-		n++
+		return nil, err
 	}
 
-	if r.err != nil {
-		r.done = true
-		if r.err == io.EOF {
-			r.err = nil
-		}
+	r.lastLine = r.lineOffset + r.lines.line()
+	r.rowLine = r.lastLine
+	for _, str := range recs {
+		// quoted fields may span several lines.
+		r.rowLine -= strings.Count(str, "\n")
 	}
+	return recs, nil
+}
 
-	r.cur = r.bld.NewRecord()
-	return n > 0
+// lineReader reads its underlying reader one line at a time, keeping track
+// of the number of lines read.
+type lineReader struct {
+	r    *bufio.Reader
+	buf  []byte // remainder of the current line
+	err  error
+	nl   int  // number of newlines read
+	part bool // whether the last line read is not terminated by a newline
 }
 
-func (r *Reader) validate(recs []string) {
-	if r.err != nil {
-		return
+func (lr *lineReader) Read(p []byte) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+	if len(lr.buf) == 0 {
+		if lr.err != nil {
+			return 0, lr.err
+		}
+		lr.buf, lr.err = lr.r.ReadSlice('\n')
+		if lr.err == bufio.ErrBufferFull {
+			lr.err = nil
+		}
+		if len(lr.buf) == 0 {
+			return 0, lr.err
+		}
 	}
 
-	if len(recs) != len(r.schema.Fields()) {
-		r.err = ErrMismatchFields
-		return
+	n := copy(p, lr.buf)
+	lr.buf = lr.buf[n:]
+	if p[n-1] == '\n' {
+		lr.nl++
+		lr.part = false
+	} else {
+		lr.part = true
+	}
+	return n, nil
+}
+
+// line returns the number of the last line read, starting at 1.
+func (lr *lineReader) line() int {
+	if lr.part {
+		return lr.nl + 1
 	}
+	return lr.nl
 }
 
 func (r *Reader) isNull(val string) bool {
@@ -254,83 +482,175 @@ func (r *Reader) isNull(val string) bool {
 	return false
 }
 
+// read appends a row to the records being built, according to the invalid
+// row policy of the reader.
 func (r *Reader) read(recs []string) {
-	for i, str := range recs {
-		r.fieldConverter[i](r.bld.Field(i), str)
+	if len(recs) != r.nfields {
+		switch r.policy {
+		case SkipInvalidRow:
+			r.invalid++
+		case NullInvalidRow:
+			for i := range r.proj {
+				r.bld.Field(i).AppendNull()
+			}
+			r.nrows++
+		default:
+			r.setErr(&ParseError{Line: r.rowLine, Err: ErrMismatchFields})
+		}
+		return
+	}
+
+	if r.policy == SkipInvalidRow {
+		if err := r.convert(r.check, recs); err != nil {
+			r.invalid++
+			return
+		}
+	}
+
+	err := r.convert(r.bld, recs)
+	r.nrows++
+	if err != nil && r.policy == FailOnInvalidRow {
+		r.setErr(err)
+	}
+}
+
+// convert appends the projected fields of a row to the given builder.
+// Invalid values are appended as nulls and the first error is returned.
+func (r *Reader) convert(bld *array.RecordBuilder, recs []string) error {
+	var err error
+	for i, j := range r.proj {
+		e := r.fieldConverter[i](bld.Field(i), recs[j])
+		if e != nil && err == nil {
+			err = &ParseError{Line: r.rowLine, Column: j + 1, Err: e}
+		}
 	}
+	return err
 }
 
-func (r *Reader) initFieldConverter(field *arrow.Field) func(array.Builder, string) {
-	switch field.Type.(type) {
+func (r *Reader) initFieldConverter(field *arrow.Field) func(array.Builder, string) error {
+	switch dt := field.Type.(type) {
 	case *arrow.BooleanType:
-		return func(field array.Builder, str string) {
-			r.parseBool(field, str)
+		return func(field array.Builder, str string) error {
+			return r.parseBool(field, str)
 		}
 	case *arrow.Int8Type:
-		return func(field array.Builder, str string) {
-			r.parseInt8(field, str)
+		return func(field array.Builder, str string) error {
+			return r.parseInt8(field, str)
 		}
 	case *arrow.Int16Type:
-		return func(field array.Builder, str string) {
-			r.parseInt16(field, str)
+		return func(field array.Builder, str string) error {
+			return r.parseInt16(field, str)
 		}
 	case *arrow.Int32Type:
-		return func(field array.Builder, str string) {
-			r.parseInt32(field, str)
+		return func(field array.Builder, str string) error {
+			return r.parseInt32(field, str)
 		}
 	case *arrow.Int64Type:
-		return func(field array.Builder, str string) {
-			r.parseInt64(field, str)
+		return func(field array.Builder, str string) error {
+			return r.parseInt64(field, str)
 		}
 	case *arrow.Uint8Type:
-		return func(field array.Builder, str string) {
-			r.parseUint8(field, str)
+		return func(field array.Builder, str string) error {
+			return r.parseUint8(field, str)
 		}
 	case *arrow.Uint16Type:
-		return func(field array.Builder, str string) {
-			r.parseUint16(field, str)
+		return func(field array.Builder, str string) error {
+			return r.parseUint16(field, str)
 		}
 	case *arrow.Uint32Type:
-		return func(field array.Builder, str string) {
-			r.parseUint32(field, str)
+		return func(field array.Builder, str string) error {
+			return r.parseUint32(field, str)
 		}
 	case *arrow.Uint64Type:
-		return func(field array.Builder, str string) {
-			r.parseUint64(field, str)
+		return func(field array.Builder, str string) error {
+			return r.parseUint64(field, str)
 		}
 	case *arrow.Float32Type:
-		return func(field array.Builder, str string) {
-			r.parseFloat32(field, str)
+		return func(field array.Builder, str string) error {
+			return r.parseFloat32(field, str)
 		}
 	case *arrow.Float64Type:
-		return func(field array.Builder, str string) {
-			r.parseFloat64(field, str)
+		return func(field array.Builder, str string) error {
+			return r.parseFloat64(field, str)
 		}
 	case *arrow.StringType:
 		// specialize the implementation when we know we cannot have nulls
 		if r.stringsCanBeNull {
-			return func(field array.Builder, str string) {
+			return func(field array.Builder, str string) error {
 				if r.isNull(str) {
 					field.AppendNull()
 				} else {
 					field.(*array.StringBuilder).Append(str)
 				}
+				return nil
 			}
 		} else {
-			return func(field array.Builder, str string) {
+			return func(field array.Builder, str string) error {
 				field.(*array.StringBuilder).Append(str)
+				return nil
 			}
 		}
 
+	case *arrow.Float16Type:
+		return func(field array.Builder, str string) error {
+			return r.parseFloat16(field, str)
+		}
+	case *arrow.Decimal128Type:
+		return func(field array.Builder, str string) error {
+			return r.parseDecimal128(field, str, dt.Precision, dt.Scale)
+		}
+	case *arrow.BinaryType:
+		return func(field array.Builder, str string) error {
+			return r.parseBinary(field, str)
+		}
+	case *arrow.FixedSizeBinaryType:
+		return func(field array.Builder, str string) error {
+			return r.parseFixedSizeBinary(field, str, dt.ByteWidth)
+		}
+	case *arrow.TimestampType:
+		loc := time.UTC
+		if dt.TimeZone != "" {
+			// time zone validity has been checked by validate.
+			loc, _ = time.LoadLocation(dt.TimeZone)
+		}
+		return func(field array.Builder, str string) error {
+			return r.parseTimestamp(field, str, dt.Unit, loc)
+		}
+	case *arrow.Date32Type:
+		return func(field array.Builder, str string) error {
+			return r.parseDate32(field, str)
+		}
+	case *arrow.Date64Type:
+		return func(field array.Builder, str string) error {
+			return r.parseDate64(field, str)
+		}
+	case *arrow.Time32Type:
+		return func(field array.Builder, str string) error {
+			return r.parseTime32(field, str, dt.Unit)
+		}
+	case *arrow.Time64Type:
+		return func(field array.Builder, str string) error {
+			return r.parseTime64(field, str, dt.Unit)
+		}
+	case *arrow.DurationType:
+		return func(field array.Builder, str string) error {
+			return r.parseDuration(field, str, dt.Unit)
+		}
+	case *arrow.ListType:
+		elem := r.initFieldConverter(&arrow.Field{Type: dt.Elem(), Nullable: true})
+		return func(field array.Builder, str string) error {
+			return r.parseList(field, str, elem)
+		}
+
 	default:
 		panic(fmt.Errorf("arrow/csv: unhandled field type %T", field.Type))
 	}
 }
 
-func (r *Reader) parseBool(field array.Builder, str string) {
+func (r *Reader) parseBool(field array.Builder, str string) error {
 	if r.isNull(str) {
 		field.AppendNull()
-		return
+		return nil
 	}
 
 	var v bool
@@ -340,171 +660,408 @@ func (r *Reader) parseBool(field array.Builder, str string) {
 	case "true", "True", "1":
 		v = true
 	default:
-		r.err = fmt.Errorf("Unrecognized boolean: %s", str)
 		field.AppendNull()
-		return
+		return fmt.Errorf("Unrecognized boolean: %s", str)
 	}
 
 	field.(*array.BooleanBuilder).Append(v)
+	return nil
 }
 
-func (r *Reader) parseInt8(field array.Builder, str string) {
+func (r *Reader) parseInt8(field array.Builder, str string) error {
 	if r.isNull(str) {
 		field.AppendNull()
-		return
+		return nil
 	}
 
 	v, err := strconv.ParseInt(str, 10, 8)
-	if err != nil && r.err == nil {
-		r.err = err
+	if err != nil {
 		field.AppendNull()
-		return
+		return err
 	}
 
 	field.(*array.Int8Builder).Append(int8(v))
+	return nil
 }
 
-func (r *Reader) parseInt16(field array.Builder, str string) {
+func (r *Reader) parseInt16(field array.Builder, str string) error {
 	if r.isNull(str) {
 		field.AppendNull()
-		return
+		return nil
 	}
 
 	v, err := strconv.ParseInt(str, 10, 16)
-	if err != nil && r.err == nil {
-		r.err = err
+	if err != nil {
 		field.AppendNull()
-		return
+		return err
 	}
 
 	field.(*array.Int16Builder).Append(int16(v))
+	return nil
 }
 
-func (r *Reader) parseInt32(field array.Builder, str string) {
+func (r *Reader) parseInt32(field array.Builder, str string) error {
 	if r.isNull(str) {
 		field.AppendNull()
-		return
+		return nil
 	}
 
 	v, err := strconv.ParseInt(str, 10, 32)
-	if err != nil && r.err == nil {
-		r.err = err
+	if err != nil {
 		field.AppendNull()
-		return
+		return err
 	}
 
 	field.(*array.Int32Builder).Append(int32(v))
+	return nil
 }
 
-func (r *Reader) parseInt64(field array.Builder, str string) {
+func (r *Reader) parseInt64(field array.Builder, str string) error {
 	if r.isNull(str) {
 		field.AppendNull()
-		return
+		return nil
 	}
 
 	v, err := strconv.ParseInt(str, 10, 64)
-	if err != nil && r.err == nil {
-		r.err = err
+	if err != nil {
 		field.AppendNull()
-		return
+		return err
 	}
 
 	field.(*array.Int64Builder).Append(v)
+	return nil
 }
 
-func (r *Reader) parseUint8(field array.Builder, str string) {
+func (r *Reader) parseUint8(field array.Builder, str string) error {
 	if r.isNull(str) {
 		field.AppendNull()
-		return
+		return nil
 	}
 
 	v, err := strconv.ParseUint(str, 10, 8)
-	if err != nil && r.err == nil {
-		r.err = err
+	if err != nil {
 		field.AppendNull()
-		return
+		return err
 	}
 
 	field.(*array.Uint8Builder).Append(uint8(v))
+	return nil
 }
 
-func (r *Reader) parseUint16(field array.Builder, str string) {
+func (r *Reader) parseUint16(field array.Builder, str string) error {
 	if r.isNull(str) {
 		field.AppendNull()
-		return
+		return nil
 	}
 
 	v, err := strconv.ParseUint(str, 10, 16)
-	if err != nil && r.err == nil {
-		r.err = err
+	if err != nil {
 		field.AppendNull()
-		return
+		return err
 	}
 
 	field.(*array.Uint16Builder).Append(uint16(v))
+	return nil
 }
 
-func (r *Reader) parseUint32(field array.Builder, str string) {
+func (r *Reader) parseUint32(field array.Builder, str string) error {
 	if r.isNull(str) {
 		field.AppendNull()
-		return
+		return nil
 	}
 
 	v, err := strconv.ParseUint(str, 10, 32)
-	if err != nil && r.err == nil {
-		r.err = err
+	if err != nil {
 		field.AppendNull()
-		return
+		return err
 	}
 
 	field.(*array.Uint32Builder).Append(uint32(v))
+	return nil
 }
 
-func (r *Reader) parseUint64(field array.Builder, str string) {
+func (r *Reader) parseUint64(field array.Builder, str string) error {
 	if r.isNull(str) {
 		field.AppendNull()
-		return
+		return nil
 	}
 
 	v, err := strconv.ParseUint(str, 10, 64)
-	if err != nil && r.err == nil {
-		r.err = err
+	if err != nil {
 		field.AppendNull()
-		return
+		return err
 	}
 
 	field.(*array.Uint64Builder).Append(v)
+	return nil
 }
 
-func (r *Reader) parseFloat32(field array.Builder, str string) {
+func (r *Reader) parseFloat32(field array.Builder, str string) error {
 	if r.isNull(str) {
 		field.AppendNull()
-		return
+		return nil
 	}
 
 	v, err := strconv.ParseFloat(str, 32)
-	if err != nil && r.err == nil {
-		r.err = err
+	if err != nil {
 		field.AppendNull()
-		return
+		return err
 	}
 	field.(*array.Float32Builder).Append(float32(v))
 
+	return nil
 }
 
-func (r *Reader) parseFloat64(field array.Builder, str string) {
+func (r *Reader) parseFloat64(field array.Builder, str string) error {
 	if r.isNull(str) {
 		field.AppendNull()
-		return
+		return nil
 	}
 
 	v, err := strconv.ParseFloat(str, 64)
-	if err != nil && r.err == nil {
-		r.err = err
+	if err != nil {
 		field.AppendNull()
-		return
+		return err
 	}
 	field.(*array.Float64Builder).Append(v)
+	return nil
+}
+
+func (r *Reader) parseFloat16(field array.Builder, str string) error {
+	if r.isNull(str) {
+		field.AppendNull()
+		return nil
+	}
+
+	v, err := strconv.ParseFloat(str, 32)
+	if err != nil {
+		field.AppendNull()
+		return err
+	}
+	field.(*array.Float16Builder).Append(float16.New(float32(v)))
+	return nil
+}
+
+func (r *Reader) parseDecimal128(field array.Builder, str string, prec, scale int32) error {
+	if r.isNull(str) {
+		field.AppendNull()
+		return nil
+	}
+
+	v, err := decimal128.FromString(str, prec, scale)
+	if err != nil {
+		field.AppendNull()
+		return err
+	}
+	field.(*array.Decimal128Builder).Append(v)
+	return nil
+}
+
+func (r *Reader) decodeBinary(str string) ([]byte, error) {
+	var (
+		v   []byte
+		err error
+	)
+	switch r.binEnc {
+	case Hex:
+		v, err = hex.DecodeString(str)
+	default:
+		v, err = base64.StdEncoding.DecodeString(str)
+	}
+	if err != nil {
+		return nil, xerrors.Errorf("arrow/csv: could not decode binary value %q: %w", str, err)
+	}
+	return v, nil
+}
+
+func (r *Reader) parseBinary(field array.Builder, str string) error {
+	if r.isNull(str) {
+		field.AppendNull()
+		return nil
+	}
+
+	v, err := r.decodeBinary(str)
+	if err != nil {
+		field.AppendNull()
+		return err
+	}
+	field.(*array.BinaryBuilder).Append(v)
+	return nil
+}
+
+func (r *Reader) parseFixedSizeBinary(field array.Builder, str string, n int) error {
+	if r.isNull(str) {
+		field.AppendNull()
+		return nil
+	}
+
+	v, err := r.decodeBinary(str)
+	if err != nil {
+		field.AppendNull()
+		return err
+	}
+
+	if len(v) != n {
+		field.AppendNull()
+		return xerrors.Errorf("arrow/csv: invalid fixed-size binary value %q (got=%d bytes, want=%d)", str, len(v), n)
+	}
+	field.(*array.FixedSizeBinaryBuilder).Append(v)
+	return nil
+}
+
+func (r *Reader) parseTimestamp(field array.Builder, str string, unit arrow.TimeUnit, loc *time.Location) error {
+	if r.isNull(str) {
+		field.AppendNull()
+		return nil
+	}
+
+	var (
+		t   time.Time
+		err error
+	)
+	for _, layout := range r.tsLayouts {
+		t, err = time.ParseInLocation(layout, str, loc)
+		if err == nil {
+			break
+		}
+	}
+	if err != nil || len(r.tsLayouts) == 0 {
+		field.AppendNull()
+		return xerrors.Errorf("arrow/csv: could not parse timestamp %q", str)
+	}
+	field.(*array.TimestampBuilder).Append(arrow.Timestamp(arrtime.FromTime(t, unit)))
+	return nil
+}
+
+func (r *Reader) parseDate(str string) (int64, error) {
+	t, err := time.Parse(arrtime.DateLayout, str)
+	if err != nil {
+		return 0, xerrors.Errorf("arrow/csv: could not parse date %q: %w", str, err)
+	}
+	return t.Unix() / arrtime.SecondsPerDay, nil
+}
+
+func (r *Reader) parseDate32(field array.Builder, str string) error {
+	if r.isNull(str) {
+		field.AppendNull()
+		return nil
+	}
+
+	days, err := r.parseDate(str)
+	if err != nil {
+		field.AppendNull()
+		return err
+	}
+	field.(*array.Date32Builder).Append(arrow.Date32(days))
+	return nil
+}
+
+func (r *Reader) parseDate64(field array.Builder, str string) error {
+	if r.isNull(str) {
+		field.AppendNull()
+		return nil
+	}
+
+	days, err := r.parseDate(str)
+	if err != nil {
+		field.AppendNull()
+		return err
+	}
+	field.(*array.Date64Builder).Append(arrow.Date64(days * arrtime.SecondsPerDay * 1000))
+	return nil
+}
+
+func (r *Reader) parseTime(str string, unit arrow.TimeUnit) (int64, error) {
+	v, err := arrtime.ParseTimeOfDay(str, unit)
+	if err != nil {
+		return 0, xerrors.Errorf("arrow/csv: could not parse time %q: %w", str, err)
+	}
+	return v, nil
+}
+
+func (r *Reader) parseTime32(field array.Builder, str string, unit arrow.TimeUnit) error {
+	if r.isNull(str) {
+		field.AppendNull()
+		return nil
+	}
+
+	v, err := r.parseTime(str, unit)
+	if err != nil {
+		field.AppendNull()
+		return err
+	}
+	field.(*array.Time32Builder).Append(arrow.Time32(v))
+	return nil
+}
+
+func (r *Reader) parseTime64(field array.Builder, str string, unit arrow.TimeUnit) error {
+	if r.isNull(str) {
+		field.AppendNull()
+		return nil
+	}
+
+	v, err := r.parseTime(str, unit)
+	if err != nil {
+		field.AppendNull()
+		return err
+	}
+	field.(*array.Time64Builder).Append(arrow.Time64(v))
+	return nil
+}
+
+// parseDuration parses durations expressed either as an integer number of
+// time units, or as a string understood by time.ParseDuration.
+func (r *Reader) parseDuration(field array.Builder, str string, unit arrow.TimeUnit) error {
+	if r.isNull(str) {
+		field.AppendNull()
+		return nil
+	}
+
+	v, err := strconv.ParseInt(str, 10, 64)
+	if err != nil {
+		d, derr := time.ParseDuration(str)
+		if derr != nil {
+			field.AppendNull()
+			return xerrors.Errorf("arrow/csv: could not parse duration %q: %w", str, derr)
+		}
+		v = int64(d / unit.Multiplier())
+	}
+	field.(*array.DurationBuilder).Append(arrow.Duration(v))
+	return nil
+}
+
+// parseList parses the elements of a list, separated by the list delimiter,
+// with the converter of the list element type.
+// An empty field is an empty list.
+func (r *Reader) parseList(field array.Builder, str string, elem func(array.Builder, string) error) error {
+	if r.isNull(str) {
+		field.AppendNull()
+		return nil
+	}
+
+	bldr := field.(*array.ListBuilder)
+	bldr.Append(true)
+	if str == "" {
+		return nil
+	}
+
+	// invalid elements are appended as nulls and the first error is reported.
+	var err error
+	vb := bldr.ValueBuilder()
+	for _, v := range strings.Split(str, string(r.listDelim)) {
+		if e := elem(vb, v); e != nil && err == nil {
+			err = e
+		}
+	}
+	return err
+}
+
+// setErr records err as the error of the reader, unless an error was
+// already recorded.
+func (r *Reader) setErr(err error) {
+	if r.err == nil {
+		r.err = err
+	}
 }
 
 // Retain increases the reference count by 1.
@@ -520,6 +1077,7 @@ func (r *Reader) Release() {
 	debug.Assert(atomic.LoadInt64(&r.refs) > 0, "too many releases")
 
 	if atomic.AddInt64(&r.refs, -1) == 0 {
+		r.stopParallel()
 		if r.cur != nil {
 			r.cur.Release()
 		}
@@ -537,6 +537,140 @@ rec[0]["str"]: ["str-0" "str-1" "str-2" "str-3" "str-4" "str-5" "str-6" "str-7"
 	}
 }
 
+func TestCSVReaderTypes(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	raw := []byte(`ts;tz;d32;d64;t32;t64;dec;f16;bin;fsb;dur;list
+2020-01-02T03:04:05.5Z;2020-01-02 03:04:05;2020-01-02;1969-12-31;01:02:03;01:02;123.45;1.5;aGVsbG8=;YWI=;1h;1,2,3
+2020-01-02;2020-01-02T03:04:05+02:00;1970-01-01;1970-01-02;23:59:59;00:00:01;-0.5;-2;;Y2Q=;42;
+NULL;NULL;NULL;NULL;NULL;NULL;NULL;NULL;NULL;NULL;NULL;NULL
+`)
+
+	schema := arrow.NewSchema(
+		[]arrow.Field{
+			{Name: "ts", Type: &arrow.TimestampType{Unit: arrow.Millisecond}, Nullable: true},
+			{Name: "tz", Type: &arrow.TimestampType{Unit: arrow.Second, TimeZone: "Europe/Paris"}, Nullable: true},
+			{Name: "d32", Type: arrow.FixedWidthTypes.Date32, Nullable: true},
+			{Name: "d64", Type: arrow.FixedWidthTypes.Date64, Nullable: true},
+			{Name: "t32", Type: arrow.FixedWidthTypes.Time32s, Nullable: true},
+			{Name: "t64", Type: arrow.FixedWidthTypes.Time64us, Nullable: true},
+			{Name: "dec", Type: &arrow.Decimal128Type{Precision: 10, Scale: 2}, Nullable: true},
+			{Name: "f16", Type: arrow.FixedWidthTypes.Float16, Nullable: true},
+			{Name: "bin", Type: arrow.BinaryTypes.Binary, Nullable: true},
+			{Name: "fsb", Type: &arrow.FixedSizeBinaryType{ByteWidth: 2}, Nullable: true},
+			{Name: "dur", Type: arrow.FixedWidthTypes.Duration_s, Nullable: true},
+			{Name: "list", Type: arrow.ListOf(arrow.PrimitiveTypes.Int32), Nullable: true},
+		},
+		nil,
+	)
+
+	r := csv.NewReader(bytes.NewReader(raw), schema,
+		csv.WithAllocator(mem),
+		csv.WithComma(';'),
+		csv.WithHeader(true),
+		csv.WithChunk(-1),
+		csv.WithListDelimiter(','),
+		csv.WithNullReader(true, "NULL"),
+	)
+	defer r.Release()
+
+	if !r.Next() {
+		t.Fatalf("could not read record: %v", r.Err())
+	}
+	if r.Err() != nil {
+		t.Fatalf("unexpected error: %v", r.Err())
+	}
+
+	out := new(bytes.Buffer)
+	rec := r.Record()
+	for i, col := range rec.Columns() {
+		fmt.Fprintf(out, "%s: %v\n", rec.ColumnName(i), col)
+	}
+
+	want := `ts: [1577934245500 1577923200000 (null)]
+tz: [1577930645 1577927045 (null)]
+d32: [18263 0 (null)]
+d64: [-86400000 86400000 (null)]
+t32: [3723 86399 (null)]
+t64: [3720000000 1000000 (null)]
+dec: [{12345 0} {18446744073709551566 -1} (null)]
+f16: [1.5 -2 (null)]
+bin: ["hello" "" (null)]
+fsb: ["ab" "cd" (null)]
+dur: [3600 42 (null)]
+list: [[1 2 3] [] (null)]
+`
+	if got := out.String(); got != want {
+		t.Fatalf("invalid output:\ngot:\n%s\nwant:\n%s\n", got, want)
+	}
+
+	if r.Next() {
+		t.Fatalf("unexpected record")
+	}
+}
+
+func TestCSVReaderBinaryEncoding(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	schema := arrow.NewSchema(
+		[]arrow.Field{
+			{Name: "bin", Type: arrow.BinaryTypes.Binary},
+		},
+		nil,
+	)
+
+	r := csv.NewReader(bytes.NewReader([]byte("68656c6c6f\n776f726c64\n")), schema,
+		csv.WithAllocator(mem),
+		csv.WithChunk(-1),
+		csv.WithBinaryEncoding(csv.Hex),
+	)
+	defer r.Release()
+
+	if !r.Next() {
+		t.Fatalf("could not read record: %v", r.Err())
+	}
+
+	if got, want := fmt.Sprintf("%v", r.Record().Column(0)), `["hello" "world"]`; got != want {
+		t.Fatalf("invalid output: got=%s, want=%s", got, want)
+	}
+}
+
+func TestCSVReaderParseError(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	for _, tc := range []struct {
+		name string
+		typ  arrow.DataType
+		raw  string
+	}{
+		{"timestamp", &arrow.TimestampType{Unit: arrow.Second}, "2020-13-01\n"},
+		{"date32", arrow.FixedWidthTypes.Date32, "2020/01/01\n"},
+		{"time32", arrow.FixedWidthTypes.Time32ms, "25:00\n"},
+		{"decimal", &arrow.Decimal128Type{Precision: 3, Scale: 1}, "123.4\n"},
+		{"decimal-scale", &arrow.Decimal128Type{Precision: 5, Scale: 1}, "1.25\n"},
+		{"binary", arrow.BinaryTypes.Binary, "not base64\n"},
+		{"fixed-size-binary", &arrow.FixedSizeBinaryType{ByteWidth: 4}, "YWI=\n"},
+		{"duration", arrow.FixedWidthTypes.Duration_ms, "1 hour\n"},
+		{"list", arrow.ListOf(arrow.PrimitiveTypes.Int8), "1;x\n"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			schema := arrow.NewSchema([]arrow.Field{{Name: "v", Type: tc.typ}}, nil)
+			r := csv.NewReader(bytes.NewReader([]byte(tc.raw)), schema, csv.WithAllocator(mem))
+			defer r.Release()
+
+			for r.Next() {
+			}
+
+			if r.Err() == nil {
+				t.Fatalf("expected an error")
+			}
+		})
+	}
+}
+
 func BenchmarkRead(b *testing.B) {
 	gen := func(rows, cols int) []byte {
 		buf := new(bytes.Buffer)
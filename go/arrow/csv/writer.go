@@ -17,13 +17,21 @@
 package csv
 
 import (
+	"encoding/base64"
 	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
 	"io"
 	"strconv"
 	"sync"
+	"time"
 
 	"github.com/apache/arrow/go/arrow"
 	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/internal/arrtime"
+	"github.com/apache/arrow/go/arrow/internal/jsonenc"
+	"golang.org/x/xerrors"
 )
 
 // Writer wraps encoding/csv.Writer and writes array.Record based on a schema.
@@ -33,25 +41,53 @@ type Writer struct {
 	header    bool
 	once      sync.Once
 	nullValue string
+
+	tsLayout   string
+	loc        *time.Location
+	binEnc     BinaryEncoding
+	formatters map[string]FormatFunc
+
+	fieldFormatter []FormatFunc
 }
 
+// FormatFunc formats the i-th value of an array as a CSV field.
+// FormatFunc is only called for valid (non-null) values.
+type FormatFunc func(arr array.Interface, i int) string
+
 // NewWriter returns a writer that writes array.Records to the CSV file
 // with the given schema.
 //
-// NewWriter panics if the given schema contains fields that have types that are not
-// primitive types.
+// Nested list and struct values are written as JSON-encoded fields.
+//
+// NewWriter panics if the given schema contains fields that have types that
+// cannot be written, unless a formatter has been registered for these fields
+// with WithFormatter.
 func NewWriter(w io.Writer, schema *arrow.Schema, opts ...Option) *Writer {
-	validate(schema)
-
 	ww := &Writer{
 		w:         csv.NewWriter(w),
 		schema:    schema,
 		nullValue: "NULL", // override by passing WithNullWriter() as an option
+		tsLayout:  DefaultTimestampLayouts[0],
+		binEnc:    Base64,
 	}
 	for _, opt := range opts {
 		opt(ww)
 	}
 
+	// Create a table of functions that will format columns, as is done for
+	// the field converters of Reader.
+	ww.fieldFormatter = make([]FormatFunc, len(schema.Fields()))
+	for i, field := range schema.Fields() {
+		format, ok := ww.formatters[field.Name]
+		if !ok {
+			format = ww.initFieldFormatter(field.Type)
+		}
+		if format == nil {
+			panic(fmt.Errorf("arrow/csv: field %d (%s) has invalid data type %T", i, field.Name, field.Type))
+		}
+		ww.fieldFormatter[i] = format
+	}
+
 	return ww
 }
 
@@ -79,121 +115,184 @@ func (w *Writer) Write(record array.Record) error {
 	}
 
 	for j, col := range record.Columns() {
-		switch w.schema.Field(j).Type.(type) {
-		case *arrow.BooleanType:
-			arr := col.(*array.Boolean)
-			for i := 0; i < arr.Len(); i++ {
-				if arr.IsValid(i) {
-					recs[i][j] = strconv.FormatBool(arr.Value(i))
-				} else {
-					recs[i][j] = w.nullValue
-				}
-			}
-		case *arrow.Int8Type:
-			arr := col.(*array.Int8)
-			for i := 0; i < arr.Len(); i++ {
-				if arr.IsValid(i) {
-					recs[i][j] = strconv.FormatInt(int64(arr.Value(i)), 10)
-				} else {
-					recs[i][j] = w.nullValue
-				}
-			}
-		case *arrow.Int16Type:
-			arr := col.(*array.Int16)
-			for i := 0; i < arr.Len(); i++ {
-				if arr.IsValid(i) {
-					recs[i][j] = strconv.FormatInt(int64(arr.Value(i)), 10)
-				} else {
-					recs[i][j] = w.nullValue
-				}
-			}
-		case *arrow.Int32Type:
-			arr := col.(*array.Int32)
-			for i := 0; i < arr.Len(); i++ {
-				if arr.IsValid(i) {
-					recs[i][j] = strconv.FormatInt(int64(arr.Value(i)), 10)
-				} else {
-					recs[i][j] = w.nullValue
-				}
-			}
-		case *arrow.Int64Type:
-			arr := col.(*array.Int64)
-			for i := 0; i < arr.Len(); i++ {
-				if arr.IsValid(i) {
-					recs[i][j] = strconv.FormatInt(int64(arr.Value(i)), 10)
-				} else {
-					recs[i][j] = w.nullValue
-				}
+		format := w.fieldFormatter[j]
+		for i := 0; i < col.Len(); i++ {
+			if col.IsValid(i) {
+				recs[i][j] = format(col, i)
+			} else {
+				recs[i][j] = w.nullValue
 			}
-		case *arrow.Uint8Type:
-			arr := col.(*array.Uint8)
-			for i := 0; i < arr.Len(); i++ {
-				if arr.IsValid(i) {
-					recs[i][j] = strconv.FormatUint(uint64(arr.Value(i)), 10)
-				} else {
-					recs[i][j] = w.nullValue
-				}
-			}
-		case *arrow.Uint16Type:
-			arr := col.(*array.Uint16)
-			for i := 0; i < arr.Len(); i++ {
-				if arr.IsValid(i) {
-					recs[i][j] = strconv.FormatUint(uint64(arr.Value(i)), 10)
-				} else {
-					recs[i][j] = w.nullValue
-				}
-			}
-		case *arrow.Uint32Type:
-			arr := col.(*array.Uint32)
-			for i := 0; i < arr.Len(); i++ {
-				if arr.IsValid(i) {
-					recs[i][j] = strconv.FormatUint(uint64(arr.Value(i)), 10)
-				} else {
-					recs[i][j] = w.nullValue
-				}
-			}
-		case *arrow.Uint64Type:
-			arr := col.(*array.Uint64)
-			for i := 0; i < arr.Len(); i++ {
-				if arr.IsValid(i) {
-					recs[i][j] = strconv.FormatUint(uint64(arr.Value(i)), 10)
-				} else {
-					recs[i][j] = w.nullValue
-				}
-			}
-		case *arrow.Float32Type:
-			arr := col.(*array.Float32)
-			for i := 0; i < arr.Len(); i++ {
-				if arr.IsValid(i) {
-					recs[i][j] = strconv.FormatFloat(float64(arr.Value(i)), 'g', -1, 32)
-				} else {
-					recs[i][j] = w.nullValue
-				}
-			}
-		case *arrow.Float64Type:
-			arr := col.(*array.Float64)
-			for i := 0; i < arr.Len(); i++ {
-				if arr.IsValid(i) {
-					recs[i][j] = strconv.FormatFloat(float64(arr.Value(i)), 'g', -1, 64)
-				} else {
-					recs[i][j] = w.nullValue
-				}
-			}
-		case *arrow.StringType:
-			arr := col.(*array.String)
-			for i := 0; i < arr.Len(); i++ {
-				if arr.IsValid(i) {
-					recs[i][j] = arr.Value(i)
-				} else {
-					recs[i][j] = w.nullValue
+		}
+	}
+
+	return w.w.WriteAll(recs)
+}
+
+// initFieldFormatter returns the function formatting values of the given
+// data type, or nil if the data type is not supported.
+func (w *Writer) initFieldFormatter(dt arrow.DataType) FormatFunc {
+	switch dt := dt.(type) {
+	case *arrow.BooleanType:
+		return func(arr array.Interface, i int) string {
+			return strconv.FormatBool(arr.(*array.Boolean).Value(i))
+		}
+	case *arrow.Int8Type:
+		return func(arr array.Interface, i int) string {
+			return strconv.FormatInt(int64(arr.(*array.Int8).Value(i)), 10)
+		}
+	case *arrow.Int16Type:
+		return func(arr array.Interface, i int) string {
+			return strconv.FormatInt(int64(arr.(*array.Int16).Value(i)), 10)
+		}
+	case *arrow.Int32Type:
+		return func(arr array.Interface, i int) string {
+			return strconv.FormatInt(int64(arr.(*array.Int32).Value(i)), 10)
+		}
+	case *arrow.Int64Type:
+		return func(arr array.Interface, i int) string {
+			return strconv.FormatInt(int64(arr.(*array.Int64).Value(i)), 10)
+		}
+	case *arrow.Uint8Type:
+		return func(arr array.Interface, i int) string {
+			return strconv.FormatUint(uint64(arr.(*array.Uint8).Value(i)), 10)
+		}
+	case *arrow.Uint16Type:
+		return func(arr array.Interface, i int) string {
+			return strconv.FormatUint(uint64(arr.(*array.Uint16).Value(i)), 10)
+		}
+	case *arrow.Uint32Type:
+		return func(arr array.Interface, i int) string {
+			return strconv.FormatUint(uint64(arr.(*array.Uint32).Value(i)), 10)
+		}
+	case *arrow.Uint64Type:
+		return func(arr array.Interface, i int) string {
+			return strconv.FormatUint(uint64(arr.(*array.Uint64).Value(i)), 10)
+		}
+	case *arrow.Float16Type:
+		return func(arr array.Interface, i int) string {
+			return strconv.FormatFloat(float64(arr.(*array.Float16).Value(i).Float32()), 'g', -1, 32)
+		}
+	case *arrow.Float32Type:
+		return func(arr array.Interface, i int) string {
+			return strconv.FormatFloat(float64(arr.(*array.Float32).Value(i)), 'g', -1, 32)
+		}
+	case *arrow.Float64Type:
+		return func(arr array.Interface, i int) string {
+			return strconv.FormatFloat(float64(arr.(*array.Float64).Value(i)), 'g', -1, 64)
+		}
+	case *arrow.Decimal128Type:
+		return func(arr array.Interface, i int) string {
+			return arr.(*array.Decimal128).Value(i).ToString(dt.Scale)
+		}
+	case *arrow.StringType:
+		return func(arr array.Interface, i int) string {
+			return arr.(*array.String).Value(i)
+		}
+	case *arrow.BinaryType:
+		return func(arr array.Interface, i int) string {
+			return w.encodeBinary(arr.(*array.Binary).Value(i))
+		}
+	case *arrow.FixedSizeBinaryType:
+		return func(arr array.Interface, i int) string {
+			return w.encodeBinary(arr.(*array.FixedSizeBinary).Value(i))
+		}
+	case *arrow.TimestampType:
+		loc := w.loc
+		if loc == nil {
+			loc = time.UTC
+			if dt.TimeZone != "" {
+				var err error
+				loc, err = time.LoadLocation(dt.TimeZone)
+				if err != nil {
+					panic(xerrors.Errorf("arrow/csv: invalid time zone %q: %w", dt.TimeZone, err))
 				}
 			}
 		}
+		return func(arr array.Interface, i int) string {
+			v := int64(arr.(*array.Timestamp).Value(i))
+			return arrtime.ToTime(v, dt.Unit).In(loc).Format(w.tsLayout)
+		}
+	case *arrow.Date32Type:
+		return func(arr array.Interface, i int) string {
+			v := int64(arr.(*array.Date32).Value(i))
+			return time.Unix(v*arrtime.SecondsPerDay, 0).UTC().Format(arrtime.DateLayout)
+		}
+	case *arrow.Date64Type:
+		return func(arr array.Interface, i int) string {
+			v := int64(arr.(*array.Date64).Value(i))
+			return arrtime.ToTime(v, arrow.Millisecond).UTC().Format(arrtime.DateLayout)
+		}
+	case *arrow.Time32Type:
+		return func(arr array.Interface, i int) string {
+			v := time.Duration(arr.(*array.Time32).Value(i))
+			return time.Time{}.Add(v * dt.Unit.Multiplier()).Format(timeFormat)
+		}
+	case *arrow.Time64Type:
+		return func(arr array.Interface, i int) string {
+			v := time.Duration(arr.(*array.Time64).Value(i))
+			return time.Time{}.Add(v * dt.Unit.Multiplier()).Format(timeFormat)
+		}
+	case *arrow.DurationType:
+		return func(arr array.Interface, i int) string {
+			return strconv.FormatInt(int64(arr.(*array.Duration).Value(i)), 10)
+		}
+	case *arrow.MonthIntervalType:
+		return func(arr array.Interface, i int) string {
+			return strconv.FormatInt(int64(arr.(*array.MonthInterval).Value(i)), 10)
+		}
+	case *arrow.DayTimeIntervalType:
+		return func(arr array.Interface, i int) string {
+			v := arr.(*array.DayTimeInterval).Value(i)
+			return fmt.Sprintf(`{"days":%d,"milliseconds":%d}`, v.Days, v.Milliseconds)
+		}
+	case *arrow.ListType, *arrow.FixedSizeListType, *arrow.StructType:
+		enc := jsonenc.New(dt, w.initJSONLeafEncoder)
+		if enc == nil {
+			return nil
+		}
+		return func(arr array.Interface, i int) string {
+			return string(enc(nil, arr, i))
+		}
+	default:
+		return nil
+	}
+}
+
+// initJSONLeafEncoder returns the function encoding valid values of the
+// given data type as JSON, from their CSV formatting, or nil if the data type
+// is not supported.
+func (w *Writer) initJSONLeafEncoder(dt arrow.DataType) jsonenc.Encoder {
+	format := w.initFieldFormatter(dt)
+	if format == nil {
+		return nil
+	}
+	switch dt.(type) {
+	case *arrow.BooleanType,
+		*arrow.Int8Type, *arrow.Int16Type, *arrow.Int32Type, *arrow.Int64Type,
+		*arrow.Uint8Type, *arrow.Uint16Type, *arrow.Uint32Type, *arrow.Uint64Type,
+		*arrow.DurationType, *arrow.MonthIntervalType, *arrow.DayTimeIntervalType:
+		return func(dst []byte, arr array.Interface, i int) []byte {
+			return append(dst, format(arr, i)...)
+		}
+	default:
+		return func(dst []byte, arr array.Interface, i int) []byte {
+			v, _ := json.Marshal(format(arr, i))
+			return append(dst, v...)
+		}
 	}
+}
 
-	return w.w.WriteAll(recs)
+func (w *Writer) encodeBinary(v []byte) string {
+	switch w.binEnc {
+	case Hex:
+		return hex.EncodeToString(v)
+	default:
+		return base64.StdEncoding.EncodeToString(v)
+	}
 }
 
+const timeFormat = "15:04:05.999999999"
+
 // Flush writes any buffered data to the underlying csv Writer.
 // If an error occurred during the Flush, return it
 func (w *Writer) Flush() error {
@@ -21,12 +21,16 @@ import (
 	"fmt"
 	"io/ioutil"
 	"log"
+	"math"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/apache/arrow/go/arrow"
 	"github.com/apache/arrow/go/arrow/array"
 	"github.com/apache/arrow/go/arrow/csv"
+	"github.com/apache/arrow/go/arrow/decimal128"
+	"github.com/apache/arrow/go/arrow/float16"
 	"github.com/apache/arrow/go/arrow/memory"
 )
 
@@ -214,6 +218,171 @@ null;null;null;null;null;null;null;null;null;null;null;null
 	}
 }
 
+func TestCSVWriterTypes(t *testing.T) {
+	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer pool.AssertSize(t, 0)
+
+	schema := arrow.NewSchema(
+		[]arrow.Field{
+			{Name: "ts", Type: &arrow.TimestampType{Unit: arrow.Millisecond}, Nullable: true},
+			{Name: "tz", Type: &arrow.TimestampType{Unit: arrow.Second, TimeZone: "Europe/Paris"}, Nullable: true},
+			{Name: "d32", Type: arrow.FixedWidthTypes.Date32, Nullable: true},
+			{Name: "d64", Type: arrow.FixedWidthTypes.Date64, Nullable: true},
+			{Name: "t32", Type: arrow.FixedWidthTypes.Time32ms, Nullable: true},
+			{Name: "t64", Type: arrow.FixedWidthTypes.Time64ns, Nullable: true},
+			{Name: "dur", Type: arrow.FixedWidthTypes.Duration_s, Nullable: true},
+			{Name: "dec", Type: &arrow.Decimal128Type{Precision: 10, Scale: 2}, Nullable: true},
+			{Name: "f16", Type: arrow.FixedWidthTypes.Float16, Nullable: true},
+			{Name: "bin", Type: arrow.BinaryTypes.Binary, Nullable: true},
+			{Name: "fsb", Type: &arrow.FixedSizeBinaryType{ByteWidth: 2}, Nullable: true},
+			{Name: "month", Type: arrow.FixedWidthTypes.MonthInterval, Nullable: true},
+			{Name: "daytime", Type: arrow.FixedWidthTypes.DayTimeInterval, Nullable: true},
+			{Name: "list", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true},
+			{Name: "struct", Type: arrow.StructOf(
+				arrow.Field{Name: "a", Type: arrow.PrimitiveTypes.Float64, Nullable: true},
+				arrow.Field{Name: "b", Type: arrow.FixedSizeListOf(2, arrow.PrimitiveTypes.Int32), Nullable: true},
+			), Nullable: true},
+		},
+		nil,
+	)
+
+	b := array.NewRecordBuilder(pool, schema)
+	defer b.Release()
+
+	b.Field(0).(*array.TimestampBuilder).Append(1577934245500)
+	b.Field(1).(*array.TimestampBuilder).Append(1577930645)
+	b.Field(2).(*array.Date32Builder).Append(18263)
+	b.Field(3).(*array.Date64Builder).Append(-86400000)
+	b.Field(4).(*array.Time32Builder).Append(3723500)
+	b.Field(5).(*array.Time64Builder).Append(3723000000001)
+	b.Field(6).(*array.DurationBuilder).Append(3600)
+	b.Field(7).(*array.Decimal128Builder).Append(decimal128.FromI64(-12345))
+	b.Field(8).(*array.Float16Builder).Append(float16.New(1.5))
+	b.Field(9).(*array.BinaryBuilder).Append([]byte("hello"))
+	b.Field(10).(*array.FixedSizeBinaryBuilder).Append([]byte("ab"))
+	b.Field(11).(*array.MonthIntervalBuilder).Append(14)
+	b.Field(12).(*array.DayTimeIntervalBuilder).Append(arrow.DayTimeInterval{Days: 1, Milliseconds: 2})
+
+	lb := b.Field(13).(*array.ListBuilder)
+	lb.Append(true)
+	lb.ValueBuilder().(*array.StringBuilder).Append(`a"b`)
+	lb.ValueBuilder().(*array.StringBuilder).AppendNull()
+
+	sb := b.Field(14).(*array.StructBuilder)
+	sb.Append(true)
+	sb.FieldBuilder(0).(*array.Float64Builder).Append(math.Inf(1))
+	fb := sb.FieldBuilder(1).(*array.FixedSizeListBuilder)
+	fb.Append(true)
+	fb.ValueBuilder().(*array.Int32Builder).AppendValues([]int32{1, 2}, nil)
+
+	for _, field := range b.Fields() {
+		field.AppendNull()
+	}
+	// struct children must have the same length as their parent.
+	sb.FieldBuilder(0).AppendNull()
+	fb.AppendNull()
+
+	rec := b.NewRecord()
+	defer rec.Release()
+
+	f := new(bytes.Buffer)
+	w := csv.NewWriter(f, schema,
+		csv.WithComma(';'),
+		csv.WithNullWriter("null"),
+		csv.WithBinaryEncoding(csv.Hex),
+	)
+
+	err := w.Write(rec)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = w.Flush()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `2020-01-02T03:04:05.5Z;2020-01-02T03:04:05+01:00;2020-01-02;1969-12-31;01:02:03.5;01:02:03.000000001;3600;-123.45;1.5;68656c6c6f;6162;14;"{""days"":1,""milliseconds"":2}";"[""a\""b"",null]";"{""a"":""+Inf"",""b"":[1,2]}"
+null;null;null;null;null;null;null;null;null;null;null;null;null;null;null
+`
+
+	if got := f.String(); got != want {
+		t.Fatalf("invalid output:\ngot=%s\nwant=%s\n", got, want)
+	}
+}
+
+func TestCSVWriterOptions(t *testing.T) {
+	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer pool.AssertSize(t, 0)
+
+	schema := arrow.NewSchema(
+		[]arrow.Field{
+			{Name: "ts", Type: &arrow.TimestampType{Unit: arrow.Second, TimeZone: "UTC"}},
+			{Name: "i64", Type: arrow.PrimitiveTypes.Int64},
+			{Name: "null", Type: arrow.Null},
+		},
+		nil,
+	)
+
+	b := array.NewRecordBuilder(pool, schema)
+	defer b.Release()
+
+	b.Field(0).(*array.TimestampBuilder).AppendValues([]arrow.Timestamp{0, 86400}, nil)
+	b.Field(1).(*array.Int64Builder).AppendValues([]int64{1, 2}, nil)
+	b.Field(2).AppendNull()
+	b.Field(2).AppendNull()
+
+	rec := b.NewRecord()
+	defer rec.Release()
+
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	f := new(bytes.Buffer)
+	w := csv.NewWriter(f, schema,
+		csv.WithTimestampLayouts("2006-01-02 15:04 MST"),
+		csv.WithLocation(loc),
+		csv.WithFormatter("i64", func(arr array.Interface, i int) string {
+			return fmt.Sprintf("#%d", arr.(*array.Int64).Value(i))
+		}),
+		csv.WithFormatter("null", func(arr array.Interface, i int) string {
+			return "n/a"
+		}),
+	)
+
+	err = w.Write(rec)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = w.Flush()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `1969-12-31 19:00 EST,#1,n/a
+1970-01-01 19:00 EST,#2,n/a
+`
+
+	if got := f.String(); got != want {
+		t.Fatalf("invalid output:\ngot=%s\nwant=%s\n", got, want)
+	}
+}
+
+func TestCSVWriterInvalidType(t *testing.T) {
+	defer func() {
+		e := recover()
+		if e == nil {
+			t.Fatalf("expected a panic")
+		}
+	}()
+
+	schema := arrow.NewSchema([]arrow.Field{{Name: "null", Type: arrow.Null}}, nil)
+	csv.NewWriter(new(bytes.Buffer), schema)
+}
+
 func BenchmarkWrite(b *testing.B) {
 	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
 	defer pool.AssertSize(b, 0)
@@ -120,6 +120,15 @@ const (
 	// Measure of elapsed time in either seconds, milliseconds, microseconds
 	// or nanoseconds.
 	DURATION
+
+	// LARGE_STRING is a UTF8 variable-length string with 64-bit offsets
+	LARGE_STRING
+
+	// LARGE_BINARY is a Variable-length byte type with 64-bit offsets
+	LARGE_BINARY
+
+	// LARGE_LIST is a list of some logical data type with 64-bit offsets
+	LARGE_LIST
 )
 
 // DataType is the representation of an Arrow type.
@@ -30,12 +30,32 @@ func (t *StringType) Name() string   { return "utf8" }
 func (t *StringType) String() string { return "utf8" }
 func (t *StringType) binary()        {}
 
+// LargeBinaryType is like BinaryType but uses 64-bit offsets.
+type LargeBinaryType struct{}
+
+func (t *LargeBinaryType) ID() Type       { return LARGE_BINARY }
+func (t *LargeBinaryType) Name() string   { return "large_binary" }
+func (t *LargeBinaryType) String() string { return "large_binary" }
+func (t *LargeBinaryType) binary()        {}
+
+// LargeStringType is like StringType but uses 64-bit offsets.
+type LargeStringType struct{}
+
+func (t *LargeStringType) ID() Type       { return LARGE_STRING }
+func (t *LargeStringType) Name() string   { return "large_utf8" }
+func (t *LargeStringType) String() string { return "large_utf8" }
+func (t *LargeStringType) binary()        {}
+
 var (
 	BinaryTypes = struct {
-		Binary BinaryDataType
-		String BinaryDataType
+		Binary      BinaryDataType
+		String      BinaryDataType
+		LargeBinary BinaryDataType
+		LargeString BinaryDataType
 	}{
-		Binary: &BinaryType{},
-		String: &StringType{},
+		Binary:      &BinaryType{},
+		String:      &StringType{},
+		LargeBinary: &LargeBinaryType{},
+		LargeString: &LargeStringType{},
 	}
 )
@@ -51,3 +51,33 @@ func TestStringType(t *testing.T) {
 		t.Fatalf("invalid string type stringer. got=%v, want=%v", got, want)
 	}
 }
+
+func TestLargeBinaryType(t *testing.T) {
+	var nt *arrow.LargeBinaryType
+	if got, want := nt.ID(), arrow.LARGE_BINARY; got != want {
+		t.Fatalf("invalid large binary type id. got=%v, want=%v", got, want)
+	}
+
+	if got, want := nt.Name(), "large_binary"; got != want {
+		t.Fatalf("invalid large binary type name. got=%v, want=%v", got, want)
+	}
+
+	if got, want := nt.String(), "large_binary"; got != want {
+		t.Fatalf("invalid large binary type stringer. got=%v, want=%v", got, want)
+	}
+}
+
+func TestLargeStringType(t *testing.T) {
+	var nt *arrow.LargeStringType
+	if got, want := nt.ID(), arrow.LARGE_STRING; got != want {
+		t.Fatalf("invalid large string type id. got=%v, want=%v", got, want)
+	}
+
+	if got, want := nt.Name(), "large_utf8"; got != want {
+		t.Fatalf("invalid large string type name. got=%v, want=%v", got, want)
+	}
+
+	if got, want := nt.String(), "large_utf8"; got != want {
+		t.Fatalf("invalid large string type stringer. got=%v, want=%v", got, want)
+	}
+}
@@ -19,6 +19,7 @@ package arrow
 import (
 	"fmt"
 	"strconv"
+	"time"
 )
 
 type BooleanType struct{}
@@ -61,6 +62,11 @@ const (
 
 func (u TimeUnit) String() string { return [...]string{"ns", "us", "ms", "s"}[uint(u)&3] }
 
+// Multiplier returns the duration of one tick of the time unit.
+func (u TimeUnit) Multiplier() time.Duration {
+	return [...]time.Duration{time.Nanosecond, time.Microsecond, time.Millisecond, time.Second}[uint(u)&3]
+}
+
 // TimestampType is encoded as a 64-bit signed integer since the UNIX epoch (2017-01-01T00:00:00Z).
 // The zero-value is a nanosecond and time zone neutral. Time zone neutral can be
 // considered UTC without having "UTC" as a time zone.
@@ -18,6 +18,7 @@ package arrow_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/apache/arrow/go/arrow"
 	"github.com/stretchr/testify/assert"
@@ -41,6 +42,24 @@ func TestTimeUnit_String(t *testing.T) {
 	}
 }
 
+// TestTimeUnit_Multiplier verifies each time unit matches its duration.
+func TestTimeUnit_Multiplier(t *testing.T) {
+	tests := []struct {
+		u   arrow.TimeUnit
+		exp time.Duration
+	}{
+		{arrow.Nanosecond, time.Nanosecond},
+		{arrow.Microsecond, time.Microsecond},
+		{arrow.Millisecond, time.Millisecond},
+		{arrow.Second, time.Second},
+	}
+	for _, test := range tests {
+		t.Run(test.u.String(), func(t *testing.T) {
+			assert.Equal(t, test.exp, test.u.Multiplier())
+		})
+	}
+}
+
 func TestDecimal128Type(t *testing.T) {
 	for _, tc := range []struct {
 		precision int32
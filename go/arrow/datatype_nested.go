@@ -46,6 +46,28 @@ func (t *ListType) String() string { return fmt.Sprintf("list<item: %v>", t.elem
 // Elem returns the ListType's element type.
 func (t *ListType) Elem() DataType { return t.elem }
 
+// LargeListType is like ListType but uses 64-bit offsets.
+type LargeListType struct {
+	elem DataType // DataType of the list's elements
+}
+
+// LargeListOf returns the large list type with element type t.
+//
+// LargeListOf panics if t is nil or invalid.
+func LargeListOf(t DataType) *LargeListType {
+	if t == nil {
+		panic("arrow: nil DataType")
+	}
+	return &LargeListType{elem: t}
+}
+
+func (*LargeListType) ID() Type         { return LARGE_LIST }
+func (*LargeListType) Name() string     { return "large_list" }
+func (t *LargeListType) String() string { return fmt.Sprintf("large_list<item: %v>", t.elem) }
+
+// Elem returns the LargeListType's element type.
+func (t *LargeListType) Elem() DataType { return t.elem }
+
 // FixedSizeListType describes a nested type in which each array slot contains
 // a fixed-size sequence of values, all having the same relative type.
 type FixedSizeListType struct {
@@ -17,6 +17,7 @@
 package arrow
 
 import (
+	"fmt"
 	"reflect"
 	"testing"
 )
@@ -78,6 +79,53 @@ func TestListOf(t *testing.T) {
 	}
 }
 
+func TestLargeListOf(t *testing.T) {
+	for _, tc := range []DataType{
+		FixedWidthTypes.Boolean,
+		PrimitiveTypes.Int32,
+		PrimitiveTypes.Float64,
+		BinaryTypes.LargeString,
+		ListOf(PrimitiveTypes.Int32),
+		LargeListOf(PrimitiveTypes.Int32),
+		StructOf(),
+	} {
+		t.Run(tc.Name(), func(t *testing.T) {
+			got := LargeListOf(tc)
+			want := &LargeListType{elem: tc}
+			if !reflect.DeepEqual(got, want) {
+				t.Fatalf("got=%#v, want=%#v", got, want)
+			}
+
+			if got, want := got.Name(), "large_list"; got != want {
+				t.Fatalf("got=%q, want=%q", got, want)
+			}
+
+			if got, want := got.ID(), LARGE_LIST; got != want {
+				t.Fatalf("got=%v, want=%v", got, want)
+			}
+
+			if got, want := got.Elem(), tc; got != want {
+				t.Fatalf("got=%v, want=%v", got, want)
+			}
+
+			if got, want := got.String(), fmt.Sprintf("large_list<item: %v>", tc); got != want {
+				t.Fatalf("got=%q, want=%q", got, want)
+			}
+		})
+	}
+
+	t.Run("invalid", func(t *testing.T) {
+		defer func() {
+			e := recover()
+			if e == nil {
+				t.Fatalf("test should have panicked but did not")
+			}
+		}()
+
+		_ = LargeListOf(nil)
+	})
+}
+
 func TestStructOf(t *testing.T) {
 	for _, tc := range []struct {
 		fields []Field
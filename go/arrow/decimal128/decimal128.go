@@ -16,6 +16,13 @@
 
 package decimal128 // import "github.com/apache/arrow/go/arrow/decimal128"
 
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
 var (
 	MaxDecimal128 = New(542101086242752217, 687399551400673280-1)
 )
@@ -71,3 +78,130 @@ func (n Num) Sign() int {
 	}
 	return int(1 | (n.hi >> 63))
 }
+
+var (
+	two128 = new(big.Int).Lsh(big.NewInt(1), 128)
+	mask64 = new(big.Int).SetUint64(^uint64(0))
+)
+
+// FromBigInt returns a new signed 128-bit integer value from the provided *big.Int.
+// Only the 128 lower bits of the two's complement representation of v are kept.
+func FromBigInt(v *big.Int) Num {
+	u := new(big.Int).Set(v)
+	if u.Sign() < 0 {
+		u.Add(u, two128)
+	}
+	lo := new(big.Int).And(u, mask64).Uint64()
+	hi := new(big.Int).And(u.Rsh(u, 64), mask64).Uint64()
+	return New(int64(hi), lo)
+}
+
+// BigInt returns the value of the number as a *big.Int.
+func (n Num) BigInt() *big.Int {
+	v := new(big.Int).SetInt64(n.hi)
+	v.Lsh(v, 64)
+	return v.Add(v, new(big.Int).SetUint64(n.lo))
+}
+
+// FromString parses the decimal number in v, such as "-123.45" or "1.5e3",
+// and returns it as a 128-bit integer scaled by 10^scale.
+//
+// FromString returns an error if v is not a valid decimal number, if it has
+// more significant fractional digits than scale or if its scaled value has
+// more than prec digits.
+func FromString(v string, prec, scale int32) (Num, error) {
+	s := v
+	neg := false
+	switch {
+	case strings.HasPrefix(s, "-"):
+		neg = true
+		s = s[1:]
+	case strings.HasPrefix(s, "+"):
+		s = s[1:]
+	}
+
+	exp := 0
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		e, err := strconv.Atoi(s[i+1:])
+		if err != nil {
+			return Num{}, fmt.Errorf("arrow/decimal128: invalid exponent in %q", v)
+		}
+		exp = e
+		s = s[:i]
+	}
+
+	ipart, fpart := s, ""
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		ipart, fpart = s[:i], s[i+1:]
+	}
+	if ipart == "" && fpart == "" {
+		return Num{}, fmt.Errorf("arrow/decimal128: invalid decimal number %q", v)
+	}
+
+	digits := ipart + fpart
+	for _, c := range digits {
+		if c < '0' || '9' < c {
+			return Num{}, fmt.Errorf("arrow/decimal128: invalid decimal number %q", v)
+		}
+	}
+	digits = strings.TrimLeft(digits, "0")
+
+	// number of decimal places the digits need to be shifted by.
+	shift := int64(scale) - int64(len(fpart)) + int64(exp)
+	switch {
+	case shift < 0:
+		n := int64(len(digits)) + shift
+		if n < 0 {
+			n = 0
+		}
+		if strings.Trim(digits[n:], "0") != "" {
+			return Num{}, fmt.Errorf("arrow/decimal128: value %q has more fractional digits than scale %d", v, scale)
+		}
+		digits = digits[:n]
+	case shift > 0 && digits != "":
+		if int64(len(digits))+shift > int64(prec) {
+			return Num{}, fmt.Errorf("arrow/decimal128: value %q does not fit in precision %d", v, prec)
+		}
+		digits += strings.Repeat("0", int(shift))
+	}
+
+	if len(digits) > int(prec) {
+		return Num{}, fmt.Errorf("arrow/decimal128: value %q does not fit in precision %d", v, prec)
+	}
+	if digits == "" {
+		return Num{}, nil
+	}
+
+	bi, _ := new(big.Int).SetString(digits, 10)
+	if neg {
+		bi.Neg(bi)
+	}
+	return FromBigInt(bi), nil
+}
+
+// ToString returns the decimal representation of the number, interpreted
+// as a value scaled by 10^scale.
+func (n Num) ToString(scale int32) string {
+	s := n.BigInt().String()
+	sign := ""
+	if strings.HasPrefix(s, "-") {
+		sign = "-"
+		s = s[1:]
+	}
+
+	switch {
+	case scale < 0:
+		if s != "0" {
+			s += strings.Repeat("0", int(-scale))
+		}
+		return sign + s
+	case scale == 0:
+		return sign + s
+	}
+
+	if len(s) <= int(scale) {
+		s = strings.Repeat("0", int(scale)-len(s)+1) + s
+	}
+	i := len(s) - int(scale)
+	return sign + s[:i] + "." + s[i:]
+}
@@ -92,3 +92,96 @@ func TestFromI64(t *testing.T) {
 }
 
 func u64Cnv(i int64) uint64 { return uint64(i) }
+
+func TestBigInt(t *testing.T) {
+	for _, v := range []string{
+		"0", "1", "-1", "42", "-42",
+		"18446744073709551615", "18446744073709551616", "-18446744073709551616",
+		"99999999999999999999999999999999999999",
+		"-99999999999999999999999999999999999999",
+	} {
+		t.Run(v, func(t *testing.T) {
+			ref, _ := new(big.Int).SetString(v, 10)
+			n := FromBigInt(ref)
+			if got, want := n.BigInt(), ref; got.Cmp(want) != 0 {
+				t.Fatalf("invalid round-trip: got=%v, want=%v", got, want)
+			}
+			if got, want := n.Sign(), ref.Sign(); got != want {
+				t.Fatalf("invalid sign: got=%d, want=%d", got, want)
+			}
+		})
+	}
+
+	if got, want := FromBigInt(big.NewInt(-2)), FromI64(-2); got != want {
+		t.Fatalf("invalid value: got=%+0#x, want=%+0#x", got, want)
+	}
+}
+
+func TestFromString(t *testing.T) {
+	for _, tc := range []struct {
+		v     string
+		prec  int32
+		scale int32
+		want  string
+		err   bool
+	}{
+		{v: "0", prec: 5, scale: 2, want: "0"},
+		{v: "123.45", prec: 5, scale: 2, want: "12345"},
+		{v: "-123.45", prec: 5, scale: 2, want: "-12345"},
+		{v: "+1.5", prec: 5, scale: 2, want: "150"},
+		{v: "1.50", prec: 5, scale: 1, want: "15"},
+		{v: ".5", prec: 5, scale: 1, want: "5"},
+		{v: "7.", prec: 5, scale: 0, want: "7"},
+		{v: "1.5e3", prec: 6, scale: 2, want: "150000"},
+		{v: "15E-1", prec: 5, scale: 1, want: "15"},
+		{v: "0001.00", prec: 3, scale: 2, want: "100"},
+		{v: "123.456", prec: 10, scale: 2, err: true},
+		{v: "123456", prec: 5, scale: 0, err: true},
+		{v: "1234.5", prec: 5, scale: 2, err: true},
+		{v: "1e40", prec: 38, scale: 0, err: true},
+		{v: "", prec: 5, scale: 2, err: true},
+		{v: ".", prec: 5, scale: 2, err: true},
+		{v: "1.2.3", prec: 5, scale: 2, err: true},
+		{v: "abc", prec: 5, scale: 2, err: true},
+		{v: "1e", prec: 5, scale: 2, err: true},
+	} {
+		t.Run(tc.v, func(t *testing.T) {
+			n, err := FromString(tc.v, tc.prec, tc.scale)
+			switch {
+			case tc.err:
+				if err == nil {
+					t.Fatalf("expected an error, got=%v", n.BigInt())
+				}
+				return
+			case err != nil:
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got, want := n.BigInt().String(), tc.want; got != want {
+				t.Fatalf("invalid value: got=%s, want=%s", got, want)
+			}
+		})
+	}
+}
+
+func TestToString(t *testing.T) {
+	for _, tc := range []struct {
+		v     int64
+		scale int32
+		want  string
+	}{
+		{0, 0, "0"},
+		{0, 2, "0.00"},
+		{12345, 2, "123.45"},
+		{-12345, 2, "-123.45"},
+		{5, 3, "0.005"},
+		{-5, 3, "-0.005"},
+		{42, 0, "42"},
+		{42, -2, "4200"},
+	} {
+		t.Run(tc.want, func(t *testing.T) {
+			if got, want := FromI64(tc.v).ToString(tc.scale), tc.want; got != want {
+				t.Fatalf("invalid string: got=%q, want=%q", got, want)
+			}
+		})
+	}
+}
@@ -45,6 +45,7 @@ func init() {
 	Records["intervals"] = makeIntervalsRecords()
 	Records["durations"] = makeDurationsRecords()
 	Records["decimal128"] = makeDecimal128sRecords()
+	Records["large"] = makeLargeRecords()
 
 	for k := range Records {
 		RecordNames = append(RecordNames, k)
@@ -402,6 +403,52 @@ func makeStringsRecords() []array.Record {
 	return recs
 }
 
+func makeLargeRecords() []array.Record {
+	mem := memory.NewGoAllocator()
+	schema := arrow.NewSchema([]arrow.Field{
+		{Name: "large_strings", Type: arrow.BinaryTypes.LargeString, Nullable: true},
+		{Name: "large_bytes", Type: arrow.BinaryTypes.LargeBinary, Nullable: true},
+		{Name: "large_list", Type: arrow.LargeListOf(arrow.PrimitiveTypes.Int32), Nullable: true},
+	}, nil)
+
+	mask := []bool{true, false, true}
+	chunks := [][]array.Interface{
+		[]array.Interface{
+			arrayOf(mem, []largeString{"1é", "2", "3"}, mask),
+			arrayOf(mem, []largeBinary{[]byte("1é"), []byte("2"), []byte("3")}, mask),
+			largeListOf(mem, []array.Interface{
+				arrayOf(mem, []int32{1, 2, 3}, mask),
+				arrayOf(mem, []int32{11, 12}, nil),
+				arrayOf(mem, []int32{}, nil),
+			}, mask),
+		},
+		[]array.Interface{
+			arrayOf(mem, []largeString{"11", "", "33"}, nil),
+			arrayOf(mem, []largeBinary{[]byte("11"), []byte(""), []byte("33")}, nil),
+			largeListOf(mem, []array.Interface{
+				arrayOf(mem, []int32{-1, -2, -3}, nil),
+				arrayOf(mem, []int32{-11, -12}, []bool{false, true}),
+				arrayOf(mem, []int32{-21}, nil),
+			}, nil),
+		},
+	}
+
+	defer func() {
+		for _, chunk := range chunks {
+			for _, col := range chunk {
+				col.Release()
+			}
+		}
+	}()
+
+	recs := make([]array.Record, len(chunks))
+	for i, chunk := range chunks {
+		recs[i] = array.NewRecord(schema, chunk, -1)
+	}
+
+	return recs
+}
+
 type (
 	nullT        struct{}
 	time32s      arrow.Time32
@@ -412,6 +459,8 @@ type (
 	timestamp_ms arrow.Timestamp
 	timestamp_us arrow.Timestamp
 	timestamp_ns arrow.Timestamp
+	largeString  string
+	largeBinary  []byte
 )
 
 var (
@@ -805,6 +854,26 @@ func arrayOf(mem memory.Allocator, a interface{}, valids []bool) array.Interface
 		bldr.AppendValues(a, valids)
 		return bldr.NewBinaryArray()
 
+	case []largeString:
+		bldr := array.NewLargeStringBuilder(mem)
+		defer bldr.Release()
+		vs := make([]string, len(a))
+		for i, v := range a {
+			vs[i] = string(v)
+		}
+		bldr.AppendValues(vs, valids)
+		return bldr.NewLargeStringArray()
+
+	case []largeBinary:
+		bldr := array.NewLargeBinaryBuilder(mem, arrow.BinaryTypes.LargeBinary)
+		defer bldr.Release()
+		vs := make([][]byte, len(a))
+		for i, v := range a {
+			vs[i] = []byte(v)
+		}
+		bldr.AppendValues(vs, valids)
+		return bldr.NewLargeBinaryArray()
+
 	case []time32s:
 		bldr := array.NewTime32Builder(mem, arrow.FixedWidthTypes.Time32s.(*arrow.Time32Type))
 		defer bldr.Release()
@@ -1000,6 +1069,30 @@ func listOf(mem memory.Allocator, values []array.Interface, valids []bool) *arra
 	return bldr.NewListArray()
 }
 
+func largeListOf(mem memory.Allocator, values []array.Interface, valids []bool) *array.LargeList {
+	if mem == nil {
+		mem = memory.NewGoAllocator()
+	}
+
+	bldr := array.NewLargeListBuilder(mem, values[0].DataType())
+	defer bldr.Release()
+
+	valid := func(i int) bool {
+		return valids[i]
+	}
+
+	if valids == nil {
+		valid = func(i int) bool { return true }
+	}
+
+	for i, value := range values {
+		bldr.Append(valid(i))
+		buildArray(bldr.ValueBuilder(), value)
+	}
+
+	return bldr.NewLargeListArray()
+}
+
 func fixedSizeListOf(mem memory.Allocator, n int32, values []array.Interface, valids []bool) *array.FixedSizeList {
 	if mem == nil {
 		mem = memory.NewGoAllocator()
@@ -0,0 +1,81 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package arrtime provides the conversions between Go times and Arrow
+// temporal values shared by the packages reading and writing text formats.
+package arrtime // import "github.com/apache/arrow/go/arrow/internal/arrtime"
+
+import (
+	"time"
+
+	"github.com/apache/arrow/go/arrow"
+)
+
+const (
+	// DateLayout is the layout of dates, as understood by time.Parse.
+	DateLayout = "2006-01-02"
+
+	// SecondsPerDay is the number of seconds in a day.
+	SecondsPerDay = 24 * 60 * 60
+)
+
+// timeLayouts is the list of layouts tried in turn when parsing times of day.
+var timeLayouts = []string{"15:04:05", "15:04"}
+
+// TimestampLayouts is the list of layouts tried in turn when parsing
+// timestamps.
+// Callers exposing these layouts must copy the slice.
+var TimestampLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02 15:04:05.999999999",
+	DateLayout,
+}
+
+// FromTime converts t to a number of time units since the UNIX epoch.
+func FromTime(t time.Time, unit arrow.TimeUnit) int64 {
+	d := int64(unit.Multiplier())
+	return t.Unix()*(int64(time.Second)/d) + int64(t.Nanosecond())/d
+}
+
+// ToTime converts a number of time units since the UNIX epoch to a time.
+func ToTime(v int64, unit arrow.TimeUnit) time.Time {
+	d := int64(unit.Multiplier())
+	n := int64(time.Second) / d
+	return time.Unix(v/n, (v%n)*d)
+}
+
+// ParseTimeOfDay parses a "15:04:05" or "15:04" time of day to a number of
+// time units since midnight.
+func ParseTimeOfDay(str string, unit arrow.TimeUnit) (int64, error) {
+	var (
+		t   time.Time
+		err error
+	)
+	for _, layout := range timeLayouts {
+		t, err = time.Parse(layout, str)
+		if err == nil {
+			break
+		}
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	midnight := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	return int64(t.Sub(midnight) / unit.Multiplier()), nil
+}
@@ -0,0 +1,142 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package jsonenc provides the JSON encoding of nested and floating-point
+// Arrow values shared by the packages writing text formats.
+package jsonenc // import "github.com/apache/arrow/go/arrow/internal/jsonenc"
+
+import (
+	"encoding/json"
+	"math"
+	"strconv"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+)
+
+// Encoder appends the JSON encoding of the i-th value of an array to dst.
+type Encoder func(dst []byte, arr array.Interface, i int) []byte
+
+// LeafFunc returns the Encoder of the valid values of a data type that is
+// neither nested nor floating-point, or nil if the data type is not supported.
+type LeafFunc func(dt arrow.DataType) Encoder
+
+// New returns the Encoder of values of the given data type, or nil if leaf
+// does not support one of the data types it holds.
+//
+// Nulls are encoded as null, structs as objects, and lists and fixed-size
+// lists as arrays. Floating-point NaN and infinite values, which are not
+// valid JSON numbers, are encoded as the "NaN", "+Inf" and "-Inf" strings.
+// Values of other data types are encoded by the Encoder returned by leaf.
+func New(dt arrow.DataType, leaf LeafFunc) Encoder {
+	var enc Encoder
+	switch dt := dt.(type) {
+	case *arrow.Float16Type:
+		enc = func(dst []byte, arr array.Interface, i int) []byte {
+			return appendFloat(dst, float64(arr.(*array.Float16).Value(i).Float32()), 32)
+		}
+	case *arrow.Float32Type:
+		enc = func(dst []byte, arr array.Interface, i int) []byte {
+			return appendFloat(dst, float64(arr.(*array.Float32).Value(i)), 32)
+		}
+	case *arrow.Float64Type:
+		enc = func(dst []byte, arr array.Interface, i int) []byte {
+			return appendFloat(dst, arr.(*array.Float64).Value(i), 64)
+		}
+	case *arrow.ListType:
+		elem := New(dt.Elem(), leaf)
+		if elem == nil {
+			return nil
+		}
+		enc = func(dst []byte, arr array.Interface, i int) []byte {
+			list := arr.(*array.List)
+			j := i + list.Data().Offset()
+			beg, end := int(list.Offsets()[j]), int(list.Offsets()[j+1])
+			return appendList(dst, list.ListValues(), beg, end, elem)
+		}
+	case *arrow.FixedSizeListType:
+		elem := New(dt.Elem(), leaf)
+		if elem == nil {
+			return nil
+		}
+		n := int(dt.Len())
+		enc = func(dst []byte, arr array.Interface, i int) []byte {
+			list := arr.(*array.FixedSizeList)
+			beg := (i + list.Data().Offset()) * n
+			return appendList(dst, list.ListValues(), beg, beg+n, elem)
+		}
+	case *arrow.StructType:
+		fields := make([]Encoder, len(dt.Fields()))
+		names := make([][]byte, len(dt.Fields()))
+		for k, f := range dt.Fields() {
+			fields[k] = New(f.Type, leaf)
+			if fields[k] == nil {
+				return nil
+			}
+			names[k], _ = json.Marshal(f.Name)
+		}
+		enc = func(dst []byte, arr array.Interface, i int) []byte {
+			st := arr.(*array.Struct)
+			dst = append(dst, '{')
+			for k, field := range fields {
+				if k > 0 {
+					dst = append(dst, ',')
+				}
+				dst = append(dst, names[k]...)
+				dst = append(dst, ':')
+				dst = field(dst, st.Field(k), i)
+			}
+			return append(dst, '}')
+		}
+	default:
+		enc = leaf(dt)
+		if enc == nil {
+			return nil
+		}
+	}
+
+	return func(dst []byte, arr array.Interface, i int) []byte {
+		if arr.IsNull(i) {
+			return append(dst, "null"...)
+		}
+		return enc(dst, arr, i)
+	}
+}
+
+func appendList(dst []byte, values array.Interface, beg, end int, elem Encoder) []byte {
+	dst = append(dst, '[')
+	for j := beg; j < end; j++ {
+		if j > beg {
+			dst = append(dst, ',')
+		}
+		dst = elem(dst, values, j)
+	}
+	return append(dst, ']')
+}
+
+// appendFloat appends the JSON encoding of v, holding a floating-point value
+// of the given bit size, to dst.
+func appendFloat(dst []byte, v float64, bits int) []byte {
+	switch {
+	case math.IsNaN(v):
+		return append(dst, `"NaN"`...)
+	case math.IsInf(v, 1):
+		return append(dst, `"+Inf"`...)
+	case math.IsInf(v, -1):
+		return append(dst, `"-Inf"`...)
+	}
+	return strconv.AppendFloat(dst, v, 'g', -1, bits)
+}
@@ -16,6 +16,12 @@
 
 // Command arrow-cat displays the content of an Arrow stream or file.
 //
+// Records are displayed in a debug format by default, or as an aligned table,
+// as CSV or as NDJSON with -format. Columns may be selected with -columns and
+// rows with -offset, -head and -tail, which apply to the rows of all the
+// records of an input, in that order. A single record of an Arrow file may be
+// displayed with -record, and the schema of an input alone with -schema.
+//
 // Examples:
 //
 //  $> arrow-cat ./testdata/primitives.data
@@ -52,6 +58,15 @@
 //  record 2...
 //    col[0] "bools": [true (null) (null) false true]
 //  [...]
+//
+//  $> arrow-cat -format=table -columns=int8s,float64s -head=3 ./testdata/primitives.data
+//  int8s   float64s
+//  -1      1
+//  (null)  (null)
+//  (null)  (null)
+//
+//  $> arrow-cat -format=csv -record=1 ./testdata/primitives.data
+//  $> arrow-cat -schema ./testdata/primitives.data
 package main // import "github.com/apache/arrow/go/arrow/ipc/cmd/arrow-cat"
 
 import (
@@ -61,9 +76,16 @@ import (
 	"io"
 	"log"
 	"os"
+	"strings"
+	"text/tabwriter"
 
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/csv"
 	"github.com/apache/arrow/go/arrow/ipc"
+	"github.com/apache/arrow/go/arrow/json"
 	"github.com/apache/arrow/go/arrow/memory"
+	"github.com/apache/arrow/go/arrow/scalar"
 	"golang.org/x/xerrors"
 )
 
@@ -71,21 +93,60 @@ func main() {
 	log.SetPrefix("arrow-cat: ")
 	log.SetFlags(0)
 
+	var (
+		cfg     = newConfig()
+		columns string
+	)
+	flag.StringVar(&cfg.format, "format", cfg.format, "output format (debug, table, csv, json)")
+	flag.StringVar(&columns, "columns", "", "comma-separated list of the columns to display")
+	flag.Int64Var(&cfg.offset, "offset", cfg.offset, "number of rows to skip")
+	flag.Int64Var(&cfg.head, "head", cfg.head, "maximum number of rows to display (-1: all rows)")
+	flag.Int64Var(&cfg.tail, "tail", cfg.tail, "number of last rows to display (-1: all rows)")
+	flag.IntVar(&cfg.record, "record", cfg.record, "index of the only record of an Arrow file to display (-1: all records)")
+	flag.BoolVar(&cfg.schema, "schema", cfg.schema, "display the schema only")
+
 	flag.Parse()
 
+	if columns != "" {
+		cfg.columns = strings.Split(columns, ",")
+	}
+
 	var err error
 	switch flag.NArg() {
 	case 0:
-		err = processStream(os.Stdout, os.Stdin)
+		err = processStream(os.Stdout, os.Stdin, cfg)
 	default:
-		err = processFiles(os.Stdout, flag.Args())
+		err = processFiles(os.Stdout, flag.Args(), cfg)
 	}
 	if err != nil {
 		log.Fatal(err)
 	}
 }
 
-func processStream(w io.Writer, rin io.Reader) error {
+type config struct {
+	format  string   // output format (debug, table, csv, json)
+	columns []string // columns to display, all columns if empty
+	offset  int64    // number of rows to skip
+	head    int64    // maximum number of rows to display, or -1
+	tail    int64    // number of last rows to display, or -1
+	record  int      // index of the only record to display, or -1
+	schema  bool     // display the schema only
+}
+
+func newConfig() config {
+	return config{
+		format: "debug",
+		head:   -1,
+		tail:   -1,
+		record: -1,
+	}
+}
+
+func processStream(w io.Writer, rin io.Reader, cfg config) error {
+	if cfg.record >= 0 {
+		return xerrors.Errorf("-record requires an Arrow file")
+	}
+
 	mem := memory.NewGoAllocator()
 	for {
 		r, err := ipc.NewReader(rin, ipc.WithAllocator(mem))
@@ -97,22 +158,35 @@ func processStream(w io.Writer, rin io.Reader) error {
 		}
 
 		n := 0
-		for r.Next() {
+		next := func() (int, array.Record, error) {
+			if !r.Next() {
+				if err := r.Err(); err != nil {
+					return 0, nil, err
+				}
+				return 0, nil, io.EOF
+			}
 			n++
-			fmt.Fprintf(w, "record %d...\n", n)
-			rec := r.Record()
-			for i, col := range rec.Columns() {
-				fmt.Fprintf(w, "  col[%d] %q: %v\n", i, rec.ColumnName(i), col)
+			return n - 1, r.Record(), nil
+		}
+
+		err = display(w, r.Schema(), 0, next, cfg)
+		if err == nil {
+			// skip the records left over by -head or -schema, to reach
+			// the next stream.
+			for r.Next() {
 			}
+			err = r.Err()
 		}
 		r.Release()
+		if err != nil {
+			return err
+		}
 	}
-	return nil
 }
 
-func processFiles(w io.Writer, names []string) error {
+func processFiles(w io.Writer, names []string, cfg config) error {
 	for _, name := range names {
-		err := processFile(w, name)
+		err := processFile(w, name, cfg)
 		if err != nil {
 			return err
 		}
@@ -120,7 +194,7 @@ func processFiles(w io.Writer, names []string) error {
 	return nil
 }
 
-func processFile(w io.Writer, fname string) error {
+func processFile(w io.Writer, fname string, cfg config) error {
 
 	f, err := os.Open(fname)
 	if err != nil {
@@ -137,7 +211,7 @@ func processFile(w io.Writer, fname string) error {
 
 	if !bytes.Equal(hdr, ipc.Magic) {
 		// try as a stream.
-		return processStream(w, f)
+		return processStream(w, f, cfg)
 	}
 
 	mem := memory.NewGoAllocator()
@@ -151,29 +225,299 @@ func processFile(w io.Writer, fname string) error {
 	}
 	defer r.Close()
 
-	fmt.Fprintf(w, "version: %v\n", r.Version())
-	for i := 0; i < r.NumRecords(); i++ {
-		fmt.Fprintf(w, "record %d/%d...\n", i+1, r.NumRecords())
+	var (
+		i   = 0
+		end = r.NumRecords()
+	)
+	if cfg.record >= 0 {
+		if cfg.record >= r.NumRecords() {
+			return xerrors.Errorf("record index %d out of bounds [0, %d)", cfg.record, r.NumRecords())
+		}
+		i, end = cfg.record, cfg.record+1
+	}
+	next := func() (int, array.Record, error) {
+		if i >= end {
+			return 0, nil, io.EOF
+		}
 		rec, err := r.Record(i)
 		if err != nil {
+			return 0, nil, err
+		}
+		i++
+		return i - 1, rec, nil
+	}
+
+	if cfg.format == "debug" && !cfg.schema {
+		fmt.Fprintf(w, "version: %v\n", r.Version())
+	}
+	return display(w, r.Schema(), r.NumRecords(), next, cfg)
+}
+
+// display writes the records yielded by next, until io.EOF, in the format
+// selected by cfg. next returns the records with their index in the input,
+// which holds total records, or an unknown number of records if total is 0.
+func display(w io.Writer, schema *arrow.Schema, total int, next func() (int, array.Record, error), cfg config) error {
+	sel, err := newSelector(schema, cfg.columns)
+	if err != nil {
+		return err
+	}
+
+	if cfg.schema {
+		_, err = fmt.Fprintln(w, sel.schema)
+		return err
+	}
+
+	p, err := newPrinter(w, sel.schema, total, cfg.format)
+	if err != nil {
+		return err
+	}
+
+	var (
+		skip = cfg.offset
+		head = cfg.head
+		tail []indexedRecord // last records, retained for -tail
+		rows int64           // number of rows in tail
+	)
+	defer func() {
+		for _, t := range tail {
+			t.rec.Release()
+		}
+	}()
+
+	for head != 0 {
+		i, rec, err := next()
+		if err != nil {
+			if xerrors.Is(err, io.EOF) {
+				break
+			}
 			return err
 		}
 
-		for i, col := range rec.Columns() {
-			fmt.Fprintf(w, "  col[%d] %q: %v\n", i, rec.ColumnName(i), col)
+		rec = sel.project(rec)
+		n := rec.NumRows()
+		switch {
+		case skip > 0 && skip >= n:
+			skip -= n
+			rec.Release()
+			continue
+		case skip > 0:
+			rec = slice(rec, skip, n)
+			n -= skip
+			skip = 0
+		}
+		if head > 0 && n > head {
+			rec = slice(rec, 0, head)
+			n = head
+		}
+		if head > 0 {
+			head -= n
+		}
+
+		if cfg.tail < 0 {
+			err = p.print(i, rec)
+			rec.Release()
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		tail = append(tail, indexedRecord{i, rec})
+		rows += n
+		for len(tail) > 0 && rows-tail[0].rec.NumRows() >= cfg.tail {
+			rows -= tail[0].rec.NumRows()
+			tail[0].rec.Release()
+			tail = tail[1:]
+		}
+	}
+
+	if len(tail) > 0 && rows > cfg.tail {
+		tail[0].rec = slice(tail[0].rec, rows-cfg.tail, tail[0].rec.NumRows())
+	}
+	for _, t := range tail {
+		err = p.print(t.i, t.rec)
+		if err != nil {
+			return err
+		}
+	}
+
+	return p.close()
+}
+
+type indexedRecord struct {
+	i   int
+	rec array.Record
+}
+
+// slice returns the rows [i, j) of rec and releases rec.
+func slice(rec array.Record, i, j int64) array.Record {
+	defer rec.Release()
+	return rec.NewSlice(i, j)
+}
+
+// selector selects columns from records.
+type selector struct {
+	schema *arrow.Schema
+	cols   []int // indices of the selected columns, or nil for all columns
+}
+
+func newSelector(schema *arrow.Schema, names []string) (*selector, error) {
+	if len(names) == 0 {
+		return &selector{schema: schema}, nil
+	}
+
+	var (
+		cols   = make([]int, len(names))
+		fields = make([]arrow.Field, len(names))
+	)
+	for i, name := range names {
+		idx := schema.FieldIndices(name)
+		if len(idx) == 0 {
+			return nil, xerrors.Errorf("unknown column %q", name)
 		}
-		rec.Release()
+		cols[i] = idx[0]
+		fields[i] = schema.Field(idx[0])
+	}
+
+	var meta *arrow.Metadata
+	if md := schema.Metadata(); md.Len() > 0 {
+		meta = &md
+	}
+	return &selector{schema: arrow.NewSchema(fields, meta), cols: cols}, nil
+}
+
+// project returns a new record holding the selected columns of rec.
+func (s *selector) project(rec array.Record) array.Record {
+	if s.cols == nil {
+		rec.Retain()
+		return rec
+	}
+
+	cols := make([]array.Interface, len(s.cols))
+	for i, j := range s.cols {
+		cols[i] = rec.Column(j)
 	}
+	return array.NewRecord(s.schema, cols, rec.NumRows())
+}
+
+// printer writes records in an output format.
+type printer interface {
+	// print writes rec, the i-th record of the input.
+	print(i int, rec array.Record) error
+	// close flushes the output.
+	close() error
+}
+
+func newPrinter(w io.Writer, schema *arrow.Schema, total int, format string) (printer, error) {
+	switch format {
+	case "debug":
+		return &debugPrinter{w: w, total: total}, nil
+	case "table":
+		return newTablePrinter(w, schema), nil
+	case "csv":
+		return &csvPrinter{w: csv.NewWriter(w, schema, csv.WithHeader(true))}, nil
+	case "json":
+		return &jsonPrinter{w: json.NewWriter(w, schema)}, nil
+	default:
+		return nil, xerrors.Errorf("unknown output format %q", format)
+	}
+}
+
+type debugPrinter struct {
+	w     io.Writer
+	total int
+}
 
+func (p *debugPrinter) print(i int, rec array.Record) error {
+	var err error
+	switch p.total {
+	case 0:
+		_, err = fmt.Fprintf(p.w, "record %d...\n", i+1)
+	default:
+		_, err = fmt.Fprintf(p.w, "record %d/%d...\n", i+1, p.total)
+	}
+	if err != nil {
+		return err
+	}
+	for i, col := range rec.Columns() {
+		_, err = fmt.Fprintf(p.w, "  col[%d] %q: %v\n", i, rec.ColumnName(i), col)
+		if err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
+func (p *debugPrinter) close() error { return nil }
+
+// tablePrinter writes records as a table, with a header line and one line
+// per row, whose columns are aligned over all the records.
+type tablePrinter struct {
+	w     *tabwriter.Writer
+	cells []string
+}
+
+func newTablePrinter(w io.Writer, schema *arrow.Schema) *tablePrinter {
+	p := &tablePrinter{
+		w:     tabwriter.NewWriter(w, 0, 8, 2, ' ', 0),
+		cells: make([]string, len(schema.Fields())),
+	}
+	for i, f := range schema.Fields() {
+		p.cells[i] = f.Name
+	}
+	p.line()
+	return p
+}
+
+func (p *tablePrinter) print(_ int, rec array.Record) error {
+	for row := 0; row < int(rec.NumRows()); row++ {
+		for i, col := range rec.Columns() {
+			s, err := scalar.GetScalar(col, row)
+			if err != nil {
+				return xerrors.Errorf("could not display column %q: %w", rec.ColumnName(i), err)
+			}
+			switch {
+			case s.IsValid():
+				p.cells[i] = s.String()
+			default:
+				p.cells[i] = "(null)"
+			}
+		}
+		p.line()
+	}
+	return nil
+}
+
+func (p *tablePrinter) line() {
+	fmt.Fprintf(p.w, "%s\n", strings.Join(p.cells, "\t"))
+}
+
+func (p *tablePrinter) close() error { return p.w.Flush() }
+
+type csvPrinter struct {
+	w *csv.Writer
+}
+
+func (p *csvPrinter) print(_ int, rec array.Record) error { return p.w.Write(rec) }
+func (p *csvPrinter) close() error                        { return p.w.Flush() }
+
+type jsonPrinter struct {
+	w *json.Writer
+}
+
+func (p *jsonPrinter) print(_ int, rec array.Record) error { return p.w.Write(rec) }
+func (p *jsonPrinter) close() error                        { return p.w.Close() }
+
 func init() {
 	flag.Usage = func() {
 		fmt.Fprintf(os.Stderr, `Command arrow-cat displays the content of an Arrow stream or file.
 
 Usage: arrow-cat [OPTIONS] [FILE1 [FILE2 [...]]]
 
+Options:
+`)
+		flag.PrintDefaults()
+		fmt.Fprintf(os.Stderr, `
 Examples:
 
  $> arrow-cat ./testdata/primitives.data
@@ -210,6 +554,15 @@ Examples:
  record 2...
    col[0] "bools": [true (null) (null) false true]
  [...]
+
+ $> arrow-cat -format=table -columns=int8s,float64s -head=3 ./testdata/primitives.data
+ int8s   float64s
+ -1      1
+ (null)  (null)
+ (null)  (null)
+
+ $> arrow-cat -format=csv -record=1 ./testdata/primitives.data
+ $> arrow-cat -schema ./testdata/primitives.data
 `)
 		os.Exit(0)
 	}
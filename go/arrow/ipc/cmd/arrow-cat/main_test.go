@@ -22,6 +22,8 @@ import (
 	"io"
 	"io/ioutil"
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/apache/arrow/go/arrow/array"
@@ -216,7 +218,7 @@ record 3...
 			defer f.Close()
 
 			w := new(bytes.Buffer)
-			err = processStream(w, f)
+			err = processStream(w, f, newConfig())
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -569,7 +571,7 @@ record 3/3...
 			}()
 
 			w := new(bytes.Buffer)
-			err := processFile(w, fname)
+			err := processFile(w, fname, newConfig())
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -580,3 +582,260 @@ record 3/3...
 		})
 	}
 }
+
+func TestCatOptions(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "go-arrow-cat-options-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	recs := arrdata.Records["primitives"]
+	write := func(name string, stream bool) string {
+		f, err := os.Create(filepath.Join(tempDir, name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer f.Close()
+
+		var w interface {
+			Write(array.Record) error
+			Close() error
+		}
+		switch {
+		case stream:
+			w = ipc.NewWriter(f, ipc.WithSchema(recs[0].Schema()), ipc.WithAllocator(mem))
+		default:
+			w, err = ipc.NewFileWriter(f, ipc.WithSchema(recs[0].Schema()), ipc.WithAllocator(mem))
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+		for _, rec := range recs {
+			err = w.Write(rec)
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+		err = w.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return f.Name()
+	}
+
+	var (
+		file   = write("primitives.arrow", false)
+		stream = write("primitives.arrows", true)
+	)
+
+	for _, tc := range []struct {
+		name   string
+		stream bool
+		cfg    func(cfg *config)
+		want   string
+		err    string
+	}{
+		{
+			name: "table",
+			cfg: func(cfg *config) {
+				cfg.format = "table"
+				cfg.columns = []string{"int8s", "float64s"}
+				cfg.head = 3
+			},
+			want: `int8s   float64s
+-1      1
+(null)  (null)
+(null)  (null)
+`,
+		},
+		{
+			name: "table-offset",
+			cfg: func(cfg *config) {
+				cfg.format = "table"
+				cfg.columns = []string{"bools", "uint16s"}
+				cfg.offset = 4
+				cfg.head = 3
+			},
+			want: `bools   uint16s
+true    5
+true    11
+(null)  (null)
+`,
+		},
+		{
+			name: "debug-offset-head",
+			cfg: func(cfg *config) {
+				cfg.columns = []string{"int8s"}
+				cfg.offset = 3
+				cfg.head = 4
+			},
+			want: `version: V4
+record 1/3...
+  col[0] "int8s": [-4 -5]
+record 2/3...
+  col[0] "int8s": [-11 (null)]
+`,
+		},
+		{
+			name:   "debug-head-stream",
+			stream: true,
+			cfg: func(cfg *config) {
+				cfg.columns = []string{"int8s"}
+				cfg.head = 7
+			},
+			want: `record 1...
+  col[0] "int8s": [-1 (null) (null) -4 -5]
+record 2...
+  col[0] "int8s": [-11 (null)]
+`,
+		},
+		{
+			name: "debug-tail",
+			cfg: func(cfg *config) {
+				cfg.columns = []string{"int8s"}
+				cfg.tail = 7
+			},
+			want: `version: V4
+record 2/3...
+  col[0] "int8s": [-14 -15]
+record 3/3...
+  col[0] "int8s": [-21 (null) (null) -24 -25]
+`,
+		},
+		{
+			name:   "debug-head-tail-stream",
+			stream: true,
+			cfg: func(cfg *config) {
+				cfg.columns = []string{"int8s"}
+				cfg.head = 12
+				cfg.tail = 3
+			},
+			want: `record 2...
+  col[0] "int8s": [-15]
+record 3...
+  col[0] "int8s": [-21 (null)]
+`,
+		},
+		{
+			name: "head-zero",
+			cfg: func(cfg *config) {
+				cfg.columns = []string{"int8s"}
+				cfg.head = 0
+			},
+			want: `version: V4
+`,
+		},
+		{
+			name: "csv-record",
+			cfg: func(cfg *config) {
+				cfg.format = "csv"
+				cfg.columns = []string{"int8s", "uint8s"}
+				cfg.record = 1
+			},
+			want: `int8s,uint8s
+-11,11
+NULL,NULL
+NULL,NULL
+-14,14
+-15,15
+`,
+		},
+		{
+			name: "json-record-tail",
+			cfg: func(cfg *config) {
+				cfg.format = "json"
+				cfg.columns = []string{"bools", "float32s"}
+				cfg.record = 2
+				cfg.tail = 2
+			},
+			want: `{"bools":false,"float32s":24}
+{"bools":true,"float32s":25}
+`,
+		},
+		{
+			name: "schema",
+			cfg: func(cfg *config) {
+				cfg.schema = true
+				cfg.columns = []string{"uint64s", "bools"}
+			},
+			want: `schema:
+  fields: 2
+    - uint64s: type=uint64, nullable
+    - bools: type=bool, nullable
+  metadata: ["k1": "v1", "k2": "v2", "k3": "v3"]
+`,
+		},
+		{
+			name:   "schema-stream",
+			stream: true,
+			cfg: func(cfg *config) {
+				cfg.schema = true
+				cfg.columns = []string{"float32s"}
+			},
+			want: `schema:
+  fields: 1
+    - float32s: type=float32, nullable
+  metadata: ["k1": "v1", "k2": "v2", "k3": "v3"]
+`,
+		},
+		{
+			name: "unknown-column",
+			cfg: func(cfg *config) {
+				cfg.columns = []string{"int8s", "nope"}
+			},
+			err: `unknown column "nope"`,
+		},
+		{
+			name: "unknown-format",
+			cfg: func(cfg *config) {
+				cfg.format = "xml"
+			},
+			err: `unknown output format "xml"`,
+		},
+		{
+			name: "record-out-of-bounds",
+			cfg: func(cfg *config) {
+				cfg.record = 3
+			},
+			err: "record index 3 out of bounds [0, 3)",
+		},
+		{
+			name:   "record-stream",
+			stream: true,
+			cfg: func(cfg *config) {
+				cfg.record = 0
+			},
+			err: "-record requires an Arrow file",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := newConfig()
+			tc.cfg(&cfg)
+
+			fname := file
+			if tc.stream {
+				fname = stream
+			}
+
+			w := new(bytes.Buffer)
+			err := processFile(w, fname, cfg)
+			switch {
+			case tc.err != "":
+				if err == nil || !strings.Contains(err.Error(), tc.err) {
+					t.Fatalf("invalid error: got=%v, want=%q", err, tc.err)
+				}
+				return
+			case err != nil:
+				t.Fatal(err)
+			}
+
+			if got, want := w.String(), tc.want; got != want {
+				t.Fatalf("invalid output:\ngot:\n%s\nwant:\n%s\n", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,509 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command arrow-convert converts records between the Arrow file, Arrow stream,
+// CSV and NDJSON formats.
+//
+// The input is read from the file named by the first argument, or from the
+// standard input. The output is written to the file named by the second
+// argument, or to the standard output.
+// Formats default to the ones implied by the extension of the files (.arrow,
+// .arrows, .csv, .json or .ndjson), and to the Arrow stream format otherwise.
+// Gzip-compressed inputs are decompressed transparently.
+//
+// The schema of CSV and NDJSON inputs is inferred from their first rows,
+// unless a schema is provided, with -schema, in the JSON format used by the
+// Arrow integration tests.
+//
+// Examples:
+//
+//  $> arrow-convert ./testdata/primitives.data out.csv
+//  $> arrow-convert -columns=int32s,float64s -limit=10 ./testdata/primitives.data out.ndjson
+//  $> cat data.csv | arrow-convert -i=csv -o=file -batch=1024 - out.arrow
+//  $> arrow-convert -o=json -compress=gzip in.arrow > out.ndjson.gz
+package main // import "github.com/apache/arrow/go/arrow/ipc/cmd/arrow-convert"
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/arrio"
+	"github.com/apache/arrow/go/arrow/arrjson"
+	"github.com/apache/arrow/go/arrow/csv"
+	"github.com/apache/arrow/go/arrow/ipc"
+	"github.com/apache/arrow/go/arrow/json"
+	"github.com/apache/arrow/go/arrow/memory"
+	"golang.org/x/xerrors"
+)
+
+func main() {
+	log.SetPrefix("arrow-convert: ")
+	log.SetFlags(0)
+
+	var (
+		cfg     config
+		comma   string
+		columns string
+	)
+	flag.StringVar(&cfg.ifmt, "i", "", "input format (file, stream, csv, json)")
+	flag.StringVar(&cfg.ofmt, "o", "", "output format (file, stream, csv, json)")
+	flag.StringVar(&cfg.schema, "schema", "", "path to the JSON schema of CSV and NDJSON inputs")
+	flag.BoolVar(&cfg.header, "header", true, "enable/disable the header line of CSV inputs and outputs")
+	flag.StringVar(&comma, "comma", ",", "field delimiter of CSV inputs and outputs")
+	flag.StringVar(&columns, "columns", "", "comma-separated list of the columns to convert")
+	flag.IntVar(&cfg.batch, "batch", 1024, "maximum number of rows of output records (0: keep input records)")
+	flag.Int64Var(&cfg.limit, "limit", -1, "maximum number of rows to convert (-1: all rows)")
+	flag.StringVar(&cfg.compress, "compress", "", "compression of the output (gzip)")
+
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: arrow-convert [flags] [input [output]]\n\nFlags:\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if flag.NArg() > 2 {
+		flag.Usage()
+		log.Fatalf("too many arguments")
+	}
+
+	var err error
+	cfg.comma, err = parseComma(comma)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if columns != "" {
+		cfg.columns = strings.Split(columns, ",")
+	}
+
+	var (
+		iname = flag.Arg(0)
+		oname = flag.Arg(1)
+	)
+	if cfg.ifmt == "" {
+		cfg.ifmt = formatOf(iname)
+	}
+	if cfg.ofmt == "" {
+		cfg.ofmt = formatOf(oname)
+	}
+
+	r := os.Stdin
+	if iname != "" && iname != "-" {
+		r, err = os.Open(iname)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer r.Close()
+	}
+
+	w := os.Stdout
+	if oname != "" && oname != "-" {
+		w, err = os.Create(oname)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer w.Close()
+	}
+
+	err = convert(w, r, memory.NewGoAllocator(), cfg)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	err = w.Close()
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+type config struct {
+	ifmt, ofmt string // input and output formats
+	schema     string // path to the JSON schema of CSV and NDJSON inputs
+	header     bool
+	comma      rune
+	columns    []string
+	batch      int
+	limit      int64
+	compress   string
+}
+
+func parseComma(s string) (rune, error) {
+	c, n := utf8.DecodeRuneInString(s)
+	if c == utf8.RuneError || n != len(s) {
+		return 0, xerrors.Errorf("invalid CSV delimiter %q", s)
+	}
+	return c, nil
+}
+
+// formatOf returns the format implied by the extension of the named file.
+func formatOf(fname string) string {
+	ext := strings.ToLower(filepath.Ext(strings.TrimSuffix(fname, ".gz")))
+	switch ext {
+	case ".arrow", ".feather":
+		return "file"
+	case ".csv":
+		return "csv"
+	case ".json", ".ndjson", ".jsonl":
+		return "json"
+	default:
+		return "stream"
+	}
+}
+
+func convert(w io.Writer, r io.Reader, mem memory.Allocator, cfg config) error {
+	var err error
+	f, ok := seekable(r)
+	switch {
+	case ok && cfg.ifmt == "file":
+		// read Arrow files in place, rather than buffering them in memory.
+		r = f
+	default:
+		r, err = decompress(r)
+		if err != nil {
+			return xerrors.Errorf("could not read input: %w", err)
+		}
+	}
+
+	rr, schema, err := newReader(r, mem, cfg)
+	if err != nil {
+		return xerrors.Errorf("could not open %s input: %w", cfg.ifmt, err)
+	}
+	if cfg.ifmt == "file" || cfg.ifmt == "stream" {
+		defer rr.(io.Closer).Close()
+	}
+
+	if cfg.limit >= 0 {
+		// the limit is applied first, so that the readers wrapping it read
+		// until the end and release their last record.
+		rr = arrio.Limit(rr, cfg.limit)
+	}
+	if len(cfg.columns) > 0 {
+		schema, err = selectColumns(schema, cfg.columns)
+		if err != nil {
+			return err
+		}
+		proj := schema
+		rr = arrio.Map(rr, func(rec array.Record) (array.Record, error) {
+			return array.ProjectRecord(rec, proj, mem)
+		})
+	}
+	if cfg.batch > 0 && (cfg.ifmt == "file" || cfg.ifmt == "stream") {
+		// CSV and NDJSON inputs are read in records of the right size.
+		rr = arrio.Rechunk(rr, int64(cfg.batch), mem)
+	}
+
+	var (
+		out = w
+		gz  *gzip.Writer
+	)
+	switch cfg.compress {
+	case "":
+	case "gzip":
+		gz = gzip.NewWriter(w)
+		out = gz
+	default:
+		return xerrors.Errorf("invalid compression %q", cfg.compress)
+	}
+
+	err = write(out, rr, schema, mem, cfg)
+	if err != nil {
+		return xerrors.Errorf("could not write %s output: %w", cfg.ofmt, err)
+	}
+
+	if gz != nil {
+		err = gz.Close()
+		if err != nil {
+			return xerrors.Errorf("could not close compressed output: %w", err)
+		}
+	}
+	return nil
+}
+
+var gzipMagic = []byte{0x1f, 0x8b}
+
+// decompress returns a reader decompressing r if it holds gzip data.
+func decompress(r io.Reader) (io.Reader, error) {
+	br := bufio.NewReader(r)
+	hdr, err := br.Peek(len(gzipMagic))
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+	if !bytes.Equal(hdr, gzipMagic) {
+		return br, nil
+	}
+	return gzip.NewReader(br)
+}
+
+// seekable returns r as a random access reader if it is an uncompressed
+// regular file.
+func seekable(r io.Reader) (ipc.ReadAtSeeker, bool) {
+	f, ok := r.(*os.File)
+	if !ok {
+		return nil, false
+	}
+	fi, err := f.Stat()
+	if err != nil || !fi.Mode().IsRegular() {
+		return nil, false
+	}
+
+	hdr := make([]byte, len(gzipMagic))
+	n, err := f.ReadAt(hdr, 0)
+	if err != nil && err != io.EOF {
+		return nil, false
+	}
+	return f, !bytes.Equal(hdr[:n], gzipMagic)
+}
+
+func newReader(r io.Reader, mem memory.Allocator, cfg config) (rr arrio.Reader, schema *arrow.Schema, err error) {
+	defer func() {
+		if e := recover(); e != nil {
+			err = xerrors.Errorf("%v", e)
+		}
+	}()
+
+	var fschema *arrow.Schema
+	if cfg.schema != "" {
+		raw, err := ioutil.ReadFile(cfg.schema)
+		if err != nil {
+			return nil, nil, xerrors.Errorf("could not read schema: %w", err)
+		}
+		fschema, err = arrjson.UnmarshalSchema(raw)
+		if err != nil {
+			return nil, nil, xerrors.Errorf("could not decode schema: %w", err)
+		}
+	}
+
+	chunk := cfg.batch
+	if chunk == 0 {
+		chunk = -1
+	}
+
+	switch cfg.ifmt {
+	case "file":
+		// the file format needs random access to its footer: inputs other
+		// than regular files, such as the standard input, are buffered.
+		ra, ok := r.(ipc.ReadAtSeeker)
+		if !ok {
+			raw, err := ioutil.ReadAll(r)
+			if err != nil {
+				return nil, nil, err
+			}
+			ra = bytes.NewReader(raw)
+		}
+		f, err := ipc.NewFileReader(ra, ipc.WithAllocator(mem))
+		if err != nil {
+			return nil, nil, err
+		}
+		return f, f.Schema(), nil
+
+	case "stream":
+		s, err := ipc.NewReader(r, ipc.WithAllocator(mem))
+		if err != nil {
+			return nil, nil, err
+		}
+		return &streamReader{s}, s.Schema(), nil
+
+	case "csv":
+		opts := []csv.Option{
+			csv.WithAllocator(mem),
+			csv.WithChunk(chunk),
+			csv.WithComma(cfg.comma),
+			csv.WithHeader(cfg.header),
+			csv.WithNullReader(true),
+		}
+		var c *csv.Reader
+		switch fschema {
+		case nil:
+			c = csv.NewInferringReader(r, opts...)
+		default:
+			c = csv.NewReader(r, fschema, opts...)
+		}
+		schema := c.Schema()
+		if err := c.Err(); err != nil {
+			return nil, nil, err
+		}
+		return &csvReader{c}, schema, nil
+
+	case "json":
+		opts := []json.Option{
+			json.WithAllocator(mem),
+			json.WithChunk(chunk),
+		}
+		var j *json.Reader
+		switch fschema {
+		case nil:
+			j = json.NewInferringReader(r, opts...)
+		default:
+			j = json.NewReader(r, fschema, opts...)
+		}
+		schema := j.Schema()
+		if err := j.Err(); err != nil {
+			return nil, nil, err
+		}
+		return j, schema, nil
+
+	default:
+		return nil, nil, xerrors.Errorf("invalid input format %q", cfg.ifmt)
+	}
+}
+
+func write(w io.Writer, r arrio.Reader, schema *arrow.Schema, mem memory.Allocator, cfg config) (err error) {
+	defer func() {
+		if e := recover(); e != nil {
+			err = xerrors.Errorf("%v", e)
+		}
+	}()
+
+	switch cfg.ofmt {
+	case "file":
+		return writeFile(w, r, schema, mem)
+
+	case "stream":
+		ww := ipc.NewWriter(w, ipc.WithAllocator(mem), ipc.WithSchema(schema))
+		defer ww.Close()
+
+		_, err = arrio.Copy(ww, r)
+		if err != nil {
+			return err
+		}
+		return ww.Close()
+
+	case "csv":
+		ww := csv.NewWriter(w, schema, csv.WithComma(cfg.comma), csv.WithHeader(cfg.header))
+		_, err = arrio.Copy(ww, r)
+		if err != nil {
+			return err
+		}
+		return ww.Flush()
+
+	case "json":
+		ww := json.NewWriter(w, schema)
+		_, err = arrio.Copy(ww, r)
+		if err != nil {
+			return err
+		}
+		return ww.Close()
+
+	default:
+		return xerrors.Errorf("invalid output format %q", cfg.ofmt)
+	}
+}
+
+// writeFile writes the records of r to w, in the Arrow file format.
+// As the file format needs a seekable output, the file is written to a
+// temporary file when w is not a regular file.
+func writeFile(w io.Writer, r arrio.Reader, schema *arrow.Schema, mem memory.Allocator) error {
+	out, ok := w.(*os.File)
+	if ok {
+		if fi, err := out.Stat(); err != nil || !fi.Mode().IsRegular() {
+			ok = false
+		}
+	}
+	if !ok {
+		tmp, err := ioutil.TempFile("", "arrow-convert-")
+		if err != nil {
+			return err
+		}
+		defer os.Remove(tmp.Name())
+		defer tmp.Close()
+		out = tmp
+	}
+
+	ww, err := ipc.NewFileWriter(out, ipc.WithAllocator(mem), ipc.WithSchema(schema))
+	if err != nil {
+		return err
+	}
+	defer ww.Close()
+
+	_, err = arrio.Copy(ww, r)
+	if err != nil {
+		return err
+	}
+	err = ww.Close()
+	if err != nil {
+		return err
+	}
+
+	if out == w {
+		return nil
+	}
+	_, err = out.Seek(0, io.SeekStart)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(w, out)
+	return err
+}
+
+// streamReader adapts an Arrow stream reader to the arrio.Reader and
+// io.Closer interfaces.
+type streamReader struct {
+	*ipc.Reader
+}
+
+func (r *streamReader) Close() error {
+	r.Release()
+	return nil
+}
+
+// csvReader adapts a CSV reader to the arrio.Reader interface.
+type csvReader struct {
+	r *csv.Reader
+}
+
+func (r *csvReader) Read() (array.Record, error) {
+	if !r.r.Next() {
+		if err := r.r.Err(); err != nil {
+			return nil, err
+		}
+		return nil, io.EOF
+	}
+	return r.r.Record(), nil
+}
+
+// selectColumns returns the schema made of the named fields of schema.
+func selectColumns(schema *arrow.Schema, names []string) (*arrow.Schema, error) {
+	fields := make([]arrow.Field, len(names))
+	for i, name := range names {
+		idx := schema.FieldIndices(name)
+		if len(idx) == 0 {
+			return nil, xerrors.Errorf("unknown column %q", name)
+		}
+		fields[i] = schema.Field(idx[0])
+	}
+
+	var meta *arrow.Metadata
+	if md := schema.Metadata(); md.Len() > 0 {
+		meta = &md
+	}
+	return arrow.NewSchema(fields, meta), nil
+}
+
+var (
+	_ arrio.Reader = (*streamReader)(nil)
+	_ arrio.Reader = (*csvReader)(nil)
+)
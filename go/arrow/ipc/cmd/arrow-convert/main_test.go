@@ -0,0 +1,255 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main // import "github.com/apache/arrow/go/arrow/ipc/cmd/arrow-convert"
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/arrjson"
+	"github.com/apache/arrow/go/arrow/internal/arrdata"
+	"github.com/apache/arrow/go/arrow/ipc"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+func TestConvertArrow(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "go-arrow-convert-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	for name, recs := range arrdata.Records {
+		t.Run(name, func(t *testing.T) {
+			mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+			defer mem.AssertSize(t, 0)
+
+			f, err := ioutil.TempFile(tempDir, "go-arrow-convert-")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer f.Close()
+
+			arrdata.WriteFile(t, f, mem, recs[0].Schema(), recs)
+
+			stream, err := ioutil.TempFile(tempDir, "go-arrow-convert-")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer stream.Close()
+
+			err = convert(stream, f, mem, config{ifmt: "file", ofmt: "stream", limit: -1})
+			if err != nil {
+				t.Fatalf("could not convert file to stream: %v", err)
+			}
+			arrdata.CheckArrowStream(t, stream, mem, recs[0].Schema(), recs)
+
+			_, err = stream.Seek(0, 0)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var buf bytes.Buffer
+			err = convert(&buf, stream, mem, config{ifmt: "stream", ofmt: "file", limit: -1})
+			if err != nil {
+				t.Fatalf("could not convert stream to file: %v", err)
+			}
+			checkFile(t, buf.Bytes(), mem, recs)
+		})
+	}
+}
+
+func TestConvertText(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "go-arrow-convert-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	recs := arrdata.Records["primitives"]
+	schema := recs[0].Schema()
+
+	raw, err := arrjson.MarshalSchema(schema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fschema := filepath.Join(tempDir, "schema.json")
+	err = ioutil.WriteFile(fschema, raw, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, format := range []string{"csv", "json"} {
+		t.Run(format, func(t *testing.T) {
+			mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+			defer mem.AssertSize(t, 0)
+
+			f, err := ioutil.TempFile(tempDir, "go-arrow-convert-")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer f.Close()
+
+			arrdata.WriteFile(t, f, mem, schema, recs)
+
+			cfg := config{
+				ifmt:   "file",
+				ofmt:   format,
+				schema: fschema,
+				header: true,
+				comma:  ',',
+				batch:  int(recs[0].NumRows()),
+				limit:  -1,
+			}
+
+			var text bytes.Buffer
+			err = convert(&text, f, mem, cfg)
+			if err != nil {
+				t.Fatalf("could not convert file to %s: %v", format, err)
+			}
+
+			cfg.ifmt, cfg.ofmt = format, "file"
+			var buf bytes.Buffer
+			err = convert(&buf, &text, mem, cfg)
+			if err != nil {
+				t.Fatalf("could not convert %s to file: %v", format, err)
+			}
+			checkFile(t, buf.Bytes(), mem, recs)
+		})
+	}
+}
+
+func TestConvertSelect(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	f, err := ioutil.TempFile("", "go-arrow-convert-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	recs := arrdata.Records["primitives"]
+	arrdata.WriteFile(t, f, mem, recs[0].Schema(), recs)
+
+	var buf bytes.Buffer
+	err = convert(&buf, f, mem, config{
+		ifmt:     "file",
+		ofmt:     "json",
+		columns:  []string{"int32s", "bools"},
+		batch:    2,
+		limit:    7,
+		compress: "gzip",
+	})
+	if err != nil {
+		t.Fatalf("could not convert file: %v", err)
+	}
+
+	if _, err := gzip.NewReader(bytes.NewReader(buf.Bytes())); err != nil {
+		t.Fatalf("output is not gzip-compressed: %v", err)
+	}
+
+	var out bytes.Buffer
+	err = convert(&out, &buf, mem, config{ifmt: "json", ofmt: "stream", limit: -1})
+	if err != nil {
+		t.Fatalf("could not convert compressed JSON: %v", err)
+	}
+
+	r, err := ipc.NewReader(&out, ipc.WithAllocator(mem))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Release()
+
+	want := arrow.NewSchema([]arrow.Field{
+		{Name: "int32s", Type: arrow.PrimitiveTypes.Int64, Nullable: true},
+		{Name: "bools", Type: arrow.FixedWidthTypes.Boolean, Nullable: true},
+	}, nil)
+	if !r.Schema().Equal(want) {
+		t.Fatalf("invalid schema:\ngot=%v\nwant=%v", r.Schema(), want)
+	}
+
+	var n int64
+	for r.Next() {
+		n += r.Record().NumRows()
+	}
+	if got, want := n, int64(7); got != want {
+		t.Fatalf("invalid number of rows: got=%d, want=%d", got, want)
+	}
+}
+
+func TestConvertErrors(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	f, err := ioutil.TempFile("", "go-arrow-convert-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	recs := arrdata.Records["primitives"]
+	arrdata.WriteFile(t, f, mem, recs[0].Schema(), recs)
+
+	for _, cfg := range []config{
+		{ifmt: "parquet", ofmt: "stream"},
+		{ifmt: "file", ofmt: "parquet"},
+		{ifmt: "file", ofmt: "stream", columns: []string{"unknown"}},
+		{ifmt: "file", ofmt: "stream", compress: "lz4"},
+		{ifmt: "stream", ofmt: "file"},
+	} {
+		_, err = f.Seek(0, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = convert(ioutil.Discard, f, mem, cfg)
+		if err == nil {
+			t.Fatalf("expected an error for %+v", cfg)
+		}
+	}
+}
+
+func checkFile(t *testing.T, raw []byte, mem memory.Allocator, recs []array.Record) {
+	t.Helper()
+
+	r, err := ipc.NewFileReader(bytes.NewReader(raw), ipc.WithAllocator(mem))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+
+	if got, want := r.NumRecords(), len(recs); got != want {
+		t.Fatalf("invalid number of records: got=%d, want=%d", got, want)
+	}
+	for i := 0; i < r.NumRecords(); i++ {
+		rec, err := r.Record(i)
+		if err != nil {
+			t.Fatalf("could not read record %d: %v", i, err)
+		}
+		if !array.RecordEqual(rec, recs[i]) {
+			t.Fatalf("records[%d] differ:\ngot= %v\nwant=%v", i, rec.Columns(), recs[i].Columns())
+		}
+	}
+}
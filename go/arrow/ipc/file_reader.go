@@ -18,8 +18,10 @@ package ipc // import "github.com/apache/arrow/go/arrow/ipc"
 
 import (
 	"bytes"
+	"context"
 	"encoding/binary"
 	"io"
+	"math"
 
 	"github.com/apache/arrow/go/arrow"
 	"github.com/apache/arrow/go/arrow/array"
@@ -44,9 +46,18 @@ type FileReader struct {
 
 	schema *arrow.Schema
 	record array.Record
+	meta   arrow.Metadata // custom metadata of the current record
+
+	mem  memory.Allocator
+	proj *arrow.Schema // schema records are projected onto, if any
 
 	irec int   // current record index. used for the arrio.Reader interface
 	err  error // last error
+
+	ctx      context.Context
+	prefetch int                  // number of records decoded ahead by Read
+	pipe     chan chan prefetched // records being decoded ahead, in order
+	cancel   context.CancelFunc
 }
 
 // NewFileReader opens an Arrow file using the provided reader r.
@@ -56,9 +67,13 @@ func NewFileReader(r ReadAtSeeker, opts ...Option) (*FileReader, error) {
 		err error
 
 		f = FileReader{
-			r:      r,
-			fields: make(dictTypeMap),
-			memo:   newMemo(),
+			r:        r,
+			fields:   make(dictTypeMap),
+			memo:     newMemo(),
+			mem:      cfg.alloc,
+			proj:     cfg.proj,
+			ctx:      cfg.ctx,
+			prefetch: cfg.prefetch,
 		}
 	)
 
@@ -87,8 +102,8 @@ func NewFileReader(r ReadAtSeeker, opts ...Option) (*FileReader, error) {
 	return &f, err
 }
 
-func (f *FileReader) readFooter() error {
-	var err error
+func (f *FileReader) readFooter() (err error) {
+	defer recoverDecodeError(&err)
 
 	if f.footer.offset <= int64(len(Magic)*2+4) {
 		return xerrors.Errorf("arrow/ipc: file too small (size=%d)", f.footer.offset)
@@ -109,7 +124,7 @@ func (f *FileReader) readFooter() error {
 	}
 
 	size := int64(binary.LittleEndian.Uint32(buf[:4]))
-	if size <= 0 || size+int64(len(Magic)*2+4) > f.footer.offset {
+	if size < 4 || size+int64(len(Magic)*2+4) > f.footer.offset {
 		return errInconsistentFileMetadata
 	}
 
@@ -122,14 +137,35 @@ func (f *FileReader) readFooter() error {
 		return xerrors.Errorf("arrow/ipc: could not read %d bytes from footer data", len(buf))
 	}
 
+	footer := flatbuf.GetRootAsFooter(buf, 0)
+
+	// access the footer tables up-front, so corrupted flatbuffer data is
+	// reported here rather than panicking later in the FileReader accessors.
+	var blk flatbuf.Block
+	footer.Version()
+	if n := footer.RecordBatchesLength(); n > 0 {
+		footer.RecordBatches(&blk, n-1)
+		blk.BodyLength()
+	}
+	if n := footer.DictionariesLength(); n > 0 {
+		footer.Dictionaries(&blk, n-1)
+		blk.BodyLength()
+	}
+
 	f.footer.buffer = memory.NewBufferBytes(buf)
-	f.footer.data = flatbuf.GetRootAsFooter(buf, 0)
+	f.footer.data = footer
 	return err
 }
 
-func (f *FileReader) readSchema() error {
-	var err error
-	f.fields, err = dictTypesFromFB(f.footer.data.Schema(nil))
+func (f *FileReader) readSchema() (err error) {
+	defer recoverDecodeError(&err)
+
+	schema := f.footer.data.Schema(nil)
+	if schema == nil {
+		return xerrors.Errorf("arrow/ipc: could not load schema from flatbuffer data")
+	}
+
+	f.fields, err = dictTypesFromFB(schema)
 	if err != nil {
 		return xerrors.Errorf("arrow/ipc: could not load dictionary types from file: %w", err)
 	}
@@ -146,6 +182,8 @@ func (f *FileReader) readSchema() error {
 			return xerrors.Errorf("arrow/ipc: invalid file metadata=%d position for dictionary %d", blk.Meta, i)
 		case !bitutil.IsMultipleOf8(blk.Body):
 			return xerrors.Errorf("arrow/ipc: invalid file body=%d position for dictionary %d", blk.Body, i)
+		case !blk.within(f.footer.offset):
+			return xerrors.Errorf("arrow/ipc: invalid file block (offset=%d, meta=%d, body=%d) for dictionary %d", blk.Offset, blk.Meta, blk.Body, i)
 		}
 
 		msg, err := blk.NewMessage()
@@ -162,10 +200,6 @@ func (f *FileReader) readSchema() error {
 		dict.Release() // memo.Add increases ref-count of dict.
 	}
 
-	schema := f.footer.data.Schema(nil)
-	if schema == nil {
-		return xerrors.Errorf("arrow/ipc: could not load schema from flatbuffer data")
-	}
 	f.schema, err = schemaFromFB(schema, &f.memo)
 	if err != nil {
 		return xerrors.Errorf("arrow/ipc: could not read schema: %w", err)
@@ -203,6 +237,9 @@ func (f *FileReader) dict(i int) (fileBlock, error) {
 }
 
 func (f *FileReader) Schema() *arrow.Schema {
+	if f.proj != nil {
+		return f.proj
+	}
 	return f.schema
 }
 
@@ -224,6 +261,8 @@ func (f *FileReader) Version() MetadataVersion {
 // Close cleans up resources used by the File.
 // Close does not close the underlying reader.
 func (f *FileReader) Close() error {
+	f.stopPrefetch()
+
 	if f.footer.data != nil {
 		f.footer.data = nil
 	}
@@ -244,39 +283,80 @@ func (f *FileReader) Close() error {
 // The returned value is valid until the next call to Record.
 // Users need to call Retain on that Record to keep it valid for longer.
 func (f *FileReader) Record(i int) (array.Record, error) {
-	if i < 0 || i > f.NumRecords() {
-		panic("arrow/ipc: record index out of bounds")
+	if f.record != nil {
+		f.record.Release()
+		f.record = nil
 	}
 
-	blk, err := f.block(i)
+	rec, meta, err := f.recordAt(i)
 	if err != nil {
 		return nil, err
 	}
+
+	f.record = rec
+	f.meta = meta
+	return f.record, nil
+}
+
+// recordAt decodes the i-th record from the file, with its custom metadata.
+// recordAt does not modify the state of the FileReader and may be called
+// concurrently.
+func (f *FileReader) recordAt(i int) (rec array.Record, meta arrow.Metadata, err error) {
+	defer recoverDecodeError(&err)
+
+	if i < 0 || i >= f.NumRecords() {
+		return nil, meta, xerrors.Errorf("arrow/ipc: record index %d out of bounds [0, %d)", i, f.NumRecords())
+	}
+
+	blk, err := f.block(i)
+	if err != nil {
+		return nil, meta, err
+	}
 	switch {
 	case !bitutil.IsMultipleOf8(blk.Offset):
-		return nil, xerrors.Errorf("arrow/ipc: invalid file offset=%d for record %d", blk.Offset, i)
+		return nil, meta, xerrors.Errorf("arrow/ipc: invalid file offset=%d for record %d", blk.Offset, i)
 	case !bitutil.IsMultipleOf8(int64(blk.Meta)):
-		return nil, xerrors.Errorf("arrow/ipc: invalid file metadata=%d position for record %d", blk.Meta, i)
+		return nil, meta, xerrors.Errorf("arrow/ipc: invalid file metadata=%d position for record %d", blk.Meta, i)
 	case !bitutil.IsMultipleOf8(blk.Body):
-		return nil, xerrors.Errorf("arrow/ipc: invalid file body=%d position for record %d", blk.Body, i)
+		return nil, meta, xerrors.Errorf("arrow/ipc: invalid file body=%d position for record %d", blk.Body, i)
+	case !blk.within(f.footer.offset):
+		return nil, meta, xerrors.Errorf("arrow/ipc: invalid file block (offset=%d, meta=%d, body=%d) for record %d", blk.Offset, blk.Meta, blk.Body, i)
 	}
 
 	msg, err := blk.NewMessage()
 	if err != nil {
-		return nil, err
+		return nil, meta, err
 	}
 	defer msg.Release()
 
 	if msg.Type() != MessageRecordBatch {
-		return nil, xerrors.Errorf("arrow/ipc: message %d is not a Record", i)
+		return nil, meta, xerrors.Errorf("arrow/ipc: message %d is not a Record", i)
 	}
 
-	if f.record != nil {
-		f.record.Release()
+	meta, err = msg.Metadata()
+	if err != nil {
+		return nil, meta, xerrors.Errorf("arrow/ipc: could not decode metadata of record %d: %w", i, err)
 	}
 
-	f.record = newRecord(f.schema, msg.meta, bytes.NewReader(msg.body.Bytes()))
-	return f.record, nil
+	rec, err = newRecord(f.schema, msg.meta, msg.body)
+	if err != nil {
+		return nil, meta, xerrors.Errorf("arrow/ipc: could not decode record %d: %w", i, err)
+	}
+	if f.proj != nil {
+		defer rec.Release()
+		rec, err = array.ProjectRecord(rec, f.proj, f.mem)
+		if err != nil {
+			return nil, meta, xerrors.Errorf("arrow/ipc: could not project record %d: %w", i, err)
+		}
+	}
+	return rec, meta, nil
+}
+
+// RecordMetadata returns the custom key-value metadata attached to the
+// message of the record last returned by Record.
+// It is valid until the next call to Record.
+func (f *FileReader) RecordMetadata() arrow.Metadata {
+	return f.meta
 }
 
 // Read reads the current record from the underlying stream and an error, if any.
@@ -288,6 +368,12 @@ func (f *FileReader) Read() (rec array.Record, err error) {
 	if f.irec == f.NumRecords() {
 		return nil, io.EOF
 	}
+	if err := f.ctx.Err(); err != nil {
+		return nil, err
+	}
+	if f.prefetch > 0 {
+		return f.readPrefetched()
+	}
 	rec, f.err = f.Record(f.irec)
 	f.irec++
 	return rec, f.err
@@ -298,59 +384,87 @@ func (f *FileReader) ReadAt(i int64) (array.Record, error) {
 	return f.Record(int(i))
 }
 
-func newRecord(schema *arrow.Schema, meta *memory.Buffer, body ReadAtSeeker) array.Record {
+func newRecord(schema *arrow.Schema, meta, body *memory.Buffer) (array.Record, error) {
 	var (
 		msg = flatbuf.GetRootAsMessage(meta.Bytes(), 0)
 		md  flatbuf.RecordBatch
 	)
-	initFB(&md, msg.Header)
+	err := initFB(&md, msg.Header)
+	if err != nil {
+		return nil, err
+	}
 	rows := md.Length()
+	if rows < 0 || rows > math.MaxInt32 {
+		return nil, xerrors.Errorf("arrow/ipc: invalid record length (%d)", rows)
+	}
 
 	ctx := &arrayLoaderContext{
 		src: ipcSource{
 			meta: &md,
-			r:    body,
+			r:    bytes.NewReader(body.Bytes()),
+			size: int64(body.Len()),
 		},
 		max: kMaxNestingDepth,
 	}
 
-	cols := make([]array.Interface, len(schema.Fields()))
+	cols := make([]array.Interface, 0, len(schema.Fields()))
+	defer func() {
+		for _, col := range cols {
+			col.Release()
+		}
+	}()
+
 	for i, field := range schema.Fields() {
-		cols[i] = ctx.loadArray(field.Type)
+		col, err := ctx.loadArray(field.Type)
+		if err != nil {
+			return nil, xerrors.Errorf("arrow/ipc: could not load column %d (%q): %w", i, field.Name, err)
+		}
+		cols = append(cols, col)
+		if int64(col.Len()) != rows {
+			return nil, xerrors.Errorf("arrow/ipc: invalid length for column %d (%q) (got=%d, want=%d)", i, field.Name, col.Len(), rows)
+		}
 	}
 
-	return array.NewRecord(schema, cols, rows)
+	return array.NewRecord(schema, cols, rows), nil
 }
 
 type ipcSource struct {
 	meta *flatbuf.RecordBatch
 	r    ReadAtSeeker
+	size int64 // size of the message body
 }
 
-func (src *ipcSource) buffer(i int) *memory.Buffer {
+func (src *ipcSource) buffer(i int) (*memory.Buffer, error) {
 	var buf flatbuf.Buffer
 	if !src.meta.Buffers(&buf, i) {
-		panic("buffer index out of bound")
+		return nil, xerrors.Errorf("arrow/ipc: buffer index %d out of bounds", i)
 	}
 	if buf.Length() == 0 {
-		return memory.NewBufferBytes(nil)
+		return memory.NewBufferBytes(nil), nil
+	}
+
+	if buf.Offset() < 0 || buf.Length() < 0 || buf.Offset() > src.size || buf.Length() > src.size-buf.Offset() {
+		return nil, xerrors.Errorf(
+			"arrow/ipc: buffer %d (offset=%d, length=%d) out of bounds of message body (size=%d)",
+			i, buf.Offset(), buf.Length(), src.size,
+		)
 	}
 
 	raw := make([]byte, buf.Length())
 	_, err := src.r.ReadAt(raw, buf.Offset())
 	if err != nil {
-		panic(err)
+		return nil, xerrors.Errorf("arrow/ipc: could not read buffer %d: %w", i, err)
 	}
 
-	return memory.NewBufferBytes(raw)
+	return memory.NewBufferBytes(raw), nil
 }
 
-func (src *ipcSource) fieldMetadata(i int) *flatbuf.FieldNode {
+func (src *ipcSource) fieldMetadata(i int) (*flatbuf.FieldNode, error) {
 	var node flatbuf.FieldNode
 	if !src.meta.Nodes(&node, i) {
-		panic("field metadata out of bound")
+		return nil, xerrors.Errorf("arrow/ipc: field metadata index %d out of bounds", i)
 	}
-	return &node
+	return &node, nil
 }
 
 type arrayLoaderContext struct {
@@ -360,19 +474,52 @@ type arrayLoaderContext struct {
 	max     int
 }
 
-func (ctx *arrayLoaderContext) field() *flatbuf.FieldNode {
-	field := ctx.src.fieldMetadata(ctx.ifield)
+func (ctx *arrayLoaderContext) field() (*flatbuf.FieldNode, error) {
+	field, err := ctx.src.fieldMetadata(ctx.ifield)
+	if err != nil {
+		return nil, err
+	}
 	ctx.ifield++
-	return field
+
+	switch n := field.Length(); {
+	case n < 0:
+		return nil, xerrors.Errorf("arrow/ipc: invalid array length (%d)", n)
+	case n > math.MaxInt32:
+		return nil, errBigArray
+	}
+	if n := field.NullCount(); n < 0 || n > field.Length() {
+		return nil, xerrors.Errorf("arrow/ipc: invalid null count (%d) for array of length %d", n, field.Length())
+	}
+	return field, nil
 }
 
-func (ctx *arrayLoaderContext) buffer() *memory.Buffer {
-	buf := ctx.src.buffer(ctx.ibuffer)
+func (ctx *arrayLoaderContext) buffer() (*memory.Buffer, error) {
+	buf, err := ctx.src.buffer(ctx.ibuffer)
+	if err != nil {
+		return nil, err
+	}
 	ctx.ibuffer++
-	return buf
+	return buf, nil
+}
+
+// buffers loads the next n buffers, checking each of them holds at least
+// the corresponding number of bytes in sizes.
+func (ctx *arrayLoaderContext) buffers(sizes ...int64) ([]*memory.Buffer, error) {
+	bufs := make([]*memory.Buffer, len(sizes))
+	for i, size := range sizes {
+		buf, err := ctx.buffer()
+		if err != nil {
+			return nil, err
+		}
+		if int64(buf.Len()) < size {
+			return nil, xerrors.Errorf("arrow/ipc: buffer %d too small (got=%d, want>=%d)", ctx.ibuffer-1, buf.Len(), size)
+		}
+		bufs[i] = buf
+	}
+	return bufs, nil
 }
 
-func (ctx *arrayLoaderContext) loadArray(dt arrow.DataType) array.Interface {
+func (ctx *arrayLoaderContext) loadArray(dt arrow.DataType) (array.Interface, error) {
 	switch dt := dt.(type) {
 	case *arrow.NullType:
 		return ctx.loadNull()
@@ -389,14 +536,17 @@ func (ctx *arrayLoaderContext) loadArray(dt arrow.DataType) array.Interface {
 		*arrow.DurationType:
 		return ctx.loadPrimitive(dt)
 
-	case *arrow.BinaryType, *arrow.StringType:
+	case *arrow.BinaryType, *arrow.StringType, *arrow.LargeBinaryType, *arrow.LargeStringType:
 		return ctx.loadBinary(dt)
 
 	case *arrow.FixedSizeBinaryType:
 		return ctx.loadFixedSizeBinary(dt)
 
 	case *arrow.ListType:
-		return ctx.loadList(dt)
+		return ctx.loadList(dt, dt.Elem())
+
+	case *arrow.LargeListType:
+		return ctx.loadList(dt, dt.Elem())
 
 	case *arrow.FixedSizeListType:
 		return ctx.loadFixedSizeList(dt)
@@ -405,125 +555,272 @@ func (ctx *arrayLoaderContext) loadArray(dt arrow.DataType) array.Interface {
 		return ctx.loadStruct(dt)
 
 	default:
-		panic(xerrors.Errorf("array type %T not handled yet", dt))
+		return nil, xerrors.Errorf("arrow/ipc: array type %T not handled yet", dt)
 	}
 }
 
-func (ctx *arrayLoaderContext) loadCommon(nbufs int) (*flatbuf.FieldNode, []*memory.Buffer) {
+func (ctx *arrayLoaderContext) loadCommon(nbufs int) (*flatbuf.FieldNode, []*memory.Buffer, error) {
 	buffers := make([]*memory.Buffer, 0, nbufs)
-	field := ctx.field()
+	field, err := ctx.field()
+	if err != nil {
+		return nil, nil, err
+	}
 
 	var buf *memory.Buffer
 	switch field.NullCount() {
 	case 0:
 		ctx.ibuffer++
 	default:
-		buf = ctx.buffer()
+		bufs, err := ctx.buffers(bitutil.BytesForBits(field.Length()))
+		if err != nil {
+			return nil, nil, xerrors.Errorf("arrow/ipc: invalid validity bitmap: %w", err)
+		}
+		buf = bufs[0]
 	}
 	buffers = append(buffers, buf)
 
-	return field, buffers
+	return field, buffers, nil
 }
 
-func (ctx *arrayLoaderContext) loadChild(dt arrow.DataType) array.Interface {
+func (ctx *arrayLoaderContext) loadChild(dt arrow.DataType) (array.Interface, error) {
 	if ctx.max == 0 {
-		panic("arrow/ipc: nested type limit reached")
+		return nil, errMaxRecursion
 	}
 	ctx.max--
-	sub := ctx.loadArray(dt)
+	sub, err := ctx.loadArray(dt)
 	ctx.max++
-	return sub
+	return sub, err
+}
+
+// loadOffsets loads the offsets buffer of a variable-length array of length n.
+// loadOffsets checks the offsets are monotonically increasing and returns
+// the last offset.
+func (ctx *arrayLoaderContext) loadOffsets(n int64) (*memory.Buffer, int64, error) {
+	size := int64(0)
+	if n > 0 {
+		size = (n + 1) * int64(arrow.Int32SizeBytes)
+	}
+	bufs, err := ctx.buffers(size)
+	if err != nil {
+		return nil, 0, xerrors.Errorf("arrow/ipc: invalid offsets: %w", err)
+	}
+
+	if n == 0 {
+		return bufs[0], 0, nil
+	}
+
+	offsets := arrow.Int32Traits.CastFromBytes(bufs[0].Bytes())[:n+1]
+	if offsets[0] < 0 {
+		return nil, 0, xerrors.Errorf("arrow/ipc: invalid first offset (%d)", offsets[0])
+	}
+	for i := 1; i < len(offsets); i++ {
+		if offsets[i] < offsets[i-1] {
+			return nil, 0, xerrors.Errorf("arrow/ipc: offsets not monotonic at index %d (%d < %d)", i, offsets[i], offsets[i-1])
+		}
+	}
+	return bufs[0], int64(offsets[n]), nil
+}
+
+// loadLargeOffsets is like loadOffsets, for int64 offsets.
+func (ctx *arrayLoaderContext) loadLargeOffsets(n int64) (*memory.Buffer, int64, error) {
+	size := int64(0)
+	if n > 0 {
+		size = (n + 1) * int64(arrow.Int64SizeBytes)
+	}
+	bufs, err := ctx.buffers(size)
+	if err != nil {
+		return nil, 0, xerrors.Errorf("arrow/ipc: invalid offsets: %w", err)
+	}
+
+	if n == 0 {
+		return bufs[0], 0, nil
+	}
+
+	offsets := arrow.Int64Traits.CastFromBytes(bufs[0].Bytes())[:n+1]
+	if offsets[0] < 0 {
+		return nil, 0, xerrors.Errorf("arrow/ipc: invalid first offset (%d)", offsets[0])
+	}
+	for i := 1; i < len(offsets); i++ {
+		if offsets[i] < offsets[i-1] {
+			return nil, 0, xerrors.Errorf("arrow/ipc: offsets not monotonic at index %d (%d < %d)", i, offsets[i], offsets[i-1])
+		}
+	}
+	return bufs[0], offsets[n], nil
 }
 
-func (ctx *arrayLoaderContext) loadNull() array.Interface {
-	field := ctx.field()
+// loadOffsetsOf loads the offsets of an array of the given type, with 32-bit
+// or 64-bit offsets.
+func (ctx *arrayLoaderContext) loadOffsetsOf(dt arrow.DataType, n int64) (*memory.Buffer, int64, error) {
+	switch dt.ID() {
+	case arrow.LARGE_STRING, arrow.LARGE_BINARY, arrow.LARGE_LIST:
+		return ctx.loadLargeOffsets(n)
+	default:
+		return ctx.loadOffsets(n)
+	}
+}
+
+func (ctx *arrayLoaderContext) loadNull() (array.Interface, error) {
+	field, err := ctx.field()
+	if err != nil {
+		return nil, err
+	}
+
 	data := array.NewData(arrow.Null, int(field.Length()), nil, nil, int(field.NullCount()), 0)
 	defer data.Release()
 
-	return array.MakeFromData(data)
+	return array.MakeFromData(data), nil
 }
 
-func (ctx *arrayLoaderContext) loadPrimitive(dt arrow.DataType) array.Interface {
-	field, buffers := ctx.loadCommon(2)
+func (ctx *arrayLoaderContext) loadPrimitive(dt arrow.DataType) (array.Interface, error) {
+	field, buffers, err := ctx.loadCommon(2)
+	if err != nil {
+		return nil, err
+	}
 
 	switch field.Length() {
 	case 0:
 		buffers = append(buffers, nil)
 		ctx.ibuffer++
 	default:
-		buffers = append(buffers, ctx.buffer())
+		bw := int64(dt.(arrow.FixedWidthDataType).BitWidth())
+		if dt.ID() == arrow.DECIMAL {
+			bw = 8 * int64(arrow.Decimal128SizeBytes)
+		}
+		bufs, err := ctx.buffers(bitutil.BytesForBits(field.Length() * bw))
+		if err != nil {
+			return nil, xerrors.Errorf("arrow/ipc: invalid values: %w", err)
+		}
+		buffers = append(buffers, bufs...)
 	}
 
 	data := array.NewData(dt, int(field.Length()), buffers, nil, int(field.NullCount()), 0)
 	defer data.Release()
 
-	return array.MakeFromData(data)
+	return array.MakeFromData(data), nil
 }
 
-func (ctx *arrayLoaderContext) loadBinary(dt arrow.DataType) array.Interface {
-	field, buffers := ctx.loadCommon(3)
-	buffers = append(buffers, ctx.buffer(), ctx.buffer())
+func (ctx *arrayLoaderContext) loadBinary(dt arrow.DataType) (array.Interface, error) {
+	field, buffers, err := ctx.loadCommon(3)
+	if err != nil {
+		return nil, err
+	}
+
+	offsets, end, err := ctx.loadOffsetsOf(dt, field.Length())
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := ctx.buffers(end)
+	if err != nil {
+		return nil, xerrors.Errorf("arrow/ipc: invalid values: %w", err)
+	}
+	buffers = append(buffers, offsets, values[0])
 
 	data := array.NewData(dt, int(field.Length()), buffers, nil, int(field.NullCount()), 0)
 	defer data.Release()
 
-	return array.MakeFromData(data)
+	return array.MakeFromData(data), nil
 }
 
-func (ctx *arrayLoaderContext) loadFixedSizeBinary(dt *arrow.FixedSizeBinaryType) array.Interface {
-	field, buffers := ctx.loadCommon(2)
-	buffers = append(buffers, ctx.buffer())
+func (ctx *arrayLoaderContext) loadFixedSizeBinary(dt *arrow.FixedSizeBinaryType) (array.Interface, error) {
+	field, buffers, err := ctx.loadCommon(2)
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := ctx.buffers(field.Length() * int64(dt.ByteWidth))
+	if err != nil {
+		return nil, xerrors.Errorf("arrow/ipc: invalid values: %w", err)
+	}
+	buffers = append(buffers, values[0])
 
 	data := array.NewData(dt, int(field.Length()), buffers, nil, int(field.NullCount()), 0)
 	defer data.Release()
 
-	return array.MakeFromData(data)
+	return array.MakeFromData(data), nil
 }
 
-func (ctx *arrayLoaderContext) loadList(dt *arrow.ListType) array.Interface {
-	field, buffers := ctx.loadCommon(2)
-	buffers = append(buffers, ctx.buffer())
+// loadList loads a list or large list array of elements of type elem.
+func (ctx *arrayLoaderContext) loadList(dt, elem arrow.DataType) (array.Interface, error) {
+	field, buffers, err := ctx.loadCommon(2)
+	if err != nil {
+		return nil, err
+	}
+
+	offsets, end, err := ctx.loadOffsetsOf(dt, field.Length())
+	if err != nil {
+		return nil, err
+	}
+	buffers = append(buffers, offsets)
 
-	sub := ctx.loadChild(dt.Elem())
+	sub, err := ctx.loadChild(elem)
+	if err != nil {
+		return nil, err
+	}
 	defer sub.Release()
 
+	if int64(sub.Len()) < end {
+		return nil, xerrors.Errorf("arrow/ipc: list child array too short (got=%d, want>=%d)", sub.Len(), end)
+	}
+
 	data := array.NewData(dt, int(field.Length()), buffers, []*array.Data{sub.Data()}, int(field.NullCount()), 0)
 	defer data.Release()
 
-	return array.NewListData(data)
+	return array.MakeFromData(data), nil
 }
 
-func (ctx *arrayLoaderContext) loadFixedSizeList(dt *arrow.FixedSizeListType) array.Interface {
-	field, buffers := ctx.loadCommon(1)
+func (ctx *arrayLoaderContext) loadFixedSizeList(dt *arrow.FixedSizeListType) (array.Interface, error) {
+	field, buffers, err := ctx.loadCommon(1)
+	if err != nil {
+		return nil, err
+	}
 
-	sub := ctx.loadChild(dt.Elem())
+	sub, err := ctx.loadChild(dt.Elem())
+	if err != nil {
+		return nil, err
+	}
 	defer sub.Release()
 
+	if want := field.Length() * int64(dt.Len()); int64(sub.Len()) < want {
+		return nil, xerrors.Errorf("arrow/ipc: fixed-size list child array too short (got=%d, want>=%d)", sub.Len(), want)
+	}
+
 	data := array.NewData(dt, int(field.Length()), buffers, []*array.Data{sub.Data()}, int(field.NullCount()), 0)
 	defer data.Release()
 
-	return array.NewFixedSizeListData(data)
+	return array.NewFixedSizeListData(data), nil
 }
 
-func (ctx *arrayLoaderContext) loadStruct(dt *arrow.StructType) array.Interface {
-	field, buffers := ctx.loadCommon(1)
-
-	arrs := make([]array.Interface, len(dt.Fields()))
-	subs := make([]*array.Data, len(dt.Fields()))
-	for i, f := range dt.Fields() {
-		arrs[i] = ctx.loadChild(f.Type)
-		subs[i] = arrs[i].Data()
+func (ctx *arrayLoaderContext) loadStruct(dt *arrow.StructType) (array.Interface, error) {
+	field, buffers, err := ctx.loadCommon(1)
+	if err != nil {
+		return nil, err
 	}
+
+	arrs := make([]array.Interface, 0, len(dt.Fields()))
+	subs := make([]*array.Data, 0, len(dt.Fields()))
 	defer func() {
 		for i := range arrs {
 			arrs[i].Release()
 		}
 	}()
 
+	for i, f := range dt.Fields() {
+		sub, err := ctx.loadChild(f.Type)
+		if err != nil {
+			return nil, err
+		}
+		arrs = append(arrs, sub)
+		subs = append(subs, sub.Data())
+		if int64(sub.Len()) < field.Length() {
+			return nil, xerrors.Errorf("arrow/ipc: struct field %d too short (got=%d, want>=%d)", i, sub.Len(), field.Length())
+		}
+	}
+
 	data := array.NewData(dt, int(field.Length()), buffers, subs, int(field.NullCount()), 0)
 	defer data.Release()
 
-	return array.NewStructData(data)
+	return array.NewStructData(data), nil
 }
 
 func readDictionary(meta *memory.Buffer, types dictTypeMap, r ReadAtSeeker) (int64, array.Interface, error) {
@@ -566,5 +863,5 @@ func readDictionary(meta *memory.Buffer, types dictTypeMap, r ReadAtSeeker) (int
 	//
 	//	batch := array.NewRecord(schema, cols, rows)
 
-	panic("not implemented")
+	return 0, nil, xerrors.Errorf("arrow/ipc: reading dictionaries not implemented")
 }
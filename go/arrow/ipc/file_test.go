@@ -19,9 +19,12 @@ package ipc_test
 import (
 	"io/ioutil"
 	"os"
+	"strconv"
 	"testing"
 
+	"github.com/apache/arrow/go/arrow"
 	"github.com/apache/arrow/go/arrow/internal/arrdata"
+	"github.com/apache/arrow/go/arrow/ipc"
 	"github.com/apache/arrow/go/arrow/memory"
 )
 
@@ -48,3 +51,120 @@ func TestFile(t *testing.T) {
 		})
 	}
 }
+
+func TestFileRecordMetadata(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	f, err := ioutil.TempFile("", "go-arrow-file-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	recs := arrdata.Records["structs"]
+	w, err := ipc.NewFileWriter(f, ipc.WithSchema(recs[0].Schema()), ipc.WithAllocator(mem))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, rec := range recs {
+		meta := arrow.NewMetadata([]string{"index"}, []string{strconv.Itoa(i)})
+		err = w.WriteWithMetadata(rec, meta)
+		if err != nil {
+			t.Fatalf("could not write record[%d]: %v", i, err)
+		}
+	}
+	err = w.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := ipc.NewFileReader(f, ipc.WithAllocator(mem))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+
+	for i := r.NumRecords() - 1; i >= 0; i-- {
+		_, err := r.Record(i)
+		if err != nil {
+			t.Fatalf("could not read record %d: %v", i, err)
+		}
+		meta := r.RecordMetadata()
+		if meta.Len() != 1 || meta.Keys()[0] != "index" || meta.Values()[0] != strconv.Itoa(i) {
+			t.Fatalf("invalid metadata for record %d: %v", i, meta)
+		}
+	}
+}
+
+func TestFileAppend(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "go-arrow-file-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	for name, recs := range arrdata.Records {
+		t.Run(name, func(t *testing.T) {
+			mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+			defer mem.AssertSize(t, 0)
+
+			f, err := ioutil.TempFile(tempDir, "go-arrow-file-")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer f.Close()
+
+			schema := recs[0].Schema()
+			arrdata.WriteFile(t, f, mem, schema, recs[:1])
+
+			for i, rec := range recs[1:] {
+				w, err := ipc.NewFileAppender(f, ipc.WithSchema(schema), ipc.WithAllocator(mem))
+				if err != nil {
+					t.Fatalf("could not open file for appending: %v", err)
+				}
+
+				err = w.Write(rec)
+				if err != nil {
+					t.Fatalf("could not append record[%d]: %v", i+1, err)
+				}
+
+				err = w.Close()
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			arrdata.CheckArrowFile(t, f, mem, schema, recs)
+
+			r, err := ipc.NewFileReader(f, ipc.WithAllocator(mem))
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer r.Close()
+
+			if got, want := r.NumRecords(), len(recs); got != want {
+				t.Fatalf("invalid number of records: got=%d, want=%d", got, want)
+			}
+		})
+	}
+}
+
+func TestFileAppendInvalidSchema(t *testing.T) {
+	f, err := ioutil.TempFile("", "go-arrow-file-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	mem := memory.NewGoAllocator()
+	recs := arrdata.Records["primitives"]
+	arrdata.WriteFile(t, f, mem, recs[0].Schema(), recs)
+
+	_, err = ipc.NewFileAppender(f, ipc.WithSchema(arrdata.Records["strings"][0].Schema()))
+	if err == nil {
+		t.Fatalf("expected an error")
+	}
+}
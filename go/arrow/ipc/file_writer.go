@@ -261,6 +261,82 @@ func NewFileWriter(w io.WriteSeeker, opts ...Option) (*FileWriter, error) {
 	return &f, err
 }
 
+// ReadWriteAtSeeker is the interface required to append records to an
+// existing Arrow file. It is implemented by *os.File.
+type ReadWriteAtSeeker interface {
+	ReadAtSeeker
+	io.Writer
+}
+
+// NewFileAppender opens the existing Arrow file f for appending records.
+//
+// NewFileAppender reads the footer of the file and checks its schema against
+// the one provided with WithSchema, if any.
+// New records are written in place of the current footer; the footer,
+// updated with the new record blocks, is written out when the returned
+// FileWriter is closed.
+// If f implements Truncate(int64) error, the current footer is truncated
+// away before any new record is written.
+func NewFileAppender(f ReadWriteAtSeeker, opts ...Option) (*FileWriter, error) {
+	r, err := NewFileReader(f, opts...)
+	if err != nil {
+		return nil, xerrors.Errorf("arrow/ipc: could not open file for appending: %w", err)
+	}
+	defer r.Close()
+
+	pw := &pwriter{
+		w:      f,
+		schema: r.Schema(),
+		dicts:  make([]fileBlock, r.NumDictionaries()),
+		recs:   make([]fileBlock, r.NumRecords()),
+	}
+	for i := range pw.dicts {
+		pw.dicts[i], err = r.dict(i)
+		if err != nil {
+			return nil, err
+		}
+		pw.dicts[i].r = nil
+	}
+	for i := range pw.recs {
+		pw.recs[i], err = r.block(i)
+		if err != nil {
+			return nil, err
+		}
+		pw.recs[i].r = nil
+	}
+
+	// the footer is followed by its size and the Arrow magic bytes.
+	pos := r.footer.offset - int64(r.footer.buffer.Len()+4+len(Magic))
+
+	pw.pos, err = f.Seek(pos, io.SeekStart)
+	if err != nil {
+		return nil, xerrors.Errorf("arrow/ipc: could not seek to file footer: %w", err)
+	}
+
+	if t, ok := f.(interface{ Truncate(int64) error }); ok {
+		err = t.Truncate(pos)
+		if err != nil {
+			return nil, xerrors.Errorf("arrow/ipc: could not truncate file footer: %w", err)
+		}
+	}
+
+	err = pw.align(kArrowIPCAlignment)
+	if err != nil {
+		return nil, xerrors.Errorf("arrow/ipc: could not align first appended block: %w", err)
+	}
+
+	cfg := newConfig(opts...)
+	w := &FileWriter{
+		w:      f,
+		pw:     pw,
+		mem:    cfg.alloc,
+		schema: pw.schema,
+	}
+	w.header.started = true
+
+	return w, nil
+}
+
 func (f *FileWriter) Close() error {
 	err := f.checkStarted()
 	if err != nil {
@@ -280,7 +356,14 @@ func (f *FileWriter) Close() error {
 	return nil
 }
 
+// Write writes the given record to the underlying file.
 func (f *FileWriter) Write(rec array.Record) error {
+	return f.WriteWithMetadata(rec, arrow.Metadata{})
+}
+
+// WriteWithMetadata writes the given record to the underlying file,
+// attaching the provided key-value metadata to the record batch message.
+func (f *FileWriter) WriteWithMetadata(rec array.Record, meta arrow.Metadata) error {
 	schema := rec.Schema()
 	if schema == nil || !schema.Equal(f.schema) {
 		return errInconsistentSchema
@@ -297,6 +380,8 @@ func (f *FileWriter) Write(rec array.Record) error {
 	)
 	defer data.Release()
 
+	enc.custom = meta
+
 	if err := enc.Encode(&data, rec); err != nil {
 		return xerrors.Errorf("arrow/ipc: could not encode record to payload: %w", err)
 	}
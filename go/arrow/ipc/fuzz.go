@@ -0,0 +1,62 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build gofuzz
+
+package ipc // import "github.com/apache/arrow/go/arrow/ipc"
+
+import (
+	"bytes"
+
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+// Fuzz is the go-fuzz entry point for the IPC readers.
+// Inputs starting with the Arrow file magic are decoded with NewFileReader,
+// all the others with NewReader.
+// The seed corpus lives under testdata/fuzz/corpus.
+func Fuzz(data []byte) int {
+	mem := memory.NewGoAllocator()
+
+	if bytes.HasPrefix(data, Magic) {
+		r, err := NewFileReader(bytes.NewReader(data), WithAllocator(mem))
+		if err != nil {
+			return 0
+		}
+		defer r.Close()
+
+		for i := 0; i < r.NumRecords(); i++ {
+			_, err := r.Record(i)
+			if err != nil {
+				return 0
+			}
+		}
+		return 1
+	}
+
+	r, err := NewReader(bytes.NewReader(data), WithAllocator(mem))
+	if err != nil {
+		return 0
+	}
+	defer r.Release()
+
+	for r.Next() {
+	}
+	if r.Err() != nil {
+		return 0
+	}
+	return 1
+}
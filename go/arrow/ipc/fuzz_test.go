@@ -0,0 +1,231 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ipc_test
+
+import (
+	"bytes"
+	"flag"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/internal/arrdata"
+	"github.com/apache/arrow/go/arrow/ipc"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+var updateCorpus = flag.Bool("update-corpus", false, "regenerate the fuzz seed corpus under testdata/fuzz/corpus")
+
+const corpusDir = "testdata/fuzz/corpus"
+
+// decode reads all the records from data, using a file reader when data
+// carries the Arrow file magic and a stream reader otherwise.
+func decode(data []byte) error {
+	mem := memory.NewGoAllocator()
+
+	if bytes.HasPrefix(data, ipc.Magic) {
+		r, err := ipc.NewFileReader(bytes.NewReader(data), ipc.WithAllocator(mem))
+		if err != nil {
+			return err
+		}
+		defer r.Close()
+
+		for i := 0; i < r.NumRecords(); i++ {
+			_, err := r.Record(i)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	r, err := ipc.NewReader(bytes.NewReader(data), ipc.WithAllocator(mem))
+	if err != nil {
+		return err
+	}
+	defer r.Release()
+
+	for r.Next() {
+	}
+	return r.Err()
+}
+
+func writeCorpus(t *testing.T) {
+	t.Helper()
+
+	err := os.MkdirAll(corpusDir, 0755)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mem := memory.NewGoAllocator()
+	for name, recs := range arrdata.Records {
+		for _, kind := range []string{"file", "stream"} {
+			f, err := os.Create(filepath.Join(corpusDir, name+"."+kind))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			switch kind {
+			case "file":
+				arrdata.WriteFile(t, f, mem, recs[0].Schema(), recs)
+			case "stream":
+				arrdata.WriteStream(t, f, mem, recs[0].Schema(), recs)
+			}
+
+			err = f.Close()
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+}
+
+func loadCorpus(t *testing.T) map[string][]byte {
+	t.Helper()
+
+	if *updateCorpus {
+		writeCorpus(t)
+	}
+
+	files, err := ioutil.ReadDir(corpusDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	corpus := make(map[string][]byte, len(files))
+	for _, f := range files {
+		raw, err := ioutil.ReadFile(filepath.Join(corpusDir, f.Name()))
+		if err != nil {
+			t.Fatal(err)
+		}
+		corpus[f.Name()] = raw
+	}
+
+	if len(corpus) == 0 {
+		t.Fatalf("empty fuzz corpus")
+	}
+	return corpus
+}
+
+func TestFuzzCorpus(t *testing.T) {
+	corpus := loadCorpus(t)
+
+	names := make([]string, 0, len(corpus))
+	for name := range corpus {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		raw := corpus[name]
+		t.Run(name, func(t *testing.T) {
+			err := decode(raw)
+			if err != nil {
+				t.Fatalf("could not decode seed: %v", err)
+			}
+
+			// truncated inputs.
+			for n := 0; n < len(raw); n += 1 + n/16 {
+				_ = decode(raw[:n])
+				if strings.HasSuffix(name, ".file") {
+					// keep the trailing magic so the footer gets decoded.
+					_ = decode(append(append([]byte(nil), raw[:n]...), raw[len(raw)-10:]...))
+				}
+			}
+
+			// corrupted inputs.
+			buf := make([]byte, len(raw))
+			for i := range raw {
+				for _, v := range []byte{0x00, 0x01, 0x7f, 0x80, 0xff} {
+					copy(buf, raw)
+					if buf[i] == v {
+						continue
+					}
+					buf[i] = v
+					_ = decode(buf)
+				}
+			}
+		})
+	}
+}
+
+func TestInvalidRecordIndex(t *testing.T) {
+	raw := loadCorpus(t)["primitives.file"]
+
+	r, err := ipc.NewFileReader(bytes.NewReader(raw))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+
+	for _, i := range []int{-1, r.NumRecords()} {
+		_, err := r.Record(i)
+		if err == nil {
+			t.Fatalf("expected an error reading record %d", i)
+		}
+	}
+}
+
+func TestInvalidOffsets(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	bldr := array.NewStringBuilder(mem)
+	defer bldr.Release()
+
+	bldr.AppendValues([]string{"a", "bb", "ccc"}, nil)
+	arr := bldr.NewStringArray()
+	defer arr.Release()
+
+	// offsets are [0, 1, 3, 6]: make them non-monotonic.
+	offsets := arr.Data().Buffers()[1].Bytes()
+	offsets[4], offsets[8] = 3, 1
+
+	schema := arrow.NewSchema([]arrow.Field{{Name: "s", Type: arrow.BinaryTypes.String}}, nil)
+	rec := array.NewRecord(schema, []array.Interface{arr}, 3)
+	defer rec.Release()
+
+	var buf bytes.Buffer
+	w := ipc.NewWriter(&buf, ipc.WithSchema(rec.Schema()), ipc.WithAllocator(mem))
+	err := w.Write(rec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = w.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := ipc.NewReader(&buf, ipc.WithAllocator(mem))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Release()
+
+	if r.Next() {
+		t.Fatalf("expected an error")
+	}
+	if err := r.Err(); err == nil || !strings.Contains(err.Error(), "offsets not monotonic") {
+		t.Fatalf("invalid error: %v", err)
+	}
+}
@@ -17,11 +17,13 @@
 package ipc // import "github.com/apache/arrow/go/arrow/ipc"
 
 import (
+	"context"
 	"io"
 
 	"github.com/apache/arrow/go/arrow"
 	"github.com/apache/arrow/go/arrow/arrio"
 	"github.com/apache/arrow/go/arrow/memory"
+	"golang.org/x/xerrors"
 )
 
 const (
@@ -53,6 +55,22 @@ func (s errString) Error() string {
 	return string(s)
 }
 
+// recoverDecodeError converts a panic raised while decoding corrupted
+// flatbuffer data into an error.
+// It must be deferred by the function whose error result is err.
+func recoverDecodeError(err *error) {
+	e := recover()
+	if e == nil {
+		return
+	}
+	switch e := e.(type) {
+	case error:
+		*err = xerrors.Errorf("arrow/ipc: invalid flatbuffer data: %w", e)
+	default:
+		*err = xerrors.Errorf("arrow/ipc: invalid flatbuffer data: %v", e)
+	}
+}
+
 type ReadAtSeeker interface {
 	io.Reader
 	io.Seeker
@@ -65,11 +83,15 @@ type config struct {
 	footer struct {
 		offset int64
 	}
+	ctx      context.Context
+	prefetch int
+	proj     *arrow.Schema
 }
 
 func newConfig(opts ...Option) *config {
 	cfg := &config{
 		alloc: memory.NewGoAllocator(),
+		ctx:   context.Background(),
 	}
 
 	for _, opt := range opts {
@@ -104,6 +126,44 @@ func WithSchema(schema *arrow.Schema) Option {
 	}
 }
 
+// WithProjection specifies a schema readers conform each record to, as
+// defined by array.ProjectRecord. Readers then report that schema.
+// WithProjection allows to read files and streams whose schema evolved,
+// with added, removed, reordered or promoted columns.
+func WithProjection(schema *arrow.Schema) Option {
+	return func(cfg *config) {
+		cfg.proj = schema
+	}
+}
+
+// WithContext specifies the context used while reading records.
+// Once the context is cancelled, readers stop reading from the underlying
+// stream or file and report the context error.
+func WithContext(ctx context.Context) Option {
+	return func(cfg *config) {
+		cfg.ctx = ctx
+	}
+}
+
+// WithPrefetch specifies the number of records readers decode ahead of
+// time on background goroutines, overlapping I/O and decoding with the
+// processing of the current record.
+//
+// A Reader reads and decodes up to n messages ahead of the current one.
+// A FileReader decodes up to n records concurrently when iterated with Read,
+// delivering them in order.
+// At most n records are held in memory besides the current one.
+//
+// With a FileReader, the underlying ReadAtSeeker is then read with ReadAt
+// from several goroutines at once: it must support concurrent calls to
+// ReadAt, as required by io.ReaderAt and provided by *os.File and
+// *bytes.Reader, and must not be used by the caller while records are read.
+func WithPrefetch(n int) Option {
+	return func(cfg *config) {
+		cfg.prefetch = n
+	}
+}
+
 var (
 	_ arrio.Reader = (*Reader)(nil)
 	_ arrio.Writer = (*Writer)(nil)
@@ -17,11 +17,13 @@
 package ipc // import "github.com/apache/arrow/go/arrow/ipc"
 
 import (
+	"bytes"
 	"encoding/binary"
 	"fmt"
 	"io"
 	"sync/atomic"
 
+	"github.com/apache/arrow/go/arrow"
 	"github.com/apache/arrow/go/arrow/internal/debug"
 	"github.com/apache/arrow/go/arrow/internal/flatbuf"
 	"github.com/apache/arrow/go/arrow/memory"
@@ -141,6 +143,12 @@ func (msg *Message) BodyLen() int64 {
 	return msg.msg.BodyLength()
 }
 
+// Metadata returns the custom key-value metadata attached to the message.
+func (msg *Message) Metadata() (meta arrow.Metadata, err error) {
+	defer recoverDecodeError(&err)
+	return metadataFromFB(msg.msg)
+}
+
 // MessageReader reads messages from an io.Reader.
 type MessageReader struct {
 	r io.Reader
@@ -177,9 +185,11 @@ func (r *MessageReader) Release() {
 // Message returns the current message that has been extracted from the
 // underlying stream.
 // It is valid until the next call to Message.
-func (r *MessageReader) Message() (*Message, error) {
+func (r *MessageReader) Message() (msg *Message, err error) {
+	defer recoverDecodeError(&err)
+
 	var buf = make([]byte, 4)
-	_, err := io.ReadFull(r.r, buf)
+	_, err = io.ReadFull(r.r, buf)
 	if err != nil {
 		return nil, xerrors.Errorf("arrow/ipc: could not read continuation indicator: %w", err)
 	}
@@ -208,17 +218,22 @@ func (r *MessageReader) Message() (*Message, error) {
 		msgLen = int32(cid)
 	}
 
-	buf = make([]byte, msgLen)
-	_, err = io.ReadFull(r.r, buf)
+	if msgLen < 4 {
+		return nil, xerrors.Errorf("arrow/ipc: invalid message metadata length (%d)", msgLen)
+	}
+
+	buf, err = readBytes(r.r, int64(msgLen))
 	if err != nil {
 		return nil, xerrors.Errorf("arrow/ipc: could not read message metadata: %w", err)
 	}
 
 	meta := flatbuf.GetRootAsMessage(buf, 0)
 	bodyLen := meta.BodyLength()
+	if bodyLen < 0 {
+		return nil, xerrors.Errorf("arrow/ipc: invalid message body length (%d)", bodyLen)
+	}
 
-	buf = make([]byte, bodyLen)
-	_, err = io.ReadFull(r.r, buf)
+	buf, err = readBytes(r.r, bodyLen)
 	if err != nil {
 		return nil, xerrors.Errorf("arrow/ipc: could not read message body: %w", err)
 	}
@@ -232,3 +247,22 @@ func (r *MessageReader) Message() (*Message, error) {
 
 	return r.msg, nil
 }
+
+// readBytes reads exactly n bytes from r.
+// Large reads grow their buffer as data arrives, so that a corrupted length
+// prefix cannot trigger an arbitrarily large allocation up-front.
+func readBytes(r io.Reader, n int64) ([]byte, error) {
+	const chunk = 1 << 20
+	if n <= chunk {
+		buf := make([]byte, n)
+		_, err := io.ReadFull(r, buf)
+		return buf, err
+	}
+
+	var buf bytes.Buffer
+	_, err := io.CopyN(&buf, r, n)
+	if err == io.EOF {
+		err = io.ErrUnexpectedEOF
+	}
+	return buf.Bytes(), err
+}
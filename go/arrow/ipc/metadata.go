@@ -82,6 +82,10 @@ func (blk fileBlock) NewMessage() (*Message, error) {
 		r   = blk.section()
 	)
 
+	if blk.Meta < 4 || blk.Body < 0 {
+		return nil, xerrors.Errorf("arrow/ipc: invalid block sizes (meta=%d, body=%d)", blk.Meta, blk.Body)
+	}
+
 	buf = make([]byte, blk.Meta)
 	_, err = io.ReadFull(r, buf)
 	if err != nil {
@@ -99,6 +103,10 @@ func (blk fileBlock) NewMessage() (*Message, error) {
 		prefix = 4
 	}
 
+	if len(buf)-prefix < 4 {
+		return nil, xerrors.Errorf("arrow/ipc: message metadata too small (size=%d)", blk.Meta)
+	}
+
 	meta := memory.NewBufferBytes(buf[prefix:]) // drop buf-size already known from blk.Meta
 
 	buf = make([]byte, blk.Body)
@@ -111,22 +119,34 @@ func (blk fileBlock) NewMessage() (*Message, error) {
 	return NewMessage(meta, body), nil
 }
 
+// within returns whether the block lies entirely within the first size bytes
+// of the underlying reader.
+func (blk fileBlock) within(size int64) bool {
+	switch {
+	case blk.Offset < 0 || blk.Meta < 0 || blk.Body < 0:
+		return false
+	case blk.Offset > size || int64(blk.Meta) > size-blk.Offset:
+		return false
+	}
+	return blk.Body <= size-blk.Offset-int64(blk.Meta)
+}
+
 func (blk fileBlock) section() io.Reader {
 	return io.NewSectionReader(blk.r, blk.Offset, int64(blk.Meta)+blk.Body)
 }
 
-func unitFromFB(unit flatbuf.TimeUnit) arrow.TimeUnit {
+func unitFromFB(unit flatbuf.TimeUnit) (arrow.TimeUnit, error) {
 	switch unit {
 	case flatbuf.TimeUnitSECOND:
-		return arrow.Second
+		return arrow.Second, nil
 	case flatbuf.TimeUnitMILLISECOND:
-		return arrow.Millisecond
+		return arrow.Millisecond, nil
 	case flatbuf.TimeUnitMICROSECOND:
-		return arrow.Microsecond
+		return arrow.Microsecond, nil
 	case flatbuf.TimeUnitNANOSECOND:
-		return arrow.Nanosecond
+		return arrow.Nanosecond, nil
 	default:
-		panic(xerrors.Errorf("arrow/ipc: invalid flatbuf.TimeUnit(%d) value", unit))
+		return 0, xerrors.Errorf("arrow/ipc: invalid flatbuf.TimeUnit(%d) value", unit)
 	}
 }
 
@@ -145,24 +165,39 @@ func unitToFB(unit arrow.TimeUnit) flatbuf.TimeUnit {
 	}
 }
 
+// checkVectorLen checks a flatbuffer vector of n tables or strings, referenced
+// from the given table, could fit within the underlying flatbuffer data.
+// It protects allocations sized after vector lengths read from untrusted input.
+func checkVectorLen(tbl flatbuffers.Table, n int) error {
+	if n < 0 || int64(n)*flatbuffers.SizeUOffsetT > int64(len(tbl.Bytes)) {
+		return xerrors.Errorf("arrow/ipc: invalid flatbuffer vector length (%d)", n)
+	}
+	return nil
+}
+
 // initFB is a helper function to handle flatbuffers' polymorphism.
 func initFB(t interface {
 	Table() flatbuffers.Table
 	Init([]byte, flatbuffers.UOffsetT)
-}, f func(tbl *flatbuffers.Table) bool) {
+}, f func(tbl *flatbuffers.Table) bool) error {
 	tbl := t.Table()
 	if !f(&tbl) {
-		panic(xerrors.Errorf("arrow/ipc: could not initialize %T from flatbuffer", t))
+		return xerrors.Errorf("arrow/ipc: could not initialize %T from flatbuffer", t)
 	}
 	t.Init(tbl.Bytes, tbl.Pos)
+	return nil
 }
 
-func fieldFromFB(field *flatbuf.Field, memo *dictMemo) (arrow.Field, error) {
+func fieldFromFB(field *flatbuf.Field, memo *dictMemo, max int) (arrow.Field, error) {
 	var (
 		err error
 		o   arrow.Field
 	)
 
+	if max == 0 {
+		return o, errMaxRecursion
+	}
+
 	o.Name = string(field.Name())
 	o.Nullable = field.Nullable()
 	o.Metadata, err = metadataFromFB(field)
@@ -174,13 +209,17 @@ func fieldFromFB(field *flatbuf.Field, memo *dictMemo) (arrow.Field, error) {
 	switch encoding {
 	case nil:
 		n := field.ChildrenLength()
+		err = checkVectorLen(field.Table(), n)
+		if err != nil {
+			return o, err
+		}
 		children := make([]arrow.Field, n)
 		for i := range children {
 			var childFB flatbuf.Field
 			if !field.Children(&childFB, i) {
 				return o, xerrors.Errorf("arrow/ipc: could not load field child %d", i)
 			}
-			child, err := fieldFromFB(&childFB, memo)
+			child, err := fieldFromFB(&childFB, memo, max-1)
 			if err != nil {
 				return o, xerrors.Errorf("arrow/ipc: could not convert field child %d: %w", i, err)
 			}
@@ -192,7 +231,8 @@ func fieldFromFB(field *flatbuf.Field, memo *dictMemo) (arrow.Field, error) {
 			return o, xerrors.Errorf("arrow/ipc: could not convert field type: %w", err)
 		}
 	default:
-		panic("not implemented") // FIXME(sbinet)
+		// FIXME(sbinet): implement dictionary-encoded fields.
+		return o, xerrors.Errorf("arrow/ipc: dictionary-encoded field %q not implemented", o.Name)
 	}
 
 	return o, nil
@@ -292,6 +332,16 @@ func (fv *fieldVisitor) visit(field arrow.Field) {
 		flatbuf.Utf8Start(fv.b)
 		fv.offset = flatbuf.Utf8End(fv.b)
 
+	case *arrow.LargeBinaryType:
+		fv.dtype = flatbuf.TypeLargeBinary
+		flatbuf.LargeBinaryStart(fv.b)
+		fv.offset = flatbuf.LargeBinaryEnd(fv.b)
+
+	case *arrow.LargeStringType:
+		fv.dtype = flatbuf.TypeLargeUtf8
+		flatbuf.LargeUtf8Start(fv.b)
+		fv.offset = flatbuf.LargeUtf8End(fv.b)
+
 	case *arrow.Date32Type:
 		fv.dtype = flatbuf.TypeDate
 		flatbuf.DateStart(fv.b)
@@ -349,6 +399,12 @@ func (fv *fieldVisitor) visit(field arrow.Field) {
 		flatbuf.ListStart(fv.b)
 		fv.offset = flatbuf.ListEnd(fv.b)
 
+	case *arrow.LargeListType:
+		fv.dtype = flatbuf.TypeLargeList
+		fv.kids = append(fv.kids, fieldToFB(fv.b, arrow.Field{Name: "item", Type: dt.Elem(), Nullable: field.Nullable}, fv.memo))
+		flatbuf.LargeListStart(fv.b)
+		fv.offset = flatbuf.LargeListEnd(fv.b)
+
 	case *arrow.FixedSizeListType:
 		fv.dtype = flatbuf.TypeFixedSizeList
 		fv.kids = append(fv.kids, fieldToFB(fv.b, arrow.Field{Name: "item", Type: dt.Elem(), Nullable: field.Nullable}, fv.memo))
@@ -448,7 +504,7 @@ func (fv *fieldVisitor) result(field arrow.Field) flatbuffers.UOffsetT {
 	return offset
 }
 
-func fieldFromFBDict(field *flatbuf.Field) (arrow.Field, error) {
+func fieldFromFBDict(field *flatbuf.Field, max int) (arrow.Field, error) {
 	var (
 		o = arrow.Field{
 			Name:     string(field.Name()),
@@ -460,13 +516,18 @@ func fieldFromFBDict(field *flatbuf.Field) (arrow.Field, error) {
 
 	// any DictionaryEncoding set is ignored here.
 
+	err = checkVectorLen(field.Table(), field.ChildrenLength())
+	if err != nil {
+		return o, err
+	}
+
 	kids := make([]arrow.Field, field.ChildrenLength())
 	for i := range kids {
 		var kid flatbuf.Field
 		if !field.Children(&kid, i) {
 			return o, xerrors.Errorf("arrow/ipc: could not load field child %d", i)
 		}
-		kids[i], err = fieldFromFB(&kid, &memo)
+		kids[i], err = fieldFromFB(&kid, &memo, max-1)
 		if err != nil {
 			return o, xerrors.Errorf("arrow/ipc: field from dict: %w", err)
 		}
@@ -503,7 +564,8 @@ func typeFromFB(field *flatbuf.Field, children []arrow.Field, md arrow.Metadata)
 			return dt, err
 		}
 
-		panic("not implemented") // FIXME(sbinet)
+		// FIXME(sbinet): implement extension types.
+		return nil, xerrors.Errorf("arrow/ipc: extension type %q not implemented", md.Values()[i])
 	}
 
 	return dt, err
@@ -543,11 +605,20 @@ func concreteTypeFromFB(typ flatbuf.Type, data flatbuffers.Table, children []arr
 	case flatbuf.TypeFixedSizeBinary:
 		var dt flatbuf.FixedSizeBinary
 		dt.Init(data.Bytes, data.Pos)
+		if dt.ByteWidth() < 0 {
+			return nil, xerrors.Errorf("arrow/ipc: invalid FixedSizeBinary byte width (%d)", dt.ByteWidth())
+		}
 		return &arrow.FixedSizeBinaryType{ByteWidth: int(dt.ByteWidth())}, nil
 
 	case flatbuf.TypeUtf8:
 		return arrow.BinaryTypes.String, nil
 
+	case flatbuf.TypeLargeBinary:
+		return arrow.BinaryTypes.LargeBinary, nil
+
+	case flatbuf.TypeLargeUtf8:
+		return arrow.BinaryTypes.LargeString, nil
+
 	case flatbuf.TypeBool:
 		return arrow.FixedWidthTypes.Boolean, nil
 
@@ -557,12 +628,21 @@ func concreteTypeFromFB(typ flatbuf.Type, data flatbuffers.Table, children []arr
 		}
 		return arrow.ListOf(children[0].Type), nil
 
+	case flatbuf.TypeLargeList:
+		if len(children) != 1 {
+			return nil, xerrors.Errorf("arrow/ipc: LargeList must have exactly 1 child field (got=%d)", len(children))
+		}
+		return arrow.LargeListOf(children[0].Type), nil
+
 	case flatbuf.TypeFixedSizeList:
 		var dt flatbuf.FixedSizeList
 		dt.Init(data.Bytes, data.Pos)
 		if len(children) != 1 {
 			return nil, xerrors.Errorf("arrow/ipc: FixedSizeList must have exactly 1 child field (got=%d)", len(children))
 		}
+		if dt.ListSize() < 0 {
+			return nil, xerrors.Errorf("arrow/ipc: invalid FixedSizeList size (%d)", dt.ListSize())
+		}
 		return arrow.FixedSizeListOf(dt.ListSize(), children[0].Type), nil
 
 	case flatbuf.TypeStruct_:
@@ -595,7 +675,10 @@ func concreteTypeFromFB(typ flatbuf.Type, data flatbuffers.Table, children []arr
 
 	default:
 		// FIXME(sbinet): implement all the other types.
-		panic(xerrors.Errorf("arrow/ipc: type %v not implemented", flatbuf.EnumNamesType[typ]))
+		if name, ok := flatbuf.EnumNamesType[typ]; ok {
+			return nil, xerrors.Errorf("arrow/ipc: type %v not implemented", name)
+		}
+		return nil, xerrors.Errorf("arrow/ipc: invalid type %d", typ)
 	}
 
 	return dt, err
@@ -684,7 +767,10 @@ func decimalFromFB(data flatbuf.Decimal) (arrow.DataType, error) {
 
 func timeFromFB(data flatbuf.Time) (arrow.DataType, error) {
 	bw := data.BitWidth()
-	unit := unitFromFB(data.Unit())
+	unit, err := unitFromFB(data.Unit())
+	if err != nil {
+		return nil, err
+	}
 
 	switch bw {
 	case 32:
@@ -711,7 +797,10 @@ func timeFromFB(data flatbuf.Time) (arrow.DataType, error) {
 }
 
 func timestampFromFB(data flatbuf.Timestamp) (arrow.DataType, error) {
-	unit := unitFromFB(data.Unit())
+	unit, err := unitFromFB(data.Unit())
+	if err != nil {
+		return nil, err
+	}
 	tz := string(data.Timezone())
 	return &arrow.TimestampType{Unit: unit, TimeZone: tz}, nil
 }
@@ -751,11 +840,17 @@ func durationFromFB(data flatbuf.Duration) (arrow.DataType, error) {
 }
 
 type customMetadataer interface {
+	Table() flatbuffers.Table
 	CustomMetadataLength() int
 	CustomMetadata(*flatbuf.KeyValue, int) bool
 }
 
 func metadataFromFB(md customMetadataer) (arrow.Metadata, error) {
+	err := checkVectorLen(md.Table(), md.CustomMetadataLength())
+	if err != nil {
+		return arrow.Metadata{}, err
+	}
+
 	var (
 		keys = make([]string, md.CustomMetadataLength())
 		vals = make([]string, md.CustomMetadataLength())
@@ -797,10 +892,12 @@ func metadataToFB(b *flatbuffers.Builder, meta arrow.Metadata, start startVecFun
 }
 
 func schemaFromFB(schema *flatbuf.Schema, memo *dictMemo) (*arrow.Schema, error) {
-	var (
-		err    error
-		fields = make([]arrow.Field, schema.FieldsLength())
-	)
+	err := checkVectorLen(schema.Table(), schema.FieldsLength())
+	if err != nil {
+		return nil, err
+	}
+
+	fields := make([]arrow.Field, schema.FieldsLength())
 
 	for i := range fields {
 		var field flatbuf.Field
@@ -808,7 +905,7 @@ func schemaFromFB(schema *flatbuf.Schema, memo *dictMemo) (*arrow.Schema, error)
 			return nil, xerrors.Errorf("arrow/ipc: could not read field %d from schema", i)
 		}
 
-		fields[i], err = fieldFromFB(&field, memo)
+		fields[i], err = fieldFromFB(&field, memo, kMaxNestingDepth)
 		if err != nil {
 			return nil, xerrors.Errorf("arrow/ipc: could not convert field %d from flatbuf: %w", i, err)
 		}
@@ -846,16 +943,18 @@ func schemaToFB(b *flatbuffers.Builder, schema *arrow.Schema, memo *dictMemo) fl
 }
 
 func dictTypesFromFB(schema *flatbuf.Schema) (dictTypeMap, error) {
-	var (
-		err    error
-		fields = make(dictTypeMap, schema.FieldsLength())
-	)
+	err := checkVectorLen(schema.Table(), schema.FieldsLength())
+	if err != nil {
+		return nil, err
+	}
+
+	fields := make(dictTypeMap, schema.FieldsLength())
 	for i := 0; i < schema.FieldsLength(); i++ {
 		var field flatbuf.Field
 		if !schema.Fields(&field, i) {
 			return nil, xerrors.Errorf("arrow/ipc: could not load field %d from schema", i)
 		}
-		fields, err = visitField(&field, fields)
+		fields, err = visitField(&field, fields, kMaxNestingDepth)
 		if err != nil {
 			return nil, xerrors.Errorf("arrow/ipc: could not visit field %d from schema: %w", i, err)
 		}
@@ -863,7 +962,11 @@ func dictTypesFromFB(schema *flatbuf.Schema) (dictTypeMap, error) {
 	return fields, err
 }
 
-func visitField(field *flatbuf.Field, dict dictTypeMap) (dictTypeMap, error) {
+func visitField(field *flatbuf.Field, dict dictTypeMap, max int) (dictTypeMap, error) {
+	if max == 0 {
+		return nil, errMaxRecursion
+	}
+
 	var err error
 	meta := field.Dictionary(nil)
 	switch meta {
@@ -875,7 +978,7 @@ func visitField(field *flatbuf.Field, dict dictTypeMap) (dictTypeMap, error) {
 			if !field.Children(&child, i) {
 				return nil, xerrors.Errorf("arrow/ipc: could not visit child %d from field", i)
 			}
-			dict, err = visitField(&child, dict)
+			dict, err = visitField(&child, dict, max-1)
 			if err != nil {
 				return nil, err
 			}
@@ -883,7 +986,7 @@ func visitField(field *flatbuf.Field, dict dictTypeMap) (dictTypeMap, error) {
 	default:
 		// field is dictionary encoded.
 		// construct the data type for the dictionary: no descendants can be dict-encoded.
-		dfield, err := fieldFromFBDict(field)
+		dfield, err := fieldFromFBDict(field, max)
 		if err != nil {
 			return nil, xerrors.Errorf("arrow/ipc: could not create data type for dictionary: %w", err)
 		}
@@ -924,13 +1027,16 @@ func writeFBBuilder(b *flatbuffers.Builder, mem memory.Allocator) *memory.Buffer
 	return buf
 }
 
-func writeMessageFB(b *flatbuffers.Builder, mem memory.Allocator, hdrType flatbuf.MessageHeader, hdr flatbuffers.UOffsetT, bodyLen int64) *memory.Buffer {
+func writeMessageFB(b *flatbuffers.Builder, mem memory.Allocator, hdrType flatbuf.MessageHeader, hdr flatbuffers.UOffsetT, bodyLen int64, meta arrow.Metadata) *memory.Buffer {
+
+	metaFB := metadataToFB(b, meta, flatbuf.MessageStartCustomMetadataVector)
 
 	flatbuf.MessageStart(b)
 	flatbuf.MessageAddVersion(b, int16(currentMetadataVersion))
 	flatbuf.MessageAddHeaderType(b, hdrType)
 	flatbuf.MessageAddHeader(b, hdr)
 	flatbuf.MessageAddBodyLength(b, bodyLen)
+	flatbuf.MessageAddCustomMetadata(b, metaFB)
 	msg := flatbuf.MessageEnd(b)
 	b.Finish(msg)
 
@@ -940,7 +1046,7 @@ func writeMessageFB(b *flatbuffers.Builder, mem memory.Allocator, hdrType flatbu
 func writeSchemaMessage(schema *arrow.Schema, mem memory.Allocator, dict *dictMemo) *memory.Buffer {
 	b := flatbuffers.NewBuilder(1024)
 	schemaFB := schemaToFB(b, schema, dict)
-	return writeMessageFB(b, mem, flatbuf.MessageHeaderSchema, schemaFB, 0)
+	return writeMessageFB(b, mem, flatbuf.MessageHeaderSchema, schemaFB, 0, arrow.Metadata{})
 }
 
 func writeFileFooter(schema *arrow.Schema, dicts, recs []fileBlock, w io.Writer) error {
@@ -966,10 +1072,10 @@ func writeFileFooter(schema *arrow.Schema, dicts, recs []fileBlock, w io.Writer)
 	return err
 }
 
-func writeRecordMessage(mem memory.Allocator, size, bodyLength int64, fields []fieldMetadata, meta []bufferMetadata) *memory.Buffer {
+func writeRecordMessage(mem memory.Allocator, size, bodyLength int64, fields []fieldMetadata, meta []bufferMetadata, custom arrow.Metadata) *memory.Buffer {
 	b := flatbuffers.NewBuilder(0)
 	recFB := recordToFB(b, size, bodyLength, fields, meta)
-	return writeMessageFB(b, mem, flatbuf.MessageHeaderRecordBatch, recFB, bodyLength)
+	return writeMessageFB(b, mem, flatbuf.MessageHeaderRecordBatch, recFB, bodyLength, custom)
 }
 
 func recordToFB(b *flatbuffers.Builder, size, bodyLength int64, fields []fieldMetadata, meta []bufferMetadata) flatbuffers.UOffsetT {
@@ -0,0 +1,179 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ipc // import "github.com/apache/arrow/go/arrow/ipc"
+
+import (
+	"context"
+	"io"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+)
+
+// prefetched is a record decoded ahead of time by a background goroutine.
+type prefetched struct {
+	rec  array.Record
+	meta arrow.Metadata
+	err  error
+}
+
+func (p prefetched) release() {
+	if p.rec != nil {
+		p.rec.Release()
+	}
+}
+
+// startPrefetch starts a goroutine reading and decoding up to n messages
+// ahead of the consumer of the Reader.
+func (r *Reader) startPrefetch(n int) {
+	var ctx context.Context
+	ctx, r.cancel = context.WithCancel(r.ctx)
+
+	// the goroutine holds one more decoded record while blocked sending it.
+	r.pipe = make(chan prefetched, n-1)
+	go func() {
+		defer close(r.pipe)
+		for {
+			var p prefetched
+			p.rec, p.meta, p.err = r.decode()
+			if p.err == io.EOF {
+				return
+			}
+
+			select {
+			case r.pipe <- p:
+			case <-ctx.Done():
+				p.release()
+				return
+			}
+
+			if p.err != nil {
+				return
+			}
+		}
+	}()
+}
+
+func (r *Reader) nextPrefetched() bool {
+	select {
+	case <-r.ctx.Done():
+		r.err = r.ctx.Err()
+		return false
+	case p, ok := <-r.pipe:
+		if !ok {
+			r.done = true
+			return false
+		}
+		if p.err != nil {
+			r.err = p.err
+			return false
+		}
+		r.rec, r.meta = p.rec, p.meta
+		return true
+	}
+}
+
+// stopPrefetch stops the prefetching goroutine, if any, and releases the
+// records it decoded but were not consumed.
+// stopPrefetch waits for the read in progress to complete.
+func (r *Reader) stopPrefetch() {
+	if r.pipe == nil {
+		return
+	}
+	r.cancel()
+	for p := range r.pipe {
+		p.release()
+	}
+	r.pipe = nil
+}
+
+// startPrefetch starts a goroutine dispatching the decoding of the records
+// following the current one, with at most n records decoded concurrently
+// or waiting to be consumed.
+// The records are read with concurrent calls to ReadAt, as documented by
+// WithPrefetch.
+func (f *FileReader) startPrefetch() {
+	var ctx context.Context
+	ctx, f.cancel = context.WithCancel(f.ctx)
+
+	f.pipe = make(chan chan prefetched, f.prefetch)
+	go func() {
+		defer close(f.pipe)
+		for i := f.irec; i < f.NumRecords(); i++ {
+			res := make(chan prefetched, 1)
+			select {
+			case f.pipe <- res:
+			case <-ctx.Done():
+				return
+			}
+
+			go func(i int) {
+				var p prefetched
+				p.rec, p.meta, p.err = f.recordAt(i)
+				res <- p
+			}(i)
+		}
+	}()
+}
+
+func (f *FileReader) readPrefetched() (array.Record, error) {
+	if f.pipe == nil {
+		f.startPrefetch()
+	}
+
+	if f.record != nil {
+		f.record.Release()
+		f.record = nil
+	}
+
+	var p prefetched
+	select {
+	case <-f.ctx.Done():
+		return nil, f.ctx.Err()
+	case res, ok := <-f.pipe:
+		if !ok {
+			// the dispatching goroutine only stops early on cancellation.
+			return nil, f.ctx.Err()
+		}
+		p = <-res
+	}
+
+	f.irec++
+	if p.err != nil {
+		f.err = p.err
+		return nil, p.err
+	}
+
+	f.record = p.rec
+	f.meta = p.meta
+	return f.record, nil
+}
+
+// stopPrefetch stops the dispatching goroutine, if any, and releases the
+// records decoded ahead of time but not consumed.
+// stopPrefetch waits for the decoding in progress to complete.
+func (f *FileReader) stopPrefetch() {
+	if f.pipe == nil {
+		return
+	}
+	f.cancel()
+	for res := range f.pipe {
+		p := <-res
+		p.release()
+	}
+	f.pipe = nil
+}
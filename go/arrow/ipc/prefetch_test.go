@@ -0,0 +1,244 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ipc_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/internal/arrdata"
+	"github.com/apache/arrow/go/arrow/ipc"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+func TestStreamPrefetch(t *testing.T) {
+	for name, recs := range arrdata.Records {
+		t.Run(name, func(t *testing.T) {
+			for _, n := range []int{1, 2, 10} {
+				mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+
+				var buf bytes.Buffer
+				w := ipc.NewWriter(&buf, ipc.WithSchema(recs[0].Schema()), ipc.WithAllocator(mem))
+				for _, rec := range recs {
+					err := w.Write(rec)
+					if err != nil {
+						t.Fatal(err)
+					}
+				}
+				err := w.Close()
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				r, err := ipc.NewReader(&buf, ipc.WithAllocator(mem), ipc.WithPrefetch(n))
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				i := 0
+				for r.Next() {
+					if !array.RecordEqual(r.Record(), recs[i]) {
+						t.Fatalf("prefetch=%d: records[%d] differ", n, i)
+					}
+					i++
+				}
+				if err := r.Err(); err != nil {
+					t.Fatalf("prefetch=%d: %v", n, err)
+				}
+				if i != len(recs) {
+					t.Fatalf("prefetch=%d: invalid number of records. got=%d, want=%d", n, i, len(recs))
+				}
+
+				r.Release()
+				mem.AssertSize(t, 0)
+			}
+		})
+	}
+}
+
+func TestStreamPrefetchRelease(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	recs := arrdata.Records["primitives"]
+
+	var buf bytes.Buffer
+	w := ipc.NewWriter(&buf, ipc.WithSchema(recs[0].Schema()), ipc.WithAllocator(mem))
+	for _, rec := range recs {
+		err := w.Write(rec)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	err := w.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := ipc.NewReader(&buf, ipc.WithAllocator(mem), ipc.WithPrefetch(len(recs)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !r.Next() {
+		t.Fatalf("could not read first record: %v", r.Err())
+	}
+
+	// release the reader with records still queued.
+	r.Release()
+}
+
+func TestStreamPrefetchCancel(t *testing.T) {
+	recs := arrdata.Records["primitives"]
+
+	var buf bytes.Buffer
+	w := ipc.NewWriter(&buf, ipc.WithSchema(recs[0].Schema()))
+	for _, rec := range recs {
+		err := w.Write(rec)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	err := w.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	r, err := ipc.NewReader(&buf, ipc.WithContext(ctx), ipc.WithPrefetch(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Release()
+
+	if !r.Next() {
+		t.Fatalf("could not read first record: %v", r.Err())
+	}
+
+	cancel()
+
+	if r.Next() {
+		t.Fatalf("expected cancellation")
+	}
+	if got, want := r.Err(), context.Canceled; got != want {
+		t.Fatalf("invalid error: got=%v, want=%v", got, want)
+	}
+}
+
+func TestFilePrefetch(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "go-arrow-file-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	for name, recs := range arrdata.Records {
+		t.Run(name, func(t *testing.T) {
+			mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+			defer mem.AssertSize(t, 0)
+
+			f, err := ioutil.TempFile(tempDir, "go-arrow-file-")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer f.Close()
+
+			arrdata.WriteFile(t, f, mem, recs[0].Schema(), recs)
+
+			for _, n := range []int{1, 2, 10} {
+				r, err := ipc.NewFileReader(f, ipc.WithAllocator(mem), ipc.WithPrefetch(n))
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				i := 0
+				for {
+					rec, err := r.Read()
+					if err == io.EOF {
+						break
+					}
+					if err != nil {
+						t.Fatalf("prefetch=%d: could not read record %d: %v", n, i, err)
+					}
+					if !array.RecordEqual(rec, recs[i]) {
+						t.Fatalf("prefetch=%d: records[%d] differ", n, i)
+					}
+					i++
+				}
+				if i != len(recs) {
+					t.Fatalf("prefetch=%d: invalid number of records. got=%d, want=%d", n, i, len(recs))
+				}
+
+				err = r.Close()
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			// close the reader with records still being decoded.
+			r, err := ipc.NewFileReader(f, ipc.WithAllocator(mem), ipc.WithPrefetch(len(recs)))
+			if err != nil {
+				t.Fatal(err)
+			}
+			_, err = r.Read()
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = r.Close()
+			if err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+func TestFilePrefetchCancel(t *testing.T) {
+	f, err := ioutil.TempFile("", "go-arrow-file-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	mem := memory.NewGoAllocator()
+	recs := arrdata.Records["primitives"]
+	arrdata.WriteFile(t, f, mem, recs[0].Schema(), recs)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	r, err := ipc.NewFileReader(f, ipc.WithContext(ctx), ipc.WithPrefetch(2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+
+	_, err = r.Read()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cancel()
+
+	_, err = r.Read()
+	if got, want := err, context.Canceled; got != want {
+		t.Fatalf("invalid error: got=%v, want=%v", got, want)
+	}
+}
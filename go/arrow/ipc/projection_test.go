@@ -0,0 +1,147 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ipc_test
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/ipc"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+func TestReaderProjection(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	schema := arrow.NewSchema([]arrow.Field{
+		{Name: "i32", Type: arrow.PrimitiveTypes.Int32},
+		{Name: "str", Type: arrow.BinaryTypes.String, Nullable: true},
+	}, nil)
+
+	b := array.NewRecordBuilder(mem, schema)
+	defer b.Release()
+
+	b.Field(0).(*array.Int32Builder).AppendValues([]int32{1, 2}, nil)
+	b.Field(1).(*array.StringBuilder).AppendValues([]string{"a", ""}, []bool{true, false})
+	rec := b.NewRecord()
+	defer rec.Release()
+
+	proj := arrow.NewSchema([]arrow.Field{
+		{Name: "str", Type: arrow.BinaryTypes.String, Nullable: true},
+		{Name: "f64", Type: arrow.PrimitiveTypes.Float64, Nullable: true},
+		{Name: "i32", Type: arrow.PrimitiveTypes.Int64},
+	}, nil)
+	want := []string{`["a" (null)]`, "[(null) (null)]", "[1 2]"}
+
+	check := func(t *testing.T, got array.Record, err error) {
+		t.Helper()
+		if err != nil {
+			t.Fatalf("could not read record: %v", err)
+		}
+		if !got.Schema().Equal(proj) {
+			t.Fatalf("invalid schema: got=%v, want=%v", got.Schema(), proj)
+		}
+		for i := range want {
+			if got := fmt.Sprint(got.Column(i)); got != want[i] {
+				t.Fatalf("invalid column %d: got=%s, want=%s", i, got, want[i])
+			}
+		}
+	}
+
+	t.Run("stream", func(t *testing.T) {
+		var buf bytes.Buffer
+		w := ipc.NewWriter(&buf, ipc.WithSchema(schema), ipc.WithAllocator(mem))
+		if err := w.Write(rec); err != nil {
+			t.Fatalf("could not write record: %v", err)
+		}
+		if err := w.Close(); err != nil {
+			t.Fatalf("could not close writer: %v", err)
+		}
+
+		r, err := ipc.NewReader(&buf, ipc.WithProjection(proj), ipc.WithAllocator(mem))
+		if err != nil {
+			t.Fatalf("could not create reader: %v", err)
+		}
+		defer r.Release()
+
+		if !r.Schema().Equal(proj) {
+			t.Fatalf("invalid reader schema: got=%v, want=%v", r.Schema(), proj)
+		}
+		got, err := r.Read()
+		check(t, got, err)
+	})
+
+	t.Run("file", func(t *testing.T) {
+		f, err := ioutil.TempFile("", "go-arrow-projection-")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer os.Remove(f.Name())
+		defer f.Close()
+
+		w, err := ipc.NewFileWriter(f, ipc.WithSchema(schema), ipc.WithAllocator(mem))
+		if err != nil {
+			t.Fatalf("could not create file writer: %v", err)
+		}
+		if err := w.Write(rec); err != nil {
+			t.Fatalf("could not write record: %v", err)
+		}
+		if err := w.Close(); err != nil {
+			t.Fatalf("could not close writer: %v", err)
+		}
+
+		r, err := ipc.NewFileReader(f, ipc.WithProjection(proj), ipc.WithAllocator(mem))
+		if err != nil {
+			t.Fatalf("could not create file reader: %v", err)
+		}
+		defer r.Close()
+
+		if !r.Schema().Equal(proj) {
+			t.Fatalf("invalid reader schema: got=%v, want=%v", r.Schema(), proj)
+		}
+		got, err := r.Record(0)
+		check(t, got, err)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		var buf bytes.Buffer
+		w := ipc.NewWriter(&buf, ipc.WithSchema(schema), ipc.WithAllocator(mem))
+		if err := w.Write(rec); err != nil {
+			t.Fatalf("could not write record: %v", err)
+		}
+		if err := w.Close(); err != nil {
+			t.Fatalf("could not close writer: %v", err)
+		}
+
+		invalid := arrow.NewSchema([]arrow.Field{{Name: "missing", Type: arrow.PrimitiveTypes.Int32}}, nil)
+		r, err := ipc.NewReader(&buf, ipc.WithProjection(invalid), ipc.WithAllocator(mem))
+		if err != nil {
+			t.Fatalf("could not create reader: %v", err)
+		}
+		defer r.Release()
+
+		if _, err := r.Read(); err == nil {
+			t.Fatalf("expected an error")
+		}
+	})
+}
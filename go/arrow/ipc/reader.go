@@ -17,7 +17,7 @@
 package ipc // import "github.com/apache/arrow/go/arrow/ipc"
 
 import (
-	"bytes"
+	"context"
 	"io"
 	"sync/atomic"
 
@@ -38,14 +38,20 @@ type Reader struct {
 
 	refCount int64
 	rec      array.Record
+	meta     arrow.Metadata // custom metadata of the current record
 	err      error
 
 	types dictTypeMap
 	memo  dictMemo
 
-	mem memory.Allocator
+	mem  memory.Allocator
+	proj *arrow.Schema // schema records are projected onto, if any
 
 	done bool
+
+	ctx    context.Context
+	pipe   chan prefetched // records read ahead by the prefetching goroutine, if any
+	cancel context.CancelFunc
 }
 
 // NewReader returns a reader that reads records from an input stream.
@@ -56,10 +62,13 @@ func NewReader(r io.Reader, opts ...Option) (*Reader, error) {
 	}
 
 	rr := &Reader{
-		r:     NewMessageReader(r),
-		types: make(dictTypeMap),
-		memo:  newMemo(),
-		mem:   cfg.alloc,
+		r:        NewMessageReader(r),
+		refCount: 1,
+		types:    make(dictTypeMap),
+		memo:     newMemo(),
+		mem:      cfg.alloc,
+		proj:     cfg.proj,
+		ctx:      cfg.ctx,
 	}
 
 	err := rr.readSchema(cfg.schema)
@@ -67,6 +76,10 @@ func NewReader(r io.Reader, opts ...Option) (*Reader, error) {
 		return nil, xerrors.Errorf("arrow/ipc: could not read schema from stream: %w", err)
 	}
 
+	if cfg.prefetch > 0 {
+		rr.startPrefetch(cfg.prefetch)
+	}
+
 	return rr, nil
 }
 
@@ -74,9 +87,16 @@ func NewReader(r io.Reader, opts ...Option) (*Reader, error) {
 // underlying stream.
 func (r *Reader) Err() error { return r.err }
 
-func (r *Reader) Schema() *arrow.Schema { return r.schema }
+func (r *Reader) Schema() *arrow.Schema {
+	if r.proj != nil {
+		return r.proj
+	}
+	return r.schema
+}
+
+func (r *Reader) readSchema(schema *arrow.Schema) (err error) {
+	defer recoverDecodeError(&err)
 
-func (r *Reader) readSchema(schema *arrow.Schema) error {
 	msg, err := r.r.Message()
 	if err != nil {
 		return xerrors.Errorf("arrow/ipc: could not read message schema: %w", err)
@@ -88,7 +108,10 @@ func (r *Reader) readSchema(schema *arrow.Schema) error {
 
 	// FIXME(sbinet) refactor msg-header handling.
 	var schemaFB flatbuf.Schema
-	initFB(&schemaFB, msg.msg.Header)
+	err = initFB(&schemaFB, msg.msg.Header)
+	if err != nil {
+		return xerrors.Errorf("arrow/ipc: could not decode message schema: %w", err)
+	}
 
 	r.types, err = dictTypesFromFB(&schemaFB)
 	if err != nil {
@@ -97,8 +120,9 @@ func (r *Reader) readSchema(schema *arrow.Schema) error {
 
 	// TODO(sbinet): in the future, we may want to reconcile IDs in the stream with
 	// those found in the schema.
-	for range r.types {
-		panic("not implemented") // FIXME(sbinet): ReadNextDictionary
+	if len(r.types) > 0 {
+		// FIXME(sbinet): ReadNextDictionary
+		return xerrors.Errorf("arrow/ipc: reading dictionaries from stream not implemented")
 	}
 
 	r.schema, err = schemaFromFB(&schemaFB, &r.memo)
@@ -127,6 +151,7 @@ func (r *Reader) Release() {
 	debug.Assert(atomic.LoadInt64(&r.refCount) > 0, "too many releases")
 
 	if atomic.AddInt64(&r.refCount, -1) == 0 {
+		r.stopPrefetch()
 		if r.rec != nil {
 			r.rec.Release()
 			r.rec = nil
@@ -144,6 +169,7 @@ func (r *Reader) Next() bool {
 		r.rec.Release()
 		r.rec = nil
 	}
+	r.meta = arrow.Metadata{}
 
 	if r.err != nil || r.done {
 		return false
@@ -153,23 +179,58 @@ func (r *Reader) Next() bool {
 }
 
 func (r *Reader) next() bool {
-	var msg *Message
-	msg, r.err = r.r.Message()
+	if r.pipe != nil {
+		return r.nextPrefetched()
+	}
+
+	if err := r.ctx.Err(); err != nil {
+		r.err = err
+		return false
+	}
+
+	r.rec, r.meta, r.err = r.decode()
 	if r.err != nil {
-		r.done = true
 		if r.err == io.EOF {
 			r.err = nil
+			r.done = true
 		}
 		return false
 	}
+	return true
+}
+
+// decode reads the next message from the underlying stream and decodes it
+// into a record.
+// decode returns io.EOF when the end of the stream has been reached.
+func (r *Reader) decode() (rec array.Record, meta arrow.Metadata, err error) {
+	defer recoverDecodeError(&err)
+
+	msg, err := r.r.Message()
+	if err != nil {
+		return nil, meta, err
+	}
 
 	if got, want := msg.Type(), MessageRecordBatch; got != want {
-		r.err = xerrors.Errorf("arrow/ipc: invalid message type (got=%v, want=%v", got, want)
-		return false
+		return nil, meta, xerrors.Errorf("arrow/ipc: invalid message type (got=%v, want=%v)", got, want)
 	}
 
-	r.rec = newRecord(r.schema, msg.meta, bytes.NewReader(msg.body.Bytes()))
-	return true
+	meta, err = msg.Metadata()
+	if err != nil {
+		return nil, meta, xerrors.Errorf("arrow/ipc: could not decode record metadata: %w", err)
+	}
+
+	rec, err = newRecord(r.schema, msg.meta, msg.body)
+	if err != nil {
+		return nil, meta, xerrors.Errorf("arrow/ipc: could not decode record: %w", err)
+	}
+	if r.proj != nil {
+		defer rec.Release()
+		rec, err = array.ProjectRecord(rec, r.proj, r.mem)
+		if err != nil {
+			return nil, meta, xerrors.Errorf("arrow/ipc: could not project record: %w", err)
+		}
+	}
+	return rec, meta, nil
 }
 
 // Record returns the current record that has been extracted from the
@@ -179,6 +240,13 @@ func (r *Reader) Record() array.Record {
 	return r.rec
 }
 
+// RecordMetadata returns the custom key-value metadata attached to the
+// message of the current record.
+// It is valid until the next call to Next.
+func (r *Reader) RecordMetadata() arrow.Metadata {
+	return r.meta
+}
+
 // Read reads the current record from the underlying stream and an error, if any.
 // When the Reader reaches the end of the underlying stream, it returns (nil, io.EOF).
 func (r *Reader) Read() (array.Record, error) {
@@ -186,6 +254,7 @@ func (r *Reader) Read() (array.Record, error) {
 		r.rec.Release()
 		r.rec = nil
 	}
+	r.meta = arrow.Metadata{}
 
 	if !r.next() {
 		if r.done {
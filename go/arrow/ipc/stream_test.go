@@ -17,12 +17,16 @@
 package ipc_test
 
 import (
+	"bytes"
 	"io"
 	"io/ioutil"
 	"os"
+	"reflect"
 	"testing"
 
+	"github.com/apache/arrow/go/arrow"
 	"github.com/apache/arrow/go/arrow/internal/arrdata"
+	"github.com/apache/arrow/go/arrow/ipc"
 	"github.com/apache/arrow/go/arrow/memory"
 )
 
@@ -60,3 +64,49 @@ func TestStream(t *testing.T) {
 		})
 	}
 }
+
+func TestStreamRecordMetadata(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	recs := arrdata.Records["primitives"]
+	metas := []arrow.Metadata{
+		arrow.NewMetadata([]string{"watermark", "offset"}, []string{"2020-01-01T00:00:00Z", "42"}),
+		arrow.Metadata{},
+		arrow.NewMetadata([]string{"offset"}, []string{"1042"}),
+	}
+
+	var buf bytes.Buffer
+	w := ipc.NewWriter(&buf, ipc.WithSchema(recs[0].Schema()), ipc.WithAllocator(mem))
+	for i, rec := range recs {
+		err := w.WriteWithMetadata(rec, metas[i%len(metas)])
+		if err != nil {
+			t.Fatalf("could not write record[%d]: %v", i, err)
+		}
+	}
+	err := w.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := ipc.NewReader(&buf, ipc.WithAllocator(mem))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Release()
+
+	n := 0
+	for r.Next() {
+		want := metas[n%len(metas)]
+		if got := r.RecordMetadata(); !reflect.DeepEqual(got.Keys(), want.Keys()) || !reflect.DeepEqual(got.Values(), want.Values()) {
+			t.Fatalf("invalid metadata for record %d: got=%v, want=%v", n, got, want)
+		}
+		n++
+	}
+	if err := r.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if n != len(recs) {
+		t.Fatalf("invalid number of records. got=%d, want=%d", n, len(recs))
+	}
+}
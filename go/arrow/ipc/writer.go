@@ -95,7 +95,14 @@ func (w *Writer) Close() error {
 	return nil
 }
 
+// Write writes the given record to the underlying stream.
 func (w *Writer) Write(rec array.Record) error {
+	return w.WriteWithMetadata(rec, arrow.Metadata{})
+}
+
+// WriteWithMetadata writes the given record to the underlying stream,
+// attaching the provided key-value metadata to the record batch message.
+func (w *Writer) WriteWithMetadata(rec array.Record, meta arrow.Metadata) error {
 	if !w.started {
 		err := w.start()
 		if err != nil {
@@ -115,6 +122,8 @@ func (w *Writer) Write(rec array.Record) error {
 	)
 	defer data.Release()
 
+	enc.custom = meta
+
 	if err := enc.Encode(&data, rec); err != nil {
 		return xerrors.Errorf("arrow/ipc: could not encode record to payload: %w", err)
 	}
@@ -144,6 +153,7 @@ type recordEncoder struct {
 
 	fields []fieldMetadata
 	meta   []bufferMetadata
+	custom arrow.Metadata // custom metadata attached to the record batch message
 
 	depth    int64
 	start    int64
@@ -331,6 +341,18 @@ func (w *recordEncoder) visit(p *payload, arr array.Interface) error {
 		p.body = append(p.body, voffsets)
 		p.body = append(p.body, values)
 
+	case *arrow.LargeBinaryType, *arrow.LargeStringType:
+		voffsets, err := w.getZeroBasedValueOffsets(arr)
+		if err != nil {
+			return xerrors.Errorf("could not retrieve zero-based value offsets from %T: %w", arr, err)
+		}
+		values := arr.Data().Buffers()[2]
+		if values != nil {
+			values.Retain()
+		}
+		p.body = append(p.body, voffsets)
+		p.body = append(p.body, values)
+
 	case *arrow.StructType:
 		w.depth--
 		arr := arr.(*array.Struct)
@@ -380,6 +402,30 @@ func (w *recordEncoder) visit(p *payload, arr array.Interface) error {
 		}
 		w.depth++
 
+	case *arrow.LargeListType:
+		arr := arr.(*array.LargeList)
+		voffsets, err := w.getZeroBasedValueOffsets(arr)
+		if err != nil {
+			return xerrors.Errorf("could not retrieve zero-based value offsets for array %T: %w", arr, err)
+		}
+		p.body = append(p.body, voffsets)
+
+		w.depth--
+		var beg, end int64
+		if voffsets != nil {
+			beg = arr.Offsets()[0]
+			end = arr.Offsets()[arr.Len()]
+		}
+
+		values := array.NewSlice(arr.ListValues(), beg, end)
+		defer values.Release()
+
+		err = w.visit(p, values)
+		if err != nil {
+			return xerrors.Errorf("could not visit list element for array %T: %w", arr, err)
+		}
+		w.depth++
+
 	case *arrow.FixedSizeListType:
 		arr := arr.(*array.FixedSizeList)
 
@@ -422,7 +468,7 @@ func (w *recordEncoder) getZeroBasedValueOffsets(arr array.Interface) (*memory.B
 }
 
 func (w *recordEncoder) encodeMetadata(p *payload, nrows int64) error {
-	p.meta = writeRecordMessage(w.mem, nrows, p.size, w.fields, w.meta)
+	p.meta = writeRecordMessage(w.mem, nrows, p.size, w.fields, w.meta, w.custom)
 	return nil
 }
 
@@ -0,0 +1,135 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package json reads newline-delimited JSON (NDJSON) files, where each line
+// holds one JSON object, and presents the extracted data as records, also
+// writes records as NDJSON files or JSON arrays of objects.
+package json // import "github.com/apache/arrow/go/arrow/json"
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+// Option configures a JSON reader/writer.
+type Option func(config)
+type config interface{}
+
+// WithAllocator specifies the Arrow memory allocator used while building records.
+func WithAllocator(mem memory.Allocator) Option {
+	return func(cfg config) {
+		switch cfg := cfg.(type) {
+		case *Reader:
+			cfg.mem = mem
+		default:
+			panic(fmt.Errorf("arrow/json: unknown config type %T", cfg))
+		}
+	}
+}
+
+// WithChunk specifies the chunk size used while reading JSON files.
+//
+// If n is zero or 1, no chunking will take place and the reader will create
+// one record per row.
+// If n is greater than 1, chunks of n rows will be read.
+// If n is negative, the reader will load the whole JSON file into memory and
+// create one big record with all the rows.
+func WithChunk(n int) Option {
+	return func(cfg config) {
+		switch cfg := cfg.(type) {
+		case *Reader:
+			cfg.chunk = n
+		default:
+			panic(fmt.Errorf("arrow/json: unknown config type %T", cfg))
+		}
+	}
+}
+
+// WithArray specifies whether the writer writes a JSON array of objects,
+// terminated by Writer.Close, instead of newline-delimited JSON objects.
+// The default value is false.
+func WithArray(useArray bool) Option {
+	return func(cfg config) {
+		switch cfg := cfg.(type) {
+		case *Writer:
+			cfg.array = useArray
+		default:
+			panic(fmt.Errorf("arrow/json: unknown config type %T", cfg))
+		}
+	}
+}
+
+// DefaultInferRows is the number of rows sampled, by default, by
+// NewInferringReader to infer the schema of a JSON file.
+const DefaultInferRows = 1000
+
+// WithInferRows specifies the number of rows sampled by NewInferringReader to
+// infer the schema of a JSON file.
+// If n is negative, the whole file is sampled.
+func WithInferRows(n int) Option {
+	return func(cfg config) {
+		switch cfg := cfg.(type) {
+		case *Reader:
+			cfg.inferRows = n
+		default:
+			panic(fmt.Errorf("arrow/json: unknown config type %T", cfg))
+		}
+	}
+}
+
+func validate(schema *arrow.Schema) {
+	for i, f := range schema.Fields() {
+		if !validType(f.Type) {
+			panic(fmt.Errorf("arrow/json: field %d (%s) has invalid data type %v", i, f.Name, f.Type))
+		}
+	}
+}
+
+func validType(dt arrow.DataType) bool {
+	switch dt := dt.(type) {
+	case *arrow.NullType, *arrow.BooleanType:
+	case *arrow.Int8Type, *arrow.Int16Type, *arrow.Int32Type, *arrow.Int64Type:
+	case *arrow.Uint8Type, *arrow.Uint16Type, *arrow.Uint32Type, *arrow.Uint64Type:
+	case *arrow.Float16Type, *arrow.Float32Type, *arrow.Float64Type:
+	case *arrow.Decimal128Type:
+	case *arrow.StringType, *arrow.BinaryType, *arrow.FixedSizeBinaryType:
+	case *arrow.Date32Type, *arrow.Date64Type:
+	case *arrow.Time32Type, *arrow.Time64Type, *arrow.DurationType:
+	case *arrow.MonthIntervalType, *arrow.DayTimeIntervalType:
+	case *arrow.TimestampType:
+		if dt.TimeZone != "" {
+			if _, err := time.LoadLocation(dt.TimeZone); err != nil {
+				return false
+			}
+		}
+	case *arrow.ListType:
+		return validType(dt.Elem())
+	case *arrow.FixedSizeListType:
+		return validType(dt.Elem())
+	case *arrow.StructType:
+		for _, f := range dt.Fields() {
+			if !validType(f.Type) {
+				return false
+			}
+		}
+	default:
+		return false
+	}
+	return true
+}
@@ -0,0 +1,191 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package json
+
+import (
+	"encoding/json"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/internal/arrtime"
+)
+
+// inferKind is the kind of a JSON value, as inferred from its values.
+// Kinds are ordered from the most to the least specific one.
+type inferKind int
+
+const (
+	inferNull inferKind = iota
+	inferBool
+	inferInt
+	inferFloat
+	inferDate
+	inferTimestamp
+	inferList
+	inferStruct
+	inferString
+)
+
+// merge returns the most specific kind able to represent values of both
+// kinds k and o.
+func (k inferKind) merge(o inferKind) inferKind {
+	if k > o {
+		k, o = o, k
+	}
+	switch {
+	case k == o, k == inferNull:
+		return o
+	case k == inferInt && o == inferFloat:
+		return inferFloat
+	case k == inferDate && o == inferTimestamp:
+		return inferTimestamp
+	default:
+		return inferString
+	}
+}
+
+// inferNode is the type of a JSON value, as inferred from a sample of values.
+type inferNode struct {
+	kind   inferKind
+	elem   *inferNode            // type of the elements of lists
+	names  []string              // names of the fields of structs, by first appearance
+	fields map[string]*inferNode // types of the fields of structs
+}
+
+// add refines the type of the node with the decoded JSON value v.
+func (n *inferNode) add(v interface{}) {
+	n.kind = n.kind.merge(kindOfValue(v))
+
+	switch n.kind {
+	case inferList:
+		arr, ok := v.([]interface{})
+		if !ok {
+			return
+		}
+		if n.elem == nil {
+			n.elem = &inferNode{}
+		}
+		for _, e := range arr {
+			n.elem.add(e)
+		}
+
+	case inferStruct:
+		obj, ok := v.(*object)
+		if !ok {
+			return
+		}
+		if n.fields == nil {
+			n.fields = make(map[string]*inferNode)
+		}
+		for _, k := range obj.keys {
+			f, ok := n.fields[k]
+			if !ok {
+				f = &inferNode{}
+				n.names = append(n.names, k)
+				n.fields[k] = f
+			}
+			f.add(obj.values[k])
+		}
+	}
+}
+
+func (n *inferNode) dataType() arrow.DataType {
+	switch n.kind {
+	case inferBool:
+		return arrow.FixedWidthTypes.Boolean
+	case inferInt:
+		return arrow.PrimitiveTypes.Int64
+	case inferFloat:
+		return arrow.PrimitiveTypes.Float64
+	case inferDate:
+		return arrow.FixedWidthTypes.Date32
+	case inferTimestamp:
+		return arrow.FixedWidthTypes.Timestamp_ns
+	case inferList:
+		if n.elem == nil {
+			return arrow.ListOf(arrow.BinaryTypes.String)
+		}
+		return arrow.ListOf(n.elem.dataType())
+	case inferStruct:
+		return arrow.StructOf(n.structFields()...)
+	default:
+		// values with incompatible types, or only null values, are read as
+		// strings.
+		return arrow.BinaryTypes.String
+	}
+}
+
+func (n *inferNode) structFields() []arrow.Field {
+	fields := make([]arrow.Field, len(n.names))
+	for i, name := range n.names {
+		fields[i] = arrow.Field{
+			Name:     name,
+			Type:     n.fields[name].dataType(),
+			Nullable: true,
+		}
+	}
+	return fields
+}
+
+// kindOfValue returns the most specific kind able to represent the decoded
+// JSON value v.
+func kindOfValue(v interface{}) inferKind {
+	switch v := v.(type) {
+	case nil:
+		return inferNull
+	case bool:
+		return inferBool
+	case json.Number:
+		if _, err := strconv.ParseInt(string(v), 10, 64); err == nil {
+			return inferInt
+		}
+		return inferFloat
+	case string:
+		if _, err := time.Parse(arrtime.DateLayout, v); err == nil {
+			return inferDate
+		}
+		if _, err := time.Parse(time.RFC3339Nano, v); err == nil {
+			return inferTimestamp
+		}
+		return inferString
+	case []interface{}:
+		return inferList
+	default:
+		return inferStruct
+	}
+}
+
+// inferSchema infers the schema of the JSON file from its first rows.
+// The sampled rows are kept to be read back by readObject.
+func (r *Reader) inferSchema() (*arrow.Schema, error) {
+	root := &inferNode{kind: inferStruct}
+	for r.inferRows < 0 || len(r.sample) < r.inferRows {
+		obj, err := r.decodeObject()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		root.add(obj)
+		r.sample = append(r.sample, obj)
+	}
+
+	return arrow.NewSchema(root.structFields(), nil), nil
+}
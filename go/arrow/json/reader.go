@@ -0,0 +1,926 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package json
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"math"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/arrio"
+	"github.com/apache/arrow/go/arrow/decimal128"
+	"github.com/apache/arrow/go/arrow/float16"
+	"github.com/apache/arrow/go/arrow/internal/arrtime"
+	"github.com/apache/arrow/go/arrow/internal/debug"
+	"github.com/apache/arrow/go/arrow/memory"
+	"golang.org/x/xerrors"
+)
+
+// Reader decodes newline-delimited JSON objects and creates array.Records
+// from a schema.
+//
+// Each object is a row, whose members are matched by name with the fields of
+// the schema. Missing members are read as nulls, and members without a
+// corresponding field are ignored.
+//
+// JSON values are converted to the type of their field as follows:
+//   - numbers are read as integers or floating-point values, and the "NaN",
+//     "+Inf" and "-Inf" strings as floating-point values. Decimals,
+//     timestamps, dates, times and durations may also be given as a number of
+//     units of their type.
+//   - decimals are read from strings, e.g. "-12.345".
+//   - timestamps are read from RFC3339 strings. Timestamps without time zone
+//     information are interpreted in the time zone of their type, or as UTC.
+//   - dates are read from "2006-01-02" strings, times from "15:04:05" strings
+//     and durations from strings understood by time.ParseDuration.
+//   - binary and fixed-size binary values are read from base64 strings.
+//   - day-time intervals are read from {"days": d, "milliseconds": ms} objects.
+//   - lists and fixed-size lists are read from arrays, and structs from objects.
+//   - lists of struct<key, value> are read from arrays, or from objects whose
+//     members become the key/value entries of the list, as for maps.
+//   - strings are read from strings. Any other JSON value is read as its JSON
+//     text.
+type Reader struct {
+	dec    *json.Decoder
+	schema *arrow.Schema
+
+	refs int64
+	bld  *array.RecordBuilder
+	cur  array.Record
+	err  error
+
+	chunk   int
+	done    bool
+	decoded int // number of rows decoded from the JSON file
+	nrows   int // number of rows appended to records
+
+	mem memory.Allocator
+
+	once      sync.Once
+	infer     bool
+	inferRows int
+	sample    []*object // rows read while inferring the schema, not yet consumed
+
+	fieldAppender []appender
+}
+
+// NewReader returns a reader that reads from the NDJSON file and creates
+// array.Records from the given schema.
+//
+// NewReader panics if the given schema contains fields that have types that
+// are not supported.
+func NewReader(r io.Reader, schema *arrow.Schema, opts ...Option) *Reader {
+	validate(schema)
+
+	rr := newReader(r, opts...)
+	rr.schema = schema
+	return rr
+}
+
+// NewInferringReader returns a reader that reads from the NDJSON file and
+// creates array.Records whose schema is inferred from the first rows of the
+// file, as configured with WithInferRows.
+//
+// Fields are ordered by first appearance in the sampled rows. JSON booleans
+// are inferred as booleans, numbers as int64 or float64, strings as date32,
+// timestamps or strings, arrays as lists and objects as structs. Fields with
+// incompatible values, or only null values, are inferred as strings.
+//
+// The schema is inferred on the first call to Schema or Next.
+func NewInferringReader(r io.Reader, opts ...Option) *Reader {
+	opts = append([]Option{WithInferRows(DefaultInferRows)}, opts...)
+
+	rr := newReader(r, opts...)
+	rr.infer = true
+	return rr
+}
+
+func newReader(r io.Reader, opts ...Option) *Reader {
+	rr := &Reader{
+		dec:   json.NewDecoder(r),
+		refs:  1,
+		chunk: 1,
+	}
+	rr.dec.UseNumber()
+	for _, opt := range opts {
+		opt(rr)
+	}
+
+	if rr.mem == nil {
+		rr.mem = memory.DefaultAllocator
+	}
+
+	return rr
+}
+
+func (r *Reader) init() {
+	r.once.Do(func() {
+		if r.infer {
+			r.schema, r.err = r.inferSchema()
+			if r.err != nil {
+				return
+			}
+		}
+
+		r.bld = array.NewRecordBuilder(r.mem, r.schema)
+		r.fieldAppender = make([]appender, len(r.schema.Fields()))
+		for i, f := range r.schema.Fields() {
+			r.fieldAppender[i] = newAppender(f.Type)
+		}
+	})
+}
+
+// Err returns the last error encountered during the iteration over the
+// underlying JSON file.
+func (r *Reader) Err() error { return r.err }
+
+// Schema returns the schema of the records extracted from the underlying JSON
+// file.
+//
+// For readers inferring their schema, Schema infers it if it has not been
+// done yet, and returns nil if the schema could not be inferred.
+func (r *Reader) Schema() *arrow.Schema {
+	r.init()
+	return r.schema
+}
+
+// Record returns the current record that has been extracted from the
+// underlying JSON file.
+// It is valid until the next call to Next or Read.
+func (r *Reader) Record() array.Record { return r.cur }
+
+// Next returns whether a Record could be extracted from the underlying JSON
+// file.
+func (r *Reader) Next() bool {
+	r.init()
+
+	if r.cur != nil {
+		r.cur.Release()
+		r.cur = nil
+	}
+
+	if r.err != nil || r.done {
+		return false
+	}
+
+	chunk := r.chunk
+	if chunk == 0 {
+		chunk = 1
+	}
+
+	n := 0
+	for chunk < 0 || n < chunk {
+		obj, err := r.readObject()
+		if err == io.EOF {
+			r.done = true
+			break
+		}
+		if err == nil {
+			err = r.appendObject(obj)
+		}
+		if err != nil {
+			r.err = err
+			// the builder may hold a partially appended row: discard it.
+			r.bld.Release()
+			r.bld = nil
+			return false
+		}
+		n++
+	}
+
+	if n == 0 {
+		return false
+	}
+
+	r.cur = r.bld.NewRecord()
+	return true
+}
+
+// Read reads the next record from the underlying JSON file.
+// Read returns io.EOF when there are no more records, or the error that
+// stopped the iteration.
+// The returned record is valid until the next call to Read or Next.
+func (r *Reader) Read() (array.Record, error) {
+	if !r.Next() {
+		if r.err != nil {
+			return nil, r.err
+		}
+		return nil, io.EOF
+	}
+	return r.cur, nil
+}
+
+// readObject returns the next row of the JSON file.
+func (r *Reader) readObject() (*object, error) {
+	if len(r.sample) > 0 {
+		obj := r.sample[0]
+		r.sample[0] = nil
+		r.sample = r.sample[1:]
+		return obj, nil
+	}
+	return r.decodeObject()
+}
+
+// decodeObject decodes the next row of the JSON file.
+func (r *Reader) decodeObject() (*object, error) {
+	tok, err := r.dec.Token()
+	if err == io.EOF {
+		return nil, err
+	}
+	var v interface{}
+	if err == nil {
+		v, err = decodeToken(r.dec, tok)
+	}
+	r.decoded++
+	if err != nil {
+		return nil, xerrors.Errorf("arrow/json: row %d: could not decode JSON: %w", r.decoded, err)
+	}
+
+	obj, ok := v.(*object)
+	if !ok {
+		return nil, xerrors.Errorf("arrow/json: row %d: invalid JSON %s, want object", r.decoded, kindOf(v))
+	}
+	return obj, nil
+}
+
+func (r *Reader) appendObject(obj *object) error {
+	r.nrows++
+	for i, f := range r.schema.Fields() {
+		err := r.fieldAppender[i](r.bld.Field(i), obj.values[f.Name])
+		if err != nil {
+			return xerrors.Errorf("arrow/json: row %d, field %q: %w", r.nrows, f.Name, err)
+		}
+	}
+	return nil
+}
+
+// Retain increases the reference count by 1.
+// Retain may be called simultaneously from multiple goroutines.
+func (r *Reader) Retain() {
+	atomic.AddInt64(&r.refs, 1)
+}
+
+// Release decreases the reference count by 1.
+// When the reference count goes to zero, the memory is freed.
+// Release may be called simultaneously from multiple goroutines.
+func (r *Reader) Release() {
+	debug.Assert(atomic.LoadInt64(&r.refs) > 0, "too many releases")
+
+	if atomic.AddInt64(&r.refs, -1) == 0 {
+		if r.cur != nil {
+			r.cur.Release()
+			r.cur = nil
+		}
+		if r.bld != nil {
+			r.bld.Release()
+			r.bld = nil
+		}
+	}
+}
+
+// object is a decoded JSON object, whose members are kept in order.
+type object struct {
+	keys   []string
+	values map[string]interface{}
+}
+
+// decodeToken decodes the JSON value starting with tok.
+//
+// Objects are decoded as *object, arrays as []interface{}, numbers as
+// json.Number, and strings, booleans and null as string, bool and nil.
+func decodeToken(dec *json.Decoder, tok json.Token) (interface{}, error) {
+	delim, ok := tok.(json.Delim)
+	if !ok {
+		return tok, nil
+	}
+
+	next := func() (interface{}, error) {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		if err != nil {
+			return nil, err
+		}
+		return decodeToken(dec, tok)
+	}
+
+	switch delim {
+	case '{':
+		obj := &object{values: make(map[string]interface{})}
+		for dec.More() {
+			key, err := next()
+			if err != nil {
+				return nil, err
+			}
+			k := key.(string) // keys are validated by the decoder.
+			v, err := next()
+			if err != nil {
+				return nil, err
+			}
+			if _, dup := obj.values[k]; !dup {
+				obj.keys = append(obj.keys, k)
+			}
+			obj.values[k] = v
+		}
+		_, err := dec.Token() // closing '}'
+		return obj, err
+
+	default: // '['
+		arr := []interface{}{}
+		for dec.More() {
+			v, err := next()
+			if err != nil {
+				return nil, err
+			}
+			arr = append(arr, v)
+		}
+		_, err := dec.Token() // closing ']'
+		return arr, err
+	}
+}
+
+// kindOf returns the kind of the decoded JSON value v.
+func kindOf(v interface{}) string {
+	switch v.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case json.Number:
+		return "number"
+	case string:
+		return "string"
+	case []interface{}:
+		return "array"
+	default:
+		return "object"
+	}
+}
+
+func typeError(v interface{}, want string) error {
+	return xerrors.Errorf("invalid JSON %s, want %s", kindOf(v), want)
+}
+
+// appendJSON appends the JSON text of the decoded JSON value v to dst.
+func appendJSON(dst []byte, v interface{}) []byte {
+	switch v := v.(type) {
+	case nil:
+		return append(dst, "null"...)
+	case bool:
+		return strconv.AppendBool(dst, v)
+	case json.Number:
+		return append(dst, v...)
+	case string:
+		str, _ := json.Marshal(v)
+		return append(dst, str...)
+	case []interface{}:
+		dst = append(dst, '[')
+		for i, e := range v {
+			if i > 0 {
+				dst = append(dst, ',')
+			}
+			dst = appendJSON(dst, e)
+		}
+		return append(dst, ']')
+	default:
+		obj := v.(*object)
+		dst = append(dst, '{')
+		for i, k := range obj.keys {
+			if i > 0 {
+				dst = append(dst, ',')
+			}
+			dst = appendJSON(dst, k)
+			dst = append(dst, ':')
+			dst = appendJSON(dst, obj.values[k])
+		}
+		return append(dst, '}')
+	}
+}
+
+// appender appends the decoded JSON value v, which may be nil, to a builder.
+type appender func(b array.Builder, v interface{}) error
+
+// newAppender returns the appender for values of type dt.
+// Appenders are built once per schema. This allows us to specialize the
+// implementation of each field's decoding and hoist type-based branches
+// outside the inner loop.
+func newAppender(dt arrow.DataType) appender {
+	switch dt := dt.(type) {
+	case *arrow.StructType:
+		return newStructAppender(dt)
+	case *arrow.FixedSizeListType:
+		return newFixedSizeListAppender(dt)
+	}
+
+	app := newValueAppender(dt)
+	return func(b array.Builder, v interface{}) error {
+		if v == nil {
+			b.AppendNull()
+			return nil
+		}
+		return app(b, v)
+	}
+}
+
+// newStructAppender returns the appender for struct values.
+// A null struct still needs one child slot per field, which is appended
+// through the field appenders so nested fixed-size lists get their values.
+func newStructAppender(dt *arrow.StructType) appender {
+	fields := make([]appender, len(dt.Fields()))
+	for i, f := range dt.Fields() {
+		fields[i] = newAppender(f.Type)
+	}
+
+	return func(b array.Builder, v interface{}) error {
+		sb := b.(*array.StructBuilder)
+		if v == nil {
+			// sb.AppendNull would add its own null to every field builder.
+			sb.AppendValues([]bool{false})
+			for i := range fields {
+				_ = fields[i](sb.FieldBuilder(i), nil)
+			}
+			return nil
+		}
+
+		obj, ok := v.(*object)
+		if !ok {
+			return typeError(v, "object")
+		}
+		sb.Append(true)
+		for i, f := range dt.Fields() {
+			if err := fields[i](sb.FieldBuilder(i), obj.values[f.Name]); err != nil {
+				return xerrors.Errorf("field %q: %w", f.Name, err)
+			}
+		}
+		return nil
+	}
+}
+
+// newFixedSizeListAppender returns the appender for fixed-size list values,
+// which also appends null elements to null lists.
+func newFixedSizeListAppender(dt *arrow.FixedSizeListType) appender {
+	n := int(dt.Len())
+	elem := newAppender(dt.Elem())
+
+	return func(b array.Builder, v interface{}) error {
+		lb := b.(*array.FixedSizeListBuilder)
+		if v == nil {
+			lb.AppendNull()
+			for i := 0; i < n; i++ {
+				_ = elem(lb.ValueBuilder(), nil)
+			}
+			return nil
+		}
+
+		arr, ok := v.([]interface{})
+		if !ok {
+			return typeError(v, "array")
+		}
+		if len(arr) != n {
+			return xerrors.Errorf("invalid fixed-size list length (got=%d, want=%d)", len(arr), n)
+		}
+		lb.Append(true)
+		for i, e := range arr {
+			if err := elem(lb.ValueBuilder(), e); err != nil {
+				return xerrors.Errorf("element %d: %w", i, err)
+			}
+		}
+		return nil
+	}
+}
+
+// newListAppender returns the appender for list values. Lists of
+// struct<key, value> may also be read from JSON objects.
+func newListAppender(dt *arrow.ListType) appender {
+	elem := newAppender(dt.Elem())
+
+	var entry appender
+	if st, ok := dt.Elem().(*arrow.StructType); ok && len(st.Fields()) == 2 &&
+		st.Field(0).Name == "key" && st.Field(1).Name == "value" {
+		key := newAppender(st.Field(0).Type)
+		val := newAppender(st.Field(1).Type)
+		entry = func(b array.Builder, v interface{}) error {
+			obj := v.(*object)
+			sb := b.(*array.StructBuilder)
+			for _, k := range obj.keys {
+				sb.Append(true)
+				if err := key(sb.FieldBuilder(0), k); err != nil {
+					return xerrors.Errorf("key %q: %w", k, err)
+				}
+				if err := val(sb.FieldBuilder(1), obj.values[k]); err != nil {
+					return xerrors.Errorf("key %q: %w", k, err)
+				}
+			}
+			return nil
+		}
+	}
+
+	return func(b array.Builder, v interface{}) error {
+		lb := b.(*array.ListBuilder)
+		switch v := v.(type) {
+		case []interface{}:
+			lb.Append(true)
+			for i, e := range v {
+				if err := elem(lb.ValueBuilder(), e); err != nil {
+					return xerrors.Errorf("element %d: %w", i, err)
+				}
+			}
+			return nil
+		case *object:
+			if entry == nil {
+				break
+			}
+			lb.Append(true)
+			return entry(lb.ValueBuilder(), v)
+		}
+		return typeError(v, "array")
+	}
+}
+
+// newValueAppender returns the appender for non-null values of type dt.
+func newValueAppender(dt arrow.DataType) appender {
+	switch dt := dt.(type) {
+	case *arrow.NullType:
+		return func(b array.Builder, v interface{}) error {
+			return typeError(v, "null")
+		}
+	case *arrow.BooleanType:
+		return func(b array.Builder, v interface{}) error {
+			bv, ok := v.(bool)
+			if !ok {
+				return typeError(v, "boolean")
+			}
+			b.(*array.BooleanBuilder).Append(bv)
+			return nil
+		}
+	case *arrow.Int8Type:
+		return func(b array.Builder, v interface{}) error {
+			n, err := parseInt(v, 8)
+			if err != nil {
+				return err
+			}
+			b.(*array.Int8Builder).Append(int8(n))
+			return nil
+		}
+	case *arrow.Int16Type:
+		return func(b array.Builder, v interface{}) error {
+			n, err := parseInt(v, 16)
+			if err != nil {
+				return err
+			}
+			b.(*array.Int16Builder).Append(int16(n))
+			return nil
+		}
+	case *arrow.Int32Type:
+		return func(b array.Builder, v interface{}) error {
+			n, err := parseInt(v, 32)
+			if err != nil {
+				return err
+			}
+			b.(*array.Int32Builder).Append(int32(n))
+			return nil
+		}
+	case *arrow.Int64Type:
+		return func(b array.Builder, v interface{}) error {
+			n, err := parseInt(v, 64)
+			if err != nil {
+				return err
+			}
+			b.(*array.Int64Builder).Append(n)
+			return nil
+		}
+	case *arrow.Uint8Type:
+		return func(b array.Builder, v interface{}) error {
+			n, err := parseUint(v, 8)
+			if err != nil {
+				return err
+			}
+			b.(*array.Uint8Builder).Append(uint8(n))
+			return nil
+		}
+	case *arrow.Uint16Type:
+		return func(b array.Builder, v interface{}) error {
+			n, err := parseUint(v, 16)
+			if err != nil {
+				return err
+			}
+			b.(*array.Uint16Builder).Append(uint16(n))
+			return nil
+		}
+	case *arrow.Uint32Type:
+		return func(b array.Builder, v interface{}) error {
+			n, err := parseUint(v, 32)
+			if err != nil {
+				return err
+			}
+			b.(*array.Uint32Builder).Append(uint32(n))
+			return nil
+		}
+	case *arrow.Uint64Type:
+		return func(b array.Builder, v interface{}) error {
+			n, err := parseUint(v, 64)
+			if err != nil {
+				return err
+			}
+			b.(*array.Uint64Builder).Append(n)
+			return nil
+		}
+	case *arrow.Float16Type:
+		return func(b array.Builder, v interface{}) error {
+			f, err := parseFloat(v, 32)
+			if err != nil {
+				return err
+			}
+			b.(*array.Float16Builder).Append(float16.New(float32(f)))
+			return nil
+		}
+	case *arrow.Float32Type:
+		return func(b array.Builder, v interface{}) error {
+			f, err := parseFloat(v, 32)
+			if err != nil {
+				return err
+			}
+			b.(*array.Float32Builder).Append(float32(f))
+			return nil
+		}
+	case *arrow.Float64Type:
+		return func(b array.Builder, v interface{}) error {
+			f, err := parseFloat(v, 64)
+			if err != nil {
+				return err
+			}
+			b.(*array.Float64Builder).Append(f)
+			return nil
+		}
+	case *arrow.Decimal128Type:
+		return func(b array.Builder, v interface{}) error {
+			var str string
+			switch v := v.(type) {
+			case string:
+				str = v
+			case json.Number:
+				str = string(v)
+			default:
+				return typeError(v, "string")
+			}
+			n, err := decimal128.FromString(str, dt.Precision, dt.Scale)
+			if err != nil {
+				return err
+			}
+			b.(*array.Decimal128Builder).Append(n)
+			return nil
+		}
+	case *arrow.StringType:
+		return func(b array.Builder, v interface{}) error {
+			str, ok := v.(string)
+			if !ok {
+				str = string(appendJSON(nil, v))
+			}
+			b.(*array.StringBuilder).Append(str)
+			return nil
+		}
+	case *arrow.BinaryType:
+		return func(b array.Builder, v interface{}) error {
+			buf, err := parseBase64(v)
+			if err != nil {
+				return err
+			}
+			b.(*array.BinaryBuilder).Append(buf)
+			return nil
+		}
+	case *arrow.FixedSizeBinaryType:
+		return func(b array.Builder, v interface{}) error {
+			buf, err := parseBase64(v)
+			if err != nil {
+				return err
+			}
+			if len(buf) != dt.ByteWidth {
+				return xerrors.Errorf("invalid fixed-size binary value (got=%d bytes, want=%d)", len(buf), dt.ByteWidth)
+			}
+			b.(*array.FixedSizeBinaryBuilder).Append(buf)
+			return nil
+		}
+	case *arrow.TimestampType:
+		loc := time.UTC
+		if dt.TimeZone != "" {
+			// time zone validity has been checked by validate.
+			loc, _ = time.LoadLocation(dt.TimeZone)
+		}
+		return func(b array.Builder, v interface{}) error {
+			n, err := parseTimestamp(v, dt.Unit, loc)
+			if err != nil {
+				return err
+			}
+			b.(*array.TimestampBuilder).Append(arrow.Timestamp(n))
+			return nil
+		}
+	case *arrow.Date32Type:
+		return func(b array.Builder, v interface{}) error {
+			n, err := parseDate(v, 1)
+			if err != nil {
+				return err
+			}
+			b.(*array.Date32Builder).Append(arrow.Date32(n))
+			return nil
+		}
+	case *arrow.Date64Type:
+		return func(b array.Builder, v interface{}) error {
+			n, err := parseDate(v, arrtime.SecondsPerDay*1000)
+			if err != nil {
+				return err
+			}
+			b.(*array.Date64Builder).Append(arrow.Date64(n))
+			return nil
+		}
+	case *arrow.Time32Type:
+		return func(b array.Builder, v interface{}) error {
+			n, err := parseTime(v, dt.Unit)
+			if err != nil {
+				return err
+			}
+			b.(*array.Time32Builder).Append(arrow.Time32(n))
+			return nil
+		}
+	case *arrow.Time64Type:
+		return func(b array.Builder, v interface{}) error {
+			n, err := parseTime(v, dt.Unit)
+			if err != nil {
+				return err
+			}
+			b.(*array.Time64Builder).Append(arrow.Time64(n))
+			return nil
+		}
+	case *arrow.DurationType:
+		return func(b array.Builder, v interface{}) error {
+			n, err := parseDuration(v, dt.Unit)
+			if err != nil {
+				return err
+			}
+			b.(*array.DurationBuilder).Append(arrow.Duration(n))
+			return nil
+		}
+	case *arrow.MonthIntervalType:
+		return func(b array.Builder, v interface{}) error {
+			n, err := parseInt(v, 32)
+			if err != nil {
+				return err
+			}
+			b.(*array.MonthIntervalBuilder).Append(arrow.MonthInterval(n))
+			return nil
+		}
+	case *arrow.DayTimeIntervalType:
+		return func(b array.Builder, v interface{}) error {
+			obj, ok := v.(*object)
+			if !ok {
+				return typeError(v, "object")
+			}
+			days, err := parseInt(obj.values["days"], 32)
+			if err != nil {
+				return xerrors.Errorf("days: %w", err)
+			}
+			ms, err := parseInt(obj.values["milliseconds"], 32)
+			if err != nil {
+				return xerrors.Errorf("milliseconds: %w", err)
+			}
+			b.(*array.DayTimeIntervalBuilder).Append(arrow.DayTimeInterval{Days: int32(days), Milliseconds: int32(ms)})
+			return nil
+		}
+	case *arrow.ListType:
+		return newListAppender(dt)
+	default:
+		// data types have been checked by validate.
+		panic(xerrors.Errorf("arrow/json: unhandled data type %T", dt))
+	}
+}
+
+func parseInt(v interface{}, bits int) (int64, error) {
+	num, ok := v.(json.Number)
+	if !ok {
+		return 0, typeError(v, "number")
+	}
+	return strconv.ParseInt(string(num), 10, bits)
+}
+
+func parseUint(v interface{}, bits int) (uint64, error) {
+	num, ok := v.(json.Number)
+	if !ok {
+		return 0, typeError(v, "number")
+	}
+	return strconv.ParseUint(string(num), 10, bits)
+}
+
+// parseFloat parses floating-point numbers, and the "NaN", "+Inf" and "-Inf"
+// strings written by Writer for values that are not valid JSON numbers.
+func parseFloat(v interface{}, bits int) (float64, error) {
+	switch v := v.(type) {
+	case json.Number:
+		return strconv.ParseFloat(string(v), bits)
+	case string:
+		switch v {
+		case "NaN":
+			return math.NaN(), nil
+		case "+Inf":
+			return math.Inf(1), nil
+		case "-Inf":
+			return math.Inf(-1), nil
+		}
+	}
+	return 0, typeError(v, "number")
+}
+
+func parseBase64(v interface{}) ([]byte, error) {
+	str, ok := v.(string)
+	if !ok {
+		return nil, typeError(v, "base64 string")
+	}
+	return base64.StdEncoding.DecodeString(str)
+}
+
+// parseTimestamp parses timestamps expressed either as an integer number of
+// time units, or as a string in one of the arrtime.TimestampLayouts.
+func parseTimestamp(v interface{}, unit arrow.TimeUnit, loc *time.Location) (int64, error) {
+	str, ok := v.(string)
+	if !ok {
+		return parseInt(v, 64)
+	}
+
+	for _, layout := range arrtime.TimestampLayouts {
+		t, err := time.ParseInLocation(layout, str, loc)
+		if err == nil {
+			return arrtime.FromTime(t, unit), nil
+		}
+	}
+	return 0, xerrors.Errorf("could not parse timestamp %q", str)
+}
+
+// parseDate parses dates expressed either as an integer number of units, or
+// as a "2006-01-02" string. Units are multiples of days.
+func parseDate(v interface{}, daysToUnit int64) (int64, error) {
+	str, ok := v.(string)
+	if !ok {
+		return parseInt(v, 64)
+	}
+
+	t, err := time.Parse(arrtime.DateLayout, str)
+	if err != nil {
+		return 0, xerrors.Errorf("could not parse date %q: %w", str, err)
+	}
+	return t.Unix() / arrtime.SecondsPerDay * daysToUnit, nil
+}
+
+// parseTime parses times of day expressed either as an integer number of
+// time units, or as a "15:04:05" string.
+func parseTime(v interface{}, unit arrow.TimeUnit) (int64, error) {
+	str, ok := v.(string)
+	if !ok {
+		return parseInt(v, 64)
+	}
+
+	n, err := arrtime.ParseTimeOfDay(str, unit)
+	if err != nil {
+		return 0, xerrors.Errorf("could not parse time %q: %w", str, err)
+	}
+	return n, nil
+}
+
+// parseDuration parses durations expressed either as an integer number of
+// time units, or as a string understood by time.ParseDuration.
+func parseDuration(v interface{}, unit arrow.TimeUnit) (int64, error) {
+	str, ok := v.(string)
+	if !ok {
+		return parseInt(v, 64)
+	}
+
+	d, err := time.ParseDuration(str)
+	if err != nil {
+		return 0, xerrors.Errorf("could not parse duration %q: %w", str, err)
+	}
+	return int64(d / unit.Multiplier()), nil
+}
+
+var (
+	_ array.RecordReader = (*Reader)(nil)
+	_ arrio.Reader       = (*Reader)(nil)
+)
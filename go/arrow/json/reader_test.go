@@ -0,0 +1,352 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package json_test
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/json"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+func TestReader(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	schema := arrow.NewSchema(
+		[]arrow.Field{
+			{Name: "bool", Type: arrow.FixedWidthTypes.Boolean, Nullable: true},
+			{Name: "i8", Type: arrow.PrimitiveTypes.Int8, Nullable: true},
+			{Name: "u64", Type: arrow.PrimitiveTypes.Uint64, Nullable: true},
+			{Name: "f16", Type: arrow.FixedWidthTypes.Float16, Nullable: true},
+			{Name: "f64", Type: arrow.PrimitiveTypes.Float64, Nullable: true},
+			{Name: "str", Type: arrow.BinaryTypes.String, Nullable: true},
+			{Name: "bin", Type: arrow.BinaryTypes.Binary, Nullable: true},
+			{Name: "fsb", Type: &arrow.FixedSizeBinaryType{ByteWidth: 2}, Nullable: true},
+			{Name: "dec", Type: &arrow.Decimal128Type{Precision: 10, Scale: 2}, Nullable: true},
+			{Name: "ts", Type: &arrow.TimestampType{Unit: arrow.Second, TimeZone: "Europe/Paris"}, Nullable: true},
+			{Name: "d32", Type: arrow.FixedWidthTypes.Date32, Nullable: true},
+			{Name: "d64", Type: arrow.FixedWidthTypes.Date64, Nullable: true},
+			{Name: "t32", Type: arrow.FixedWidthTypes.Time32s, Nullable: true},
+			{Name: "t64", Type: arrow.FixedWidthTypes.Time64us, Nullable: true},
+			{Name: "dur", Type: arrow.FixedWidthTypes.Duration_ms, Nullable: true},
+			{Name: "months", Type: arrow.FixedWidthTypes.MonthInterval, Nullable: true},
+			{Name: "daytime", Type: arrow.FixedWidthTypes.DayTimeInterval, Nullable: true},
+			{Name: "list", Type: arrow.ListOf(arrow.PrimitiveTypes.Int32), Nullable: true},
+			{Name: "fsl", Type: arrow.FixedSizeListOf(2, arrow.PrimitiveTypes.Int32), Nullable: true},
+			{Name: "struct", Type: arrow.StructOf(
+				arrow.Field{Name: "a", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
+				arrow.Field{Name: "b", Type: arrow.BinaryTypes.String, Nullable: true},
+			), Nullable: true},
+			{Name: "map", Type: arrow.ListOf(arrow.StructOf(
+				arrow.Field{Name: "key", Type: arrow.BinaryTypes.String},
+				arrow.Field{Name: "value", Type: arrow.PrimitiveTypes.Int64, Nullable: true},
+			)), Nullable: true},
+		},
+		nil,
+	)
+
+	input := `{"bool": true, "i8": -1, "u64": 18446744073709551615, "f16": 1.5, "f64": 1e3, "str": "hello", "bin": "AQI=", "fsb": "AQI=", "dec": "-12.34", "ts": "2020-01-02T03:04:05Z", "d32": "2020-01-02", "d64": "1970-01-02", "t32": "01:02:03", "t64": "00:00:01.5", "dur": "1.5s", "months": 3, "daytime": {"days": 1, "milliseconds": 2}, "list": [1, null, 3], "fsl": [1, 2], "struct": {"a": 1, "b": "x"}, "map": {"z": 1, "y": null}}
+{"bool": false, "i8": 2, "u64": 0, "f16": -2, "f64": 0.5, "str": {"k": [1, "v"]}, "bin": "", "fsb": "AwQ=", "dec": 5, "ts": "2020-01-02 03:04:05", "d32": 1, "d64": 86400000, "t32": 60, "t64": 1, "dur": 10, "months": -1, "daytime": {"days": -1, "milliseconds": 0}, "list": [], "fsl": [null, 4], "struct": {"b": "y", "c": 42}, "map": [{"key": "k", "value": 7}]}
+{"extra": "ignored"}
+{"bool": null, "i8": null, "u64": null, "f16": null, "f64": null, "str": null, "bin": null, "fsb": null, "dec": null, "ts": 1, "d32": null, "d64": null, "t32": null, "t64": null, "dur": null, "months": null, "daytime": null, "list": null, "fsl": null, "struct": null, "map": null}
+`
+
+	r := json.NewReader(strings.NewReader(input), schema, json.WithAllocator(mem), json.WithChunk(-1))
+	defer r.Release()
+
+	r.Retain()
+	r.Release()
+
+	if !r.Next() {
+		t.Fatalf("could not read record: %v", r.Err())
+	}
+	rec := r.Record()
+	if got, want := rec.NumRows(), int64(4); got != want {
+		t.Fatalf("invalid number of rows: got=%d, want=%d", got, want)
+	}
+
+	want := []string{
+		`[true false (null) (null)]`,
+		`[-1 2 (null) (null)]`,
+		`[18446744073709551615 0 (null) (null)]`,
+		`[1.5 -2 (null) (null)]`,
+		`[1000 0.5 (null) (null)]`,
+		`["hello" "{\"k\":[1,\"v\"]}" (null) (null)]`,
+		`["\x01\x02" "" (null) (null)]`,
+		`["\x01\x02" "\x03\x04" (null) (null)]`,
+		`[{18446744073709550382 -1} {500 0} (null) (null)]`,
+		`[1577934245 1577930645 (null) 1]`,
+		`[18263 1 (null) (null)]`,
+		`[86400000 86400000 (null) (null)]`,
+		`[3723 60 (null) (null)]`,
+		`[1500000 1 (null) (null)]`,
+		`[1500 10 (null) (null)]`,
+		`[3 -1 (null) (null)]`,
+		`[{1 2} {-1 0} (null) (null)]`,
+		`[[1 (null) 3] [] (null) (null)]`,
+		`[[1 2] [(null) 4] (null) (null)]`,
+		`{[1 (null) (null) (null)] ["x" "y" (null) (null)]}`,
+		`[{["z" "y"] [1 (null)]} {["k"] [7]} (null) (null)]`,
+	}
+	for i, col := range rec.Columns() {
+		if got, want := fmt.Sprintf("%v", col), want[i]; got != want {
+			t.Errorf("invalid column %q: got=%s, want=%s", rec.ColumnName(i), got, want)
+		}
+	}
+
+	if got, want := rec.Column(19).NullN(), 2; got != want {
+		t.Errorf("invalid number of null structs: got=%d, want=%d", got, want)
+	}
+
+	if r.Next() {
+		t.Fatalf("unexpected record")
+	}
+	if err := r.Err(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestReaderChunk(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	schema := arrow.NewSchema([]arrow.Field{{Name: "i", Type: arrow.PrimitiveTypes.Int64}}, nil)
+	input := "{\"i\": 1}\n{\"i\": 2}\n\n{\"i\": 3}\n"
+
+	for _, tc := range []struct {
+		chunk int
+		want  []string
+	}{
+		{0, []string{"[1]", "[2]", "[3]"}},
+		{1, []string{"[1]", "[2]", "[3]"}},
+		{2, []string{"[1 2]", "[3]"}},
+		{3, []string{"[1 2 3]"}},
+		{-1, []string{"[1 2 3]"}},
+	} {
+		t.Run(fmt.Sprintf("chunk=%d", tc.chunk), func(t *testing.T) {
+			r := json.NewReader(strings.NewReader(input), schema, json.WithAllocator(mem), json.WithChunk(tc.chunk))
+			defer r.Release()
+
+			var got []string
+			for {
+				rec, err := r.Read()
+				if err == io.EOF {
+					break
+				}
+				if err != nil {
+					t.Fatalf("could not read record %d: %v", len(got), err)
+				}
+				got = append(got, fmt.Sprintf("%v", rec.Column(0)))
+			}
+
+			if got, want := strings.Join(got, ","), strings.Join(tc.want, ","); got != want {
+				t.Fatalf("invalid records: got=%s, want=%s", got, want)
+			}
+		})
+	}
+}
+
+func TestReaderNullStruct(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	schema := arrow.NewSchema([]arrow.Field{
+		{Name: "s", Type: arrow.StructOf(
+			arrow.Field{Name: "a", Type: arrow.PrimitiveTypes.Int64, Nullable: true},
+			arrow.Field{Name: "fsl", Type: arrow.FixedSizeListOf(2, arrow.PrimitiveTypes.Int64), Nullable: true},
+		), Nullable: true},
+	}, nil)
+	input := `{"s": null}
+{"s": {"a": 1, "fsl": [2, 3]}}
+{"s": {"a": 4, "fsl": [5, 6]}}
+`
+
+	r := json.NewReader(strings.NewReader(input), schema, json.WithAllocator(mem), json.WithChunk(-1))
+	defer r.Release()
+
+	if !r.Next() {
+		t.Fatalf("could not read record: %v", r.Err())
+	}
+	st := r.Record().Column(0).(*array.Struct)
+
+	if got, want := st.NullN(), 1; got != want {
+		t.Errorf("invalid number of nulls: got=%d, want=%d", got, want)
+	}
+	for i, want := range []string{"[(null) 1 4]", "[(null) [2 3] [5 6]]"} {
+		if got := fmt.Sprintf("%v", st.Field(i)); got != want {
+			t.Errorf("invalid field %d: got=%s, want=%s", i, got, want)
+		}
+	}
+}
+
+func TestInferringReader(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	input := `{"i": 1, "f": 1, "s": "a", "b": true, "d": "2020-01-02", "ts": "2020-01-02", "n": null, "mixed": 1, "list": [1, 2], "obj": {"x": 1}}
+{"i": 2, "f": 1.5, "s": "b", "b": false, "d": null, "ts": "2020-01-02T03:04:05Z", "n": null, "mixed": "one", "list": [], "obj": {"y": [true]}, "late": 1}
+{"i": 3, "f": 2, "s": null, "b": null, "d": "2020-01-03", "ts": null, "n": null, "mixed": [1], "list": null, "obj": null, "late": 2.5}
+`
+
+	r := json.NewInferringReader(strings.NewReader(input), json.WithAllocator(mem), json.WithChunk(-1), json.WithInferRows(2))
+	defer r.Release()
+
+	want := arrow.NewSchema(
+		[]arrow.Field{
+			{Name: "i", Type: arrow.PrimitiveTypes.Int64, Nullable: true},
+			{Name: "f", Type: arrow.PrimitiveTypes.Float64, Nullable: true},
+			{Name: "s", Type: arrow.BinaryTypes.String, Nullable: true},
+			{Name: "b", Type: arrow.FixedWidthTypes.Boolean, Nullable: true},
+			{Name: "d", Type: arrow.FixedWidthTypes.Date32, Nullable: true},
+			{Name: "ts", Type: arrow.FixedWidthTypes.Timestamp_ns, Nullable: true},
+			{Name: "n", Type: arrow.BinaryTypes.String, Nullable: true},
+			{Name: "mixed", Type: arrow.BinaryTypes.String, Nullable: true},
+			{Name: "list", Type: arrow.ListOf(arrow.PrimitiveTypes.Int64), Nullable: true},
+			{Name: "obj", Type: arrow.StructOf(
+				arrow.Field{Name: "x", Type: arrow.PrimitiveTypes.Int64, Nullable: true},
+				arrow.Field{Name: "y", Type: arrow.ListOf(arrow.FixedWidthTypes.Boolean), Nullable: true},
+			), Nullable: true},
+			{Name: "late", Type: arrow.PrimitiveTypes.Int64, Nullable: true},
+		},
+		nil,
+	)
+	if got := r.Schema(); !got.Equal(want) {
+		t.Fatalf("invalid schema:\ngot=%v\nwant=%v", got, want)
+	}
+
+	if r.Next() {
+		t.Fatalf("unexpected record")
+	}
+	if r.Err() == nil {
+		t.Fatalf("expected an error for the row after the sampled ones")
+	}
+	if got, want := r.Err().Error(), `arrow/json: row 3, field "late": strconv.ParseInt: parsing "2.5": invalid syntax`; got != want {
+		t.Fatalf("invalid error: got=%q, want=%q", got, want)
+	}
+
+	r = json.NewInferringReader(strings.NewReader(input), json.WithAllocator(mem), json.WithChunk(-1))
+	defer r.Release()
+
+	if !r.Next() {
+		t.Fatalf("could not read record: %v", r.Err())
+	}
+	rec := r.Record()
+	for i, want := range []string{
+		`[1 2 3]`,
+		`[1 1.5 2]`,
+		`["a" "b" (null)]`,
+		`[true false (null)]`,
+		`[18263 (null) 18264]`,
+		`[1577923200000000000 1577934245000000000 (null)]`,
+		`[(null) (null) (null)]`,
+		`["1" "one" "[1]"]`,
+		`[[1 2] [] (null)]`,
+		`{[1 (null) (null)] [(null) [true] (null)]}`,
+		`[(null) 1 2.5]`,
+	} {
+		if got := fmt.Sprintf("%v", rec.Column(i)); got != want {
+			t.Errorf("invalid column %q: got=%s, want=%s", rec.ColumnName(i), got, want)
+		}
+	}
+}
+
+func TestInferringReaderEmpty(t *testing.T) {
+	r := json.NewInferringReader(strings.NewReader(""))
+	defer r.Release()
+
+	if got, want := len(r.Schema().Fields()), 0; got != want {
+		t.Fatalf("invalid number of fields: got=%d, want=%d", got, want)
+	}
+	if r.Next() {
+		t.Fatalf("unexpected record")
+	}
+	if err := r.Err(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestReaderErrors(t *testing.T) {
+	schema := arrow.NewSchema(
+		[]arrow.Field{
+			{Name: "i", Type: arrow.PrimitiveTypes.Int8, Nullable: true},
+			{Name: "ts", Type: arrow.FixedWidthTypes.Timestamp_s, Nullable: true},
+			{Name: "dec", Type: &arrow.Decimal128Type{Precision: 4, Scale: 2}, Nullable: true},
+			{Name: "fsl", Type: arrow.FixedSizeListOf(2, arrow.PrimitiveTypes.Int8), Nullable: true},
+			{Name: "st", Type: arrow.StructOf(arrow.Field{Name: "a", Type: arrow.FixedWidthTypes.Boolean, Nullable: true}), Nullable: true},
+		},
+		nil,
+	)
+
+	for _, tc := range []struct {
+		input string
+		want  string
+	}{
+		{`{"i": 1}` + "\n" + `{"i": 128}`, `arrow/json: row 2, field "i": strconv.ParseInt: parsing "128": value out of range`},
+		{`{"i": "1"}`, `arrow/json: row 1, field "i": invalid JSON string, want number`},
+		{`{"ts": "yesterday"}`, `arrow/json: row 1, field "ts": could not parse timestamp "yesterday"`},
+		{`{"fsl": [1]}`, `arrow/json: row 1, field "fsl": invalid fixed-size list length (got=1, want=2)`},
+		{`{"st": {"a": 1}}`, `arrow/json: row 1, field "st": field "a": invalid JSON number, want boolean`},
+		{`[1]`, `arrow/json: row 1: invalid JSON array, want object`},
+		{`{"i": 1`, `arrow/json: row 1: could not decode JSON: `},
+		{`{"i": 1}` + "\n" + `{"i": }`, `arrow/json: row 2: could not decode JSON: `},
+	} {
+		t.Run("", func(t *testing.T) {
+			mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+			defer mem.AssertSize(t, 0)
+
+			r := json.NewReader(strings.NewReader(tc.input), schema, json.WithAllocator(mem), json.WithChunk(-1))
+			defer r.Release()
+
+			_, err := r.Read()
+			if err == nil {
+				t.Fatalf("expected an error")
+			}
+			if got := err.Error(); !strings.HasPrefix(got, tc.want) {
+				t.Fatalf("invalid error: got=%q, want=%q", got, tc.want)
+			}
+			if r.Next() {
+				t.Fatalf("unexpected record after an error")
+			}
+		})
+	}
+}
+
+func TestReaderInvalidType(t *testing.T) {
+	schema := arrow.NewSchema(
+		[]arrow.Field{{Name: "ts", Type: &arrow.TimestampType{Unit: arrow.Second, TimeZone: "Nowhere/Void"}}},
+		nil,
+	)
+
+	defer func() {
+		e := recover()
+		if e == nil {
+			t.Fatalf("expected a panic")
+		}
+	}()
+
+	json.NewReader(strings.NewReader(""), schema)
+}
+
+var (
+	_ array.RecordReader = (*json.Reader)(nil)
+)
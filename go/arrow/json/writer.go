@@ -0,0 +1,297 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package json
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/arrio"
+	"github.com/apache/arrow/go/arrow/internal/arrtime"
+	"github.com/apache/arrow/go/arrow/internal/jsonenc"
+	"golang.org/x/xerrors"
+)
+
+// Writer writes array.Records as JSON objects, one per row, either as a
+// newline-delimited JSON file or as a JSON array of objects.
+//
+// Values are written in the representation read by Reader:
+//   - structs are written as objects, and lists and fixed-size lists as arrays.
+//   - timestamps are written as RFC3339 strings in the time zone of their type,
+//     or in UTC.
+//   - dates are written as "2006-01-02" strings and times as
+//     "15:04:05.999999999" strings.
+//   - decimals are written as strings holding their exact value.
+//   - binary and fixed-size binary values are written as base64 strings.
+//   - durations and month intervals are written as numbers, and day-time
+//     intervals as {"days": d, "milliseconds": ms} objects.
+//   - floating-point NaN and infinite values, which are not valid JSON
+//     numbers, are written as the "NaN", "+Inf" and "-Inf" strings.
+type Writer struct {
+	w      io.Writer
+	schema *arrow.Schema
+	array  bool
+	nrows  int
+	closed bool
+	buf    []byte
+
+	names        [][]byte // JSON-encoded names of the fields
+	fieldEncoder []jsonenc.Encoder
+}
+
+// NewWriter returns a writer that writes array.Records to the JSON file
+// with the given schema.
+//
+// NewWriter panics if the given schema contains fields that have types that
+// cannot be written.
+func NewWriter(w io.Writer, schema *arrow.Schema, opts ...Option) *Writer {
+	validate(schema)
+
+	ww := &Writer{
+		w:      w,
+		schema: schema,
+	}
+	for _, opt := range opts {
+		opt(ww)
+	}
+
+	ww.names = make([][]byte, len(schema.Fields()))
+	ww.fieldEncoder = make([]jsonenc.Encoder, len(schema.Fields()))
+	for i, field := range schema.Fields() {
+		ww.names[i], _ = json.Marshal(field.Name)
+		ww.fieldEncoder[i] = jsonenc.New(field.Type, newLeafEncoder)
+	}
+
+	return ww
+}
+
+func (w *Writer) Schema() *arrow.Schema { return w.schema }
+
+// Write writes the rows of a single Record to the JSON file.
+func (w *Writer) Write(rec array.Record) error {
+	if w.closed {
+		return xerrors.Errorf("arrow/json: write to closed writer")
+	}
+	if !rec.Schema().Equal(w.schema) {
+		return xerrors.Errorf("arrow/json: record schema does not match writer schema")
+	}
+
+	buf := w.buf[:0]
+	for i := 0; i < int(rec.NumRows()); i++ {
+		switch {
+		case !w.array:
+		case w.nrows == 0:
+			buf = append(buf, "[\n"...)
+		default:
+			buf = append(buf, ",\n"...)
+		}
+
+		buf = append(buf, '{')
+		for j, col := range rec.Columns() {
+			if j > 0 {
+				buf = append(buf, ',')
+			}
+			buf = append(buf, w.names[j]...)
+			buf = append(buf, ':')
+			buf = w.fieldEncoder[j](buf, col, i)
+		}
+		buf = append(buf, '}')
+
+		if !w.array {
+			buf = append(buf, '\n')
+		}
+		w.nrows++
+	}
+	w.buf = buf
+
+	if len(buf) == 0 {
+		return nil
+	}
+	_, err := w.w.Write(buf)
+	if err != nil {
+		return xerrors.Errorf("arrow/json: could not write record: %w", err)
+	}
+	return nil
+}
+
+// Close terminates the JSON array of objects, when the writer is configured
+// with WithArray. It does not close the underlying io.Writer.
+func (w *Writer) Close() error {
+	if w.closed {
+		return nil
+	}
+	w.closed = true
+
+	if !w.array {
+		return nil
+	}
+
+	end := "\n]\n"
+	if w.nrows == 0 {
+		end = "[]\n"
+	}
+	_, err := io.WriteString(w.w, end)
+	if err != nil {
+		return xerrors.Errorf("arrow/json: could not close JSON array: %w", err)
+	}
+	return nil
+}
+
+// newLeafEncoder returns the function encoding valid values of the given
+// data type, other than nested and floating-point ones.
+func newLeafEncoder(dt arrow.DataType) jsonenc.Encoder {
+	var enc jsonenc.Encoder
+	switch dt := dt.(type) {
+	case *arrow.NullType:
+		enc = func(dst []byte, arr array.Interface, i int) []byte {
+			return append(dst, "null"...)
+		}
+	case *arrow.BooleanType:
+		enc = func(dst []byte, arr array.Interface, i int) []byte {
+			return strconv.AppendBool(dst, arr.(*array.Boolean).Value(i))
+		}
+	case *arrow.Int8Type:
+		enc = func(dst []byte, arr array.Interface, i int) []byte {
+			return strconv.AppendInt(dst, int64(arr.(*array.Int8).Value(i)), 10)
+		}
+	case *arrow.Int16Type:
+		enc = func(dst []byte, arr array.Interface, i int) []byte {
+			return strconv.AppendInt(dst, int64(arr.(*array.Int16).Value(i)), 10)
+		}
+	case *arrow.Int32Type:
+		enc = func(dst []byte, arr array.Interface, i int) []byte {
+			return strconv.AppendInt(dst, int64(arr.(*array.Int32).Value(i)), 10)
+		}
+	case *arrow.Int64Type:
+		enc = func(dst []byte, arr array.Interface, i int) []byte {
+			return strconv.AppendInt(dst, arr.(*array.Int64).Value(i), 10)
+		}
+	case *arrow.Uint8Type:
+		enc = func(dst []byte, arr array.Interface, i int) []byte {
+			return strconv.AppendUint(dst, uint64(arr.(*array.Uint8).Value(i)), 10)
+		}
+	case *arrow.Uint16Type:
+		enc = func(dst []byte, arr array.Interface, i int) []byte {
+			return strconv.AppendUint(dst, uint64(arr.(*array.Uint16).Value(i)), 10)
+		}
+	case *arrow.Uint32Type:
+		enc = func(dst []byte, arr array.Interface, i int) []byte {
+			return strconv.AppendUint(dst, uint64(arr.(*array.Uint32).Value(i)), 10)
+		}
+	case *arrow.Uint64Type:
+		enc = func(dst []byte, arr array.Interface, i int) []byte {
+			return strconv.AppendUint(dst, arr.(*array.Uint64).Value(i), 10)
+		}
+	case *arrow.Decimal128Type:
+		enc = func(dst []byte, arr array.Interface, i int) []byte {
+			return strconv.AppendQuote(dst, arr.(*array.Decimal128).Value(i).ToString(dt.Scale))
+		}
+	case *arrow.StringType:
+		enc = func(dst []byte, arr array.Interface, i int) []byte {
+			return appendString(dst, arr.(*array.String).Value(i))
+		}
+	case *arrow.BinaryType:
+		enc = func(dst []byte, arr array.Interface, i int) []byte {
+			return appendBase64(dst, arr.(*array.Binary).Value(i))
+		}
+	case *arrow.FixedSizeBinaryType:
+		enc = func(dst []byte, arr array.Interface, i int) []byte {
+			return appendBase64(dst, arr.(*array.FixedSizeBinary).Value(i))
+		}
+	case *arrow.TimestampType:
+		loc := time.UTC
+		if dt.TimeZone != "" {
+			// time zone validity has been checked by validate.
+			loc, _ = time.LoadLocation(dt.TimeZone)
+		}
+		enc = func(dst []byte, arr array.Interface, i int) []byte {
+			t := arrtime.ToTime(int64(arr.(*array.Timestamp).Value(i)), dt.Unit).In(loc)
+			dst = append(dst, '"')
+			dst = t.AppendFormat(dst, time.RFC3339Nano)
+			return append(dst, '"')
+		}
+	case *arrow.Date32Type:
+		enc = func(dst []byte, arr array.Interface, i int) []byte {
+			days := int64(arr.(*array.Date32).Value(i))
+			return appendTime(dst, time.Unix(days*arrtime.SecondsPerDay, 0), arrtime.DateLayout)
+		}
+	case *arrow.Date64Type:
+		enc = func(dst []byte, arr array.Interface, i int) []byte {
+			ms := int64(arr.(*array.Date64).Value(i))
+			return appendTime(dst, arrtime.ToTime(ms, arrow.Millisecond), arrtime.DateLayout)
+		}
+	case *arrow.Time32Type:
+		enc = func(dst []byte, arr array.Interface, i int) []byte {
+			v := int64(arr.(*array.Time32).Value(i))
+			return appendTime(dst, arrtime.ToTime(v, dt.Unit), timeFormat)
+		}
+	case *arrow.Time64Type:
+		enc = func(dst []byte, arr array.Interface, i int) []byte {
+			v := int64(arr.(*array.Time64).Value(i))
+			return appendTime(dst, arrtime.ToTime(v, dt.Unit), timeFormat)
+		}
+	case *arrow.DurationType:
+		enc = func(dst []byte, arr array.Interface, i int) []byte {
+			return strconv.AppendInt(dst, int64(arr.(*array.Duration).Value(i)), 10)
+		}
+	case *arrow.MonthIntervalType:
+		enc = func(dst []byte, arr array.Interface, i int) []byte {
+			return strconv.AppendInt(dst, int64(arr.(*array.MonthInterval).Value(i)), 10)
+		}
+	case *arrow.DayTimeIntervalType:
+		enc = func(dst []byte, arr array.Interface, i int) []byte {
+			v := arr.(*array.DayTimeInterval).Value(i)
+			dst = append(dst, `{"days":`...)
+			dst = strconv.AppendInt(dst, int64(v.Days), 10)
+			dst = append(dst, `,"milliseconds":`...)
+			dst = strconv.AppendInt(dst, int64(v.Milliseconds), 10)
+			return append(dst, '}')
+		}
+	default:
+		// data types have been checked by validate.
+		panic(xerrors.Errorf("arrow/json: unhandled data type %T", dt))
+	}
+	return enc
+}
+
+func appendString(dst []byte, v string) []byte {
+	str, _ := json.Marshal(v)
+	return append(dst, str...)
+}
+
+func appendBase64(dst []byte, v []byte) []byte {
+	dst = append(dst, '"')
+	dst = append(dst, base64.StdEncoding.EncodeToString(v)...)
+	return append(dst, '"')
+}
+
+func appendTime(dst []byte, t time.Time, layout string) []byte {
+	dst = append(dst, '"')
+	dst = t.UTC().AppendFormat(dst, layout)
+	return append(dst, '"')
+}
+
+const timeFormat = "15:04:05.999999999"
+
+var (
+	_ arrio.Writer = (*Writer)(nil)
+)
@@ -0,0 +1,246 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package json_test
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/arrio"
+	"github.com/apache/arrow/go/arrow/float16"
+	"github.com/apache/arrow/go/arrow/json"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+func TestWriter(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	schema := arrow.NewSchema(
+		[]arrow.Field{
+			{Name: "bool", Type: arrow.FixedWidthTypes.Boolean, Nullable: true},
+			{Name: "i8", Type: arrow.PrimitiveTypes.Int8, Nullable: true},
+			{Name: "u64", Type: arrow.PrimitiveTypes.Uint64, Nullable: true},
+			{Name: "f16", Type: arrow.FixedWidthTypes.Float16, Nullable: true},
+			{Name: "f64", Type: arrow.PrimitiveTypes.Float64, Nullable: true},
+			{Name: "str", Type: arrow.BinaryTypes.String, Nullable: true},
+			{Name: "bin", Type: arrow.BinaryTypes.Binary, Nullable: true},
+			{Name: "fsb", Type: &arrow.FixedSizeBinaryType{ByteWidth: 2}, Nullable: true},
+			{Name: "dec", Type: &arrow.Decimal128Type{Precision: 10, Scale: 2}, Nullable: true},
+			{Name: "ts", Type: &arrow.TimestampType{Unit: arrow.Millisecond, TimeZone: "Europe/Paris"}, Nullable: true},
+			{Name: "d32", Type: arrow.FixedWidthTypes.Date32, Nullable: true},
+			{Name: "d64", Type: arrow.FixedWidthTypes.Date64, Nullable: true},
+			{Name: "t32", Type: arrow.FixedWidthTypes.Time32s, Nullable: true},
+			{Name: "t64", Type: arrow.FixedWidthTypes.Time64us, Nullable: true},
+			{Name: "dur", Type: arrow.FixedWidthTypes.Duration_ms, Nullable: true},
+			{Name: "months", Type: arrow.FixedWidthTypes.MonthInterval, Nullable: true},
+			{Name: "daytime", Type: arrow.FixedWidthTypes.DayTimeInterval, Nullable: true},
+			{Name: "list", Type: arrow.ListOf(arrow.PrimitiveTypes.Int32), Nullable: true},
+			{Name: "fsl", Type: arrow.FixedSizeListOf(2, arrow.PrimitiveTypes.Int32), Nullable: true},
+			{Name: "struct", Type: arrow.StructOf(
+				arrow.Field{Name: "a", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
+				arrow.Field{Name: "b", Type: arrow.BinaryTypes.String, Nullable: true},
+			), Nullable: true},
+		},
+		nil,
+	)
+
+	// rows are written in the representation read by Reader.
+	want := `{"bool":true,"i8":-1,"u64":18446744073709551615,"f16":1.5,"f64":1000,"str":"hello \"you\"","bin":"AQI=","fsb":"AQI=","dec":"-12.34","ts":"2020-01-02T04:04:05.5+01:00","d32":"2020-01-02","d64":"1969-12-31","t32":"01:02:03","t64":"00:00:01.5","dur":1500,"months":3,"daytime":{"days":1,"milliseconds":2},"list":[1,null,3],"fsl":[1,2],"struct":{"a":1,"b":"x"}}
+{"bool":false,"i8":2,"u64":0,"f16":-2,"f64":1e-07,"str":"","bin":"","fsb":"AwQ=","dec":"5.00","ts":"1970-01-01T01:00:00+01:00","d32":"1970-01-02","d64":"1970-01-02","t32":"00:01:00","t64":"00:00:00.000001","dur":-10,"months":-1,"daytime":{"days":-1,"milliseconds":0},"list":[],"fsl":[null,4],"struct":{"a":null,"b":"y"}}
+{"bool":null,"i8":null,"u64":null,"f16":null,"f64":null,"str":null,"bin":null,"fsb":null,"dec":null,"ts":null,"d32":null,"d64":null,"t32":null,"t64":null,"dur":null,"months":null,"daytime":null,"list":null,"fsl":null,"struct":null}
+`
+
+	r := json.NewReader(strings.NewReader(want), schema, json.WithAllocator(mem), json.WithChunk(2))
+	defer r.Release()
+
+	out := new(bytes.Buffer)
+	w := json.NewWriter(out, schema)
+	n, err := arrio.Copy(w, r)
+	if err != nil {
+		t.Fatalf("could not copy records: %v", err)
+	}
+	if got, want := n, int64(2); got != want {
+		t.Fatalf("invalid number of records: got=%d, want=%d", got, want)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := out.String(); got != want {
+		t.Fatalf("invalid output:\ngot:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestWriterArray(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	schema := arrow.NewSchema(
+		[]arrow.Field{
+			{Name: "f", Type: arrow.PrimitiveTypes.Float64, Nullable: true},
+			{Name: "s", Type: arrow.BinaryTypes.String, Nullable: true},
+		},
+		nil,
+	)
+
+	b := array.NewRecordBuilder(mem, schema)
+	defer b.Release()
+
+	b.Field(0).(*array.Float64Builder).AppendValues([]float64{math.NaN(), math.Inf(1), math.Inf(-1), 0.25}, nil)
+	b.Field(1).(*array.StringBuilder).AppendValues([]string{"a", "b", "c", "d"}, []bool{true, true, false, true})
+
+	rec := b.NewRecord()
+	defer rec.Release()
+
+	slice := rec.NewSlice(1, 4)
+	defer slice.Release()
+
+	for _, tc := range []struct {
+		name string
+		recs []array.Record
+		opts []json.Option
+		want string
+	}{
+		{
+			name: "ndjson",
+			recs: []array.Record{slice, rec},
+			want: `{"f":"+Inf","s":"b"}
+{"f":"-Inf","s":null}
+{"f":0.25,"s":"d"}
+{"f":"NaN","s":"a"}
+{"f":"+Inf","s":"b"}
+{"f":"-Inf","s":null}
+{"f":0.25,"s":"d"}
+`,
+		},
+		{
+			name: "array",
+			recs: []array.Record{slice, rec},
+			opts: []json.Option{json.WithArray(true)},
+			want: `[
+{"f":"+Inf","s":"b"},
+{"f":"-Inf","s":null},
+{"f":0.25,"s":"d"},
+{"f":"NaN","s":"a"},
+{"f":"+Inf","s":"b"},
+{"f":"-Inf","s":null},
+{"f":0.25,"s":"d"}
+]
+`,
+		},
+		{
+			name: "empty-array",
+			opts: []json.Option{json.WithArray(true)},
+			want: "[]\n",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			out := new(bytes.Buffer)
+			w := json.NewWriter(out, schema, tc.opts...)
+			for i, rec := range tc.recs {
+				if err := w.Write(rec); err != nil {
+					t.Fatalf("could not write record %d: %v", i, err)
+				}
+			}
+			if err := w.Close(); err != nil {
+				t.Fatal(err)
+			}
+			if err := w.Write(rec); err == nil {
+				t.Fatalf("expected an error writing to a closed writer")
+			}
+
+			if got := out.String(); got != tc.want {
+				t.Fatalf("invalid output:\ngot:\n%s\nwant:\n%s", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestWriterReaderSpecialFloats(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	schema := arrow.NewSchema(
+		[]arrow.Field{
+			{Name: "f16", Type: arrow.FixedWidthTypes.Float16, Nullable: true},
+			{Name: "f32", Type: arrow.PrimitiveTypes.Float32, Nullable: true},
+			{Name: "f64", Type: arrow.PrimitiveTypes.Float64, Nullable: true},
+		},
+		nil,
+	)
+
+	b := array.NewRecordBuilder(mem, schema)
+	defer b.Release()
+
+	vs := []float64{math.NaN(), math.Inf(1), math.Inf(-1), 1.5}
+	for _, v := range vs {
+		b.Field(0).(*array.Float16Builder).Append(float16.New(float32(v)))
+		b.Field(1).(*array.Float32Builder).Append(float32(v))
+		b.Field(2).(*array.Float64Builder).Append(v)
+	}
+
+	rec := b.NewRecord()
+	defer rec.Release()
+
+	var buf bytes.Buffer
+	w := json.NewWriter(&buf, schema)
+	if err := w.Write(rec); err != nil {
+		t.Fatalf("could not write record: %v", err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("could not close writer: %v", err)
+	}
+
+	r := json.NewReader(&buf, schema, json.WithAllocator(mem), json.WithChunk(-1))
+	defer r.Release()
+
+	if !r.Next() {
+		t.Fatalf("could not read record: %v", r.Err())
+	}
+	want := "[NaN +Inf -Inf 1.5]"
+	for i, col := range r.Record().Columns() {
+		if got := fmt.Sprintf("%v", col); got != want {
+			t.Errorf("invalid column %q: got=%s, want=%s", r.Record().ColumnName(i), got, want)
+		}
+	}
+}
+
+func TestWriterInvalidSchema(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	schema := arrow.NewSchema([]arrow.Field{{Name: "i", Type: arrow.PrimitiveTypes.Int64}}, nil)
+	other := arrow.NewSchema([]arrow.Field{{Name: "j", Type: arrow.PrimitiveTypes.Int64}}, nil)
+
+	b := array.NewRecordBuilder(mem, other)
+	defer b.Release()
+
+	b.Field(0).(*array.Int64Builder).Append(1)
+	rec := b.NewRecord()
+	defer rec.Release()
+
+	w := json.NewWriter(new(bytes.Buffer), schema)
+	if err := w.Write(rec); err == nil {
+		t.Fatalf("expected an error")
+	}
+}
@@ -0,0 +1,472 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package arrow
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"golang.org/x/xerrors"
+)
+
+// ParseDataType returns the data type described by s, in the format of the
+// String method of data types, e.g. "int64", "timestamp[ms, tz=UTC]",
+// "list<item: utf8>" or "struct<a: int32, b: fixed_size_binary[4]>".
+//
+// The fields of struct types are parsed as non-nullable and without metadata,
+// as their string form holds neither.
+func ParseDataType(s string) (DataType, error) {
+	p := &parser{s: strings.TrimSpace(s)}
+	dt, err := p.dataType()
+	if err != nil {
+		return nil, err
+	}
+	if err := p.end(); err != nil {
+		return nil, err
+	}
+	return dt, nil
+}
+
+// ParseField returns the field described by s, in the format of the String
+// method of Field, e.g. "f1: type=int32, nullable".
+// The field metadata, if any, follows on the next line.
+func ParseField(s string) (Field, error) {
+	var (
+		field Field
+		sep   = ": type="
+		i     = strings.Index(s, sep)
+	)
+	if i < 0 {
+		return field, xerrors.Errorf("arrow: could not parse field %q: missing %q", s, sep)
+	}
+	field.Name = s[:i]
+
+	p := &parser{s: strings.TrimRight(s, " \t\r\n"), pos: i + len(sep)}
+	dt, err := p.dataType()
+	if err != nil {
+		return field, err
+	}
+	field.Type = dt
+	field.Nullable = p.consume(", nullable")
+
+	if p.consume("\n") {
+		p.skipSpaces()
+		if err := p.expect("metadata: "); err != nil {
+			return field, err
+		}
+		field.Metadata, err = p.metadata()
+		if err != nil {
+			return field, err
+		}
+	}
+
+	if err := p.end(); err != nil {
+		return field, err
+	}
+	return field, nil
+}
+
+// ParseSchema returns the schema described by s, in the format of the String
+// method of Schema:
+//
+//	schema:
+//	  fields: 2
+//	    - f1: type=int32
+//	    - f2: type=list<item: utf8>, nullable
+//	  metadata: ["k": "v"]
+func ParseSchema(s string) (*Schema, error) {
+	lines := strings.Split(strings.TrimSpace(s), "\n")
+	if strings.TrimSpace(lines[0]) != "schema:" {
+		return nil, xerrors.Errorf("arrow: could not parse schema: missing %q header", "schema:")
+	}
+	if len(lines) < 2 || !strings.HasPrefix(strings.TrimSpace(lines[1]), "fields: ") {
+		return nil, xerrors.Errorf("arrow: could not parse schema: missing number of fields")
+	}
+	n, err := strconv.Atoi(strings.TrimPrefix(strings.TrimSpace(lines[1]), "fields: "))
+	if err != nil {
+		return nil, xerrors.Errorf("arrow: could not parse schema number of fields: %w", err)
+	}
+
+	var (
+		fields []string
+		meta   *Metadata
+	)
+	for i, line := range lines[2:] {
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case strings.HasPrefix(trimmed, "- "):
+			fields = append(fields, strings.TrimPrefix(strings.TrimLeft(line, " \t"), "- "))
+		case strings.HasPrefix(line, "  metadata: ") && i == len(lines)-3:
+			p := &parser{s: strings.TrimRight(line, " \t\r"), pos: len("  metadata: ")}
+			md, err := p.metadata()
+			if err != nil {
+				return nil, err
+			}
+			if err := p.end(); err != nil {
+				return nil, err
+			}
+			meta = &md
+		case strings.HasPrefix(trimmed, "metadata: ") && len(fields) > 0:
+			fields[len(fields)-1] += "\n" + line
+		default:
+			return nil, xerrors.Errorf("arrow: could not parse schema: invalid line %q", line)
+		}
+	}
+
+	if len(fields) != n {
+		return nil, xerrors.Errorf("arrow: could not parse schema: got %d fields, want %d", len(fields), n)
+	}
+
+	fs := make([]Field, len(fields))
+	for i, f := range fields {
+		fs[i], err = ParseField(f)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return NewSchema(fs, meta), nil
+}
+
+var parsedTypes = map[string]DataType{
+	"null":              Null,
+	"bool":              FixedWidthTypes.Boolean,
+	"int8":              PrimitiveTypes.Int8,
+	"int16":             PrimitiveTypes.Int16,
+	"int32":             PrimitiveTypes.Int32,
+	"int64":             PrimitiveTypes.Int64,
+	"uint8":             PrimitiveTypes.Uint8,
+	"uint16":            PrimitiveTypes.Uint16,
+	"uint32":            PrimitiveTypes.Uint32,
+	"uint64":            PrimitiveTypes.Uint64,
+	"float16":           FixedWidthTypes.Float16,
+	"float32":           PrimitiveTypes.Float32,
+	"float64":           PrimitiveTypes.Float64,
+	"date32":            PrimitiveTypes.Date32,
+	"date64":            PrimitiveTypes.Date64,
+	"month_interval":    FixedWidthTypes.MonthInterval,
+	"day_time_interval": FixedWidthTypes.DayTimeInterval,
+	"binary":            BinaryTypes.Binary,
+	"utf8":              BinaryTypes.String,
+	"large_binary":      BinaryTypes.LargeBinary,
+	"large_utf8":        BinaryTypes.LargeString,
+}
+
+var parsedUnits = map[string]TimeUnit{
+	"ns": Nanosecond,
+	"us": Microsecond,
+	"ms": Millisecond,
+	"s":  Second,
+}
+
+// parser is a recursive descent parser for the string forms of data types.
+type parser struct {
+	s   string
+	pos int
+}
+
+func (p *parser) errorf(format string, args ...interface{}) error {
+	return xerrors.Errorf("arrow: could not parse %q at offset %d: %s", p.s, p.pos, xerrors.Errorf(format, args...))
+}
+
+func (p *parser) skipSpaces() {
+	for p.pos < len(p.s) && p.s[p.pos] == ' ' {
+		p.pos++
+	}
+}
+
+// consume consumes lit if the input continues with it.
+func (p *parser) consume(lit string) bool {
+	if !strings.HasPrefix(p.s[p.pos:], lit) {
+		return false
+	}
+	p.pos += len(lit)
+	return true
+}
+
+func (p *parser) expect(lit string) error {
+	if !p.consume(lit) {
+		return p.errorf("expected %q", lit)
+	}
+	return nil
+}
+
+func (p *parser) end() error {
+	if p.pos != len(p.s) {
+		return p.errorf("unexpected trailing characters")
+	}
+	return nil
+}
+
+// until returns the input up to the first occurrence of one of the bytes
+// of set, and consumes it.
+func (p *parser) until(set string) string {
+	beg := p.pos
+	for p.pos < len(p.s) && strings.IndexByte(set, p.s[p.pos]) < 0 {
+		p.pos++
+	}
+	return p.s[beg:p.pos]
+}
+
+func (p *parser) ident() string {
+	beg := p.pos
+	for p.pos < len(p.s) {
+		c := p.s[p.pos]
+		if !('a' <= c && c <= 'z' || '0' <= c && c <= '9' || c == '_') {
+			break
+		}
+		p.pos++
+	}
+	return p.s[beg:p.pos]
+}
+
+func (p *parser) int() (int, error) {
+	beg := p.pos
+	for p.pos < len(p.s) && '0' <= p.s[p.pos] && p.s[p.pos] <= '9' {
+		p.pos++
+	}
+	v, err := strconv.Atoi(p.s[beg:p.pos])
+	if err != nil {
+		p.pos = beg
+		return 0, p.errorf("expected an integer")
+	}
+	return v, nil
+}
+
+func (p *parser) unit() (TimeUnit, error) {
+	u, ok := parsedUnits[p.ident()]
+	if !ok {
+		return 0, p.errorf("invalid time unit")
+	}
+	return u, nil
+}
+
+// bracketed parses "[" v "]", v being parsed by fn.
+func (p *parser) bracketed(fn func() error) error {
+	if err := p.expect("["); err != nil {
+		return err
+	}
+	if err := fn(); err != nil {
+		return err
+	}
+	return p.expect("]")
+}
+
+func (p *parser) dataType() (DataType, error) {
+	beg := p.pos
+	name := p.ident()
+	if dt, ok := parsedTypes[name]; ok {
+		return dt, nil
+	}
+
+	var err error
+	switch name {
+	case "fixed_size_binary":
+		dt := &FixedSizeBinaryType{}
+		err = p.bracketed(func() (err error) {
+			dt.ByteWidth, err = p.int()
+			return err
+		})
+		return dt, err
+
+	case "timestamp":
+		dt := &TimestampType{}
+		err = p.bracketed(func() (err error) {
+			dt.Unit, err = p.unit()
+			if err != nil {
+				return err
+			}
+			if p.consume(", tz=") {
+				dt.TimeZone = p.until("]")
+			}
+			return nil
+		})
+		return dt, err
+
+	case "time32", "time64", "duration":
+		var unit TimeUnit
+		err = p.bracketed(func() (err error) {
+			beg := p.pos
+			unit, err = p.unit()
+			if err != nil {
+				return err
+			}
+			switch {
+			case name == "time32" && unit != Second && unit != Millisecond,
+				name == "time64" && unit != Microsecond && unit != Nanosecond:
+				p.pos = beg
+				return p.errorf("invalid time unit %v for %s", unit, name)
+			}
+			return nil
+		})
+		switch name {
+		case "time32":
+			return &Time32Type{Unit: unit}, err
+		case "time64":
+			return &Time64Type{Unit: unit}, err
+		default:
+			return &DurationType{Unit: unit}, err
+		}
+
+	case "decimal":
+		dt := &Decimal128Type{}
+		var prec, scale int
+		if err := p.expect("("); err != nil {
+			return nil, err
+		}
+		beg := p.pos
+		if prec, err = p.int(); err != nil {
+			return nil, err
+		}
+		if prec < 1 || prec > 38 {
+			p.pos = beg
+			return nil, p.errorf("invalid decimal precision %d", prec)
+		}
+		if err := p.expect(", "); err != nil {
+			return nil, err
+		}
+		if scale, err = p.int(); err != nil {
+			return nil, err
+		}
+		dt.Precision, dt.Scale = int32(prec), int32(scale)
+		return dt, p.expect(")")
+
+	case "list", "large_list", "fixed_size_list":
+		if err := p.expect("<item: "); err != nil {
+			return nil, err
+		}
+		elem, err := p.dataType()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(">"); err != nil {
+			return nil, err
+		}
+		switch name {
+		case "list":
+			return ListOf(elem), nil
+		case "large_list":
+			return LargeListOf(elem), nil
+		}
+		var n int
+		err = p.bracketed(func() (err error) {
+			beg := p.pos
+			n, err = p.int()
+			if err == nil && (n <= 0 || n > math.MaxInt32) {
+				p.pos = beg
+				err = p.errorf("invalid fixed size list size %d", n)
+			}
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+		return FixedSizeListOf(int32(n), elem), nil
+
+	case "struct":
+		if err := p.expect("<"); err != nil {
+			return nil, err
+		}
+		var (
+			fields []Field
+			seen   = make(map[string]bool)
+		)
+		for !p.consume(">") {
+			if len(fields) > 0 {
+				if err := p.expect(", "); err != nil {
+					return nil, err
+				}
+			}
+			beg := p.pos
+			name := p.until(":")
+			if seen[name] {
+				p.pos = beg
+				return nil, p.errorf("duplicate field %q", name)
+			}
+			seen[name] = true
+			if err := p.expect(": "); err != nil {
+				return nil, err
+			}
+			dt, err := p.dataType()
+			if err != nil {
+				return nil, err
+			}
+			fields = append(fields, Field{Name: name, Type: dt})
+		}
+		return StructOf(fields...), nil
+	}
+
+	p.pos = beg
+	return nil, p.errorf("unknown data type %q", name)
+}
+
+// metadata parses metadata in the format of the String method of Metadata,
+// e.g. ["k1": "v1", "k2": "v2"].
+func (p *parser) metadata() (Metadata, error) {
+	var keys, values []string
+	if err := p.expect("["); err != nil {
+		return Metadata{}, err
+	}
+	for !p.consume("]") {
+		if len(keys) > 0 {
+			if err := p.expect(", "); err != nil {
+				return Metadata{}, err
+			}
+		}
+		k, err := p.quoted()
+		if err != nil {
+			return Metadata{}, err
+		}
+		if err := p.expect(": "); err != nil {
+			return Metadata{}, err
+		}
+		v, err := p.quoted()
+		if err != nil {
+			return Metadata{}, err
+		}
+		keys = append(keys, k)
+		values = append(values, v)
+	}
+	return NewMetadata(keys, values), nil
+}
+
+// quoted parses a Go double-quoted string.
+func (p *parser) quoted() (string, error) {
+	beg := p.pos
+	if !p.consume(`"`) {
+		return "", p.errorf("expected a quoted string")
+	}
+	for p.pos < len(p.s) && p.s[p.pos] != '"' {
+		if p.s[p.pos] == '\\' {
+			if p.pos+1 >= len(p.s) {
+				break
+			}
+			p.pos++
+		}
+		p.pos++
+	}
+	if !p.consume(`"`) {
+		p.pos = beg
+		return "", p.errorf("unterminated quoted string")
+	}
+	v, err := strconv.Unquote(p.s[beg:p.pos])
+	if err != nil {
+		p.pos = beg
+		return "", p.errorf("invalid quoted string: %v", err)
+	}
+	return v, nil
+}
@@ -0,0 +1,187 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package arrow_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/internal/arrdata"
+)
+
+func TestParseDataType(t *testing.T) {
+	for _, dt := range []arrow.DataType{
+		arrow.Null,
+		arrow.FixedWidthTypes.Boolean,
+		arrow.PrimitiveTypes.Int8,
+		arrow.PrimitiveTypes.Uint64,
+		arrow.PrimitiveTypes.Float64,
+		arrow.FixedWidthTypes.Float16,
+		arrow.PrimitiveTypes.Date32,
+		arrow.FixedWidthTypes.MonthInterval,
+		arrow.FixedWidthTypes.DayTimeInterval,
+		arrow.BinaryTypes.Binary,
+		arrow.BinaryTypes.String,
+		arrow.BinaryTypes.LargeBinary,
+		arrow.BinaryTypes.LargeString,
+		&arrow.FixedSizeBinaryType{ByteWidth: 16},
+		&arrow.TimestampType{Unit: arrow.Millisecond},
+		&arrow.TimestampType{Unit: arrow.Nanosecond, TimeZone: "UTC"},
+		&arrow.TimestampType{Unit: arrow.Second, TimeZone: "Europe/Paris"},
+		&arrow.Time32Type{Unit: arrow.Millisecond},
+		&arrow.Time64Type{Unit: arrow.Microsecond},
+		&arrow.DurationType{Unit: arrow.Second},
+		&arrow.Decimal128Type{Precision: 38, Scale: 10},
+		arrow.ListOf(arrow.PrimitiveTypes.Int64),
+		arrow.LargeListOf(arrow.ListOf(arrow.BinaryTypes.String)),
+		arrow.FixedSizeListOf(3, arrow.PrimitiveTypes.Float32),
+		arrow.StructOf(),
+		arrow.StructOf(
+			arrow.Field{Name: "a", Type: arrow.PrimitiveTypes.Int32},
+			arrow.Field{Name: "b c", Type: arrow.StructOf(
+				arrow.Field{Name: "d", Type: arrow.ListOf(&arrow.TimestampType{Unit: arrow.Millisecond, TimeZone: "UTC"})},
+			)},
+		),
+	} {
+		t.Run(fmt.Sprint(dt), func(t *testing.T) {
+			got, err := arrow.ParseDataType(fmt.Sprint(dt))
+			if err != nil {
+				t.Fatalf("could not parse data type: %v", err)
+			}
+			if !arrow.TypeEqual(got, dt) {
+				t.Fatalf("invalid data type: got=%v, want=%v", got, dt)
+			}
+		})
+	}
+}
+
+func TestParseDataTypeErrors(t *testing.T) {
+	for _, s := range []string{
+		"",
+		"int33",
+		"int32>",
+		"fixed_size_binary[]",
+		"timestamp[ps]",
+		"decimal(10 2)",
+		"list<int32>",
+		"list<item: int32",
+		"fixed_size_list<item: int32>",
+		"struct<a int32>",
+		"struct<a: int32 b: int32>",
+		"struct<a: int32, a: int64>",
+		"fixed_size_list<item: int32>[0]",
+		"fixed_size_list<item: int32>[2147483648]",
+		"time32[us]",
+		"time32[ns]",
+		"time64[s]",
+		"time64[ms]",
+		"decimal(0, 0)",
+		"decimal(39, 2)",
+	} {
+		t.Run(s, func(t *testing.T) {
+			dt, err := arrow.ParseDataType(s)
+			if err == nil {
+				t.Fatalf("expected an error, got=%v", dt)
+			}
+		})
+	}
+}
+
+func TestParseField(t *testing.T) {
+	for _, f := range []arrow.Field{
+		{Name: "f1", Type: arrow.PrimitiveTypes.Int32},
+		{Name: "f2", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true},
+		{Name: "with spaces", Type: arrow.BinaryTypes.LargeString, Nullable: true},
+		{
+			Name:     "f3",
+			Type:     &arrow.TimestampType{Unit: arrow.Millisecond, TimeZone: "UTC"},
+			Metadata: arrow.NewMetadata([]string{"k1", `k"2`}, []string{"v1", "v, 2\n"}),
+		},
+	} {
+		t.Run(f.Name, func(t *testing.T) {
+			got, err := arrow.ParseField(f.String())
+			if err != nil {
+				t.Fatalf("could not parse field: %v", err)
+			}
+			if !got.Equal(f) {
+				t.Fatalf("invalid field: got=%v, want=%v", got, f)
+			}
+		})
+	}
+
+	for _, s := range []string{
+		"f1",
+		"f1: int32",
+		"f1: type=int32, nullable, nullable",
+		"f1: type=int32\n  metadata: [\"k\"]",
+		"f1: type=int32\n  metadata: [\"k\": \"v\"",
+		"f1: type=int32\n  metadata: [\"k\\",
+	} {
+		_, err := arrow.ParseField(s)
+		if err == nil {
+			t.Fatalf("expected an error parsing %q", s)
+		}
+	}
+}
+
+func TestParseSchema(t *testing.T) {
+	for name, recs := range arrdata.Records {
+		t.Run(name, func(t *testing.T) {
+			want := recs[0].Schema()
+			got, err := arrow.ParseSchema(want.String())
+			if err != nil {
+				t.Fatalf("could not parse schema: %v", err)
+			}
+			if !got.Equal(want) {
+				t.Fatalf("invalid schema:\ngot=%v\nwant=%v", got, want)
+			}
+		})
+	}
+
+	md := arrow.NewMetadata([]string{"k"}, []string{"v"})
+	want := arrow.NewSchema([]arrow.Field{
+		{Name: "a", Type: arrow.PrimitiveTypes.Int64, Metadata: md},
+		{Name: "b", Type: arrow.BinaryTypes.String, Nullable: true},
+	}, &md)
+
+	got, err := arrow.ParseSchema(want.String())
+	if err != nil {
+		t.Fatalf("could not parse schema: %v", err)
+	}
+	if !got.Equal(want) {
+		t.Fatalf("invalid schema:\ngot=%v\nwant=%v", got, want)
+	}
+	if got, want := got.Metadata().String(), md.String(); got != want {
+		t.Fatalf("invalid metadata: got=%v, want=%v", got, want)
+	}
+
+	for _, s := range []string{
+		"",
+		"schema:",
+		"schema:\n  fields: 2\n    - a: type=int32",
+		"schema:\n  fields: 1\n    - a: type=int33",
+		"schema:\n  fields: 1\n    - a: type=int32\n  oops",
+		"schema:\n  fields: 1\n    - a: type=int32\n  metadata: [\"k\\",
+		"schema:\n  fields: 1\n    - a: type=int32\n      metadata: [\"k\\",
+	} {
+		_, err := arrow.ParseSchema(s)
+		if err == nil {
+			t.Fatalf("expected an error parsing %q", s)
+		}
+	}
+}
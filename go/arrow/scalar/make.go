@@ -0,0 +1,775 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scalar
+
+import (
+	"reflect"
+	"time"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/decimal128"
+	"github.com/apache/arrow/go/arrow/float16"
+	"github.com/apache/arrow/go/arrow/internal/arrtime"
+	"github.com/apache/arrow/go/arrow/memory"
+	"golang.org/x/xerrors"
+)
+
+// MakeNullScalar returns a null scalar of the given data type.
+func MakeNullScalar(dtype arrow.DataType) Scalar {
+	s := scalar{Type: dtype}
+	switch dtype.ID() {
+	case arrow.NULL:
+		return &Null{scalar: s}
+	case arrow.BOOL:
+		return &Boolean{scalar: s}
+	case arrow.INT8:
+		return &Int8{scalar: s}
+	case arrow.INT16:
+		return &Int16{scalar: s}
+	case arrow.INT32:
+		return &Int32{scalar: s}
+	case arrow.INT64:
+		return &Int64{scalar: s}
+	case arrow.UINT8:
+		return &Uint8{scalar: s}
+	case arrow.UINT16:
+		return &Uint16{scalar: s}
+	case arrow.UINT32:
+		return &Uint32{scalar: s}
+	case arrow.UINT64:
+		return &Uint64{scalar: s}
+	case arrow.FLOAT16:
+		return &Float16{scalar: s}
+	case arrow.FLOAT32:
+		return &Float32{scalar: s}
+	case arrow.FLOAT64:
+		return &Float64{scalar: s}
+	case arrow.DECIMAL:
+		return &Decimal128{scalar: s}
+	case arrow.STRING, arrow.LARGE_STRING:
+		return &String{scalar: s}
+	case arrow.BINARY, arrow.LARGE_BINARY:
+		return &Binary{scalar: s}
+	case arrow.FIXED_SIZE_BINARY:
+		return &FixedSizeBinary{scalar: s}
+	case arrow.DATE32:
+		return &Date32{scalar: s}
+	case arrow.DATE64:
+		return &Date64{scalar: s}
+	case arrow.TIME32:
+		return &Time32{scalar: s}
+	case arrow.TIME64:
+		return &Time64{scalar: s}
+	case arrow.TIMESTAMP:
+		return &Timestamp{scalar: s}
+	case arrow.DURATION:
+		return &Duration{scalar: s}
+	case arrow.INTERVAL:
+		switch dtype.(type) {
+		case *arrow.MonthIntervalType:
+			return &MonthInterval{scalar: s}
+		case *arrow.DayTimeIntervalType:
+			return &DayTimeInterval{scalar: s}
+		}
+	case arrow.LIST, arrow.LARGE_LIST:
+		return &List{scalar: s}
+	case arrow.FIXED_SIZE_LIST:
+		return &FixedSizeList{scalar: s}
+	case arrow.STRUCT:
+		return &Struct{scalar: s}
+	}
+	panic(xerrors.Errorf("arrow/scalar: unsupported data type %v", dtype))
+}
+
+// MakeScalar returns a valid scalar holding the Go value v, with the data type
+// naturally associated with the type of v:
+//   - bool, integers, floating-point values, strings and []byte give scalars
+//     of the corresponding Arrow types. int and uint give int64 and uint64.
+//   - float16.Num, decimal128.Num, arrow.Date32, arrow.Date64,
+//     arrow.MonthInterval and arrow.DayTimeInterval give scalars of their
+//     Arrow types. decimal128.Num values have a precision of 38 and a scale
+//     of 0.
+//   - time.Time gives a timestamp in nanoseconds, in UTC, and time.Duration
+//     gives a duration in nanoseconds.
+//   - array.Interface values give list scalars.
+//   - nil gives the null scalar, and scalars are returned as is.
+//
+// MakeScalarParam may be used to create scalars of other data types.
+func MakeScalar(v interface{}) (Scalar, error) {
+	switch v := v.(type) {
+	case nil:
+		return ScalarNull, nil
+	case Scalar:
+		return v, nil
+	case bool:
+		return NewBooleanScalar(v), nil
+	case int8:
+		return NewInt8Scalar(v), nil
+	case int16:
+		return NewInt16Scalar(v), nil
+	case int32:
+		return NewInt32Scalar(v), nil
+	case int64:
+		return NewInt64Scalar(v), nil
+	case int:
+		return NewInt64Scalar(int64(v)), nil
+	case uint8:
+		return NewUint8Scalar(v), nil
+	case uint16:
+		return NewUint16Scalar(v), nil
+	case uint32:
+		return NewUint32Scalar(v), nil
+	case uint64:
+		return NewUint64Scalar(v), nil
+	case uint:
+		return NewUint64Scalar(uint64(v)), nil
+	case float16.Num:
+		return NewFloat16Scalar(v), nil
+	case float32:
+		return NewFloat32Scalar(v), nil
+	case float64:
+		return NewFloat64Scalar(v), nil
+	case decimal128.Num:
+		return NewDecimal128Scalar(v, &arrow.Decimal128Type{Precision: 38}), nil
+	case string:
+		return NewStringScalar(v), nil
+	case []byte:
+		return NewBinaryScalar(v), nil
+	case arrow.Date32:
+		return NewDate32Scalar(v), nil
+	case arrow.Date64:
+		return NewDate64Scalar(v), nil
+	case arrow.MonthInterval:
+		return NewMonthIntervalScalar(v), nil
+	case arrow.DayTimeInterval:
+		return NewDayTimeIntervalScalar(v), nil
+	case time.Time:
+		return NewTimestampScalar(arrow.Timestamp(v.UnixNano()), arrow.FixedWidthTypes.Timestamp_ns), nil
+	case time.Duration:
+		return NewDurationScalar(arrow.Duration(v), arrow.FixedWidthTypes.Duration_ns), nil
+	case array.Interface:
+		return NewListScalar(v), nil
+	}
+	return nil, xerrors.Errorf("arrow/scalar: unsupported Go type %T", v)
+}
+
+// MakeScalarParam returns a scalar of the given data type holding the Go
+// value v, converted to the data type:
+//   - numeric types accept any Go integer or floating-point value, which must
+//     be representable in the data type.
+//   - string and binary types accept strings and []byte, fixed-size binary
+//     types requiring the right length.
+//   - timestamp, date and time types accept time.Time values, and duration
+//     types accept time.Duration values, converted to their unit. They accept
+//     integers too, interpreted in their unit.
+//   - decimal types accept decimal128.Num values and integers.
+//   - list and fixed-size list types accept arrays of their element type.
+//   - struct types accept a slice of the scalars, or of the Go values, of
+//     their fields. Field scalars are retained by the struct scalar.
+//   - nil gives a null scalar of the data type.
+func MakeScalarParam(v interface{}, dtype arrow.DataType) (Scalar, error) {
+	if v == nil {
+		return MakeNullScalar(dtype), nil
+	}
+	if s, ok := v.(Scalar); ok {
+		if !arrow.TypeEqual(s.DataType(), dtype) {
+			return nil, xerrors.Errorf("arrow/scalar: scalar of type %v, want %v", s.DataType(), dtype)
+		}
+		return s, nil
+	}
+
+	var (
+		s   = scalar{Type: dtype, Valid: true}
+		err = xerrors.Errorf("arrow/scalar: could not convert value of type %T to %v", v, dtype)
+	)
+	switch dt := dtype.(type) {
+	case *arrow.BooleanType:
+		if v, ok := v.(bool); ok {
+			return &Boolean{scalar: s, Value: v}, nil
+		}
+		return nil, err
+
+	case *arrow.StringType, *arrow.LargeStringType:
+		switch v := v.(type) {
+		case string:
+			return &String{scalar: s, Value: v}, nil
+		case []byte:
+			return &String{scalar: s, Value: string(v)}, nil
+		}
+		return nil, err
+
+	case *arrow.BinaryType, *arrow.LargeBinaryType:
+		switch v := v.(type) {
+		case string:
+			return &Binary{scalar: s, Value: []byte(v)}, nil
+		case []byte:
+			return &Binary{scalar: s, Value: v}, nil
+		}
+		return nil, err
+
+	case *arrow.FixedSizeBinaryType:
+		var b []byte
+		switch v := v.(type) {
+		case string:
+			b = []byte(v)
+		case []byte:
+			b = v
+		default:
+			return nil, err
+		}
+		if len(b) != dt.ByteWidth {
+			return nil, xerrors.Errorf("arrow/scalar: invalid length %d for %v", len(b), dtype)
+		}
+		return &FixedSizeBinary{scalar: s, Value: b}, nil
+
+	case *arrow.Decimal128Type:
+		if v, ok := v.(decimal128.Num); ok {
+			return &Decimal128{scalar: s, Value: v}, nil
+		}
+		i, ok := toInt64(v)
+		if !ok {
+			return nil, err
+		}
+		return &Decimal128{scalar: s, Value: decimal128.FromI64(i)}, nil
+
+	case *arrow.Float16Type:
+		if v, ok := v.(float16.Num); ok {
+			return &Float16{scalar: s, Value: v}, nil
+		}
+		f, ok := toFloat64(v)
+		if !ok {
+			return nil, err
+		}
+		return &Float16{scalar: s, Value: float16.New(float32(f))}, nil
+
+	case *arrow.Float32Type:
+		f, ok := toFloat64(v)
+		if !ok {
+			return nil, err
+		}
+		return &Float32{scalar: s, Value: float32(f)}, nil
+
+	case *arrow.Float64Type:
+		f, ok := toFloat64(v)
+		if !ok {
+			return nil, err
+		}
+		return &Float64{scalar: s, Value: f}, nil
+
+	case *arrow.Int8Type, *arrow.Int16Type, *arrow.Int32Type, *arrow.Int64Type,
+		*arrow.Uint8Type, *arrow.Uint16Type, *arrow.Uint32Type, *arrow.Uint64Type:
+		return makeInteger(v, s)
+
+	case *arrow.TimestampType:
+		if t, ok := v.(time.Time); ok {
+			return &Timestamp{scalar: s, Value: arrow.Timestamp(arrtime.FromTime(t, dt.Unit))}, nil
+		}
+		i, ok := toInt64(v)
+		if !ok {
+			return nil, err
+		}
+		return &Timestamp{scalar: s, Value: arrow.Timestamp(i)}, nil
+
+	case *arrow.Date32Type:
+		switch v := v.(type) {
+		case arrow.Date32:
+			return &Date32{scalar: s, Value: v}, nil
+		case time.Time:
+			return &Date32{scalar: s, Value: arrow.Date32(floorDiv(v.Unix(), arrtime.SecondsPerDay))}, nil
+		}
+		i, ok := toInt64(v)
+		if !ok {
+			return nil, err
+		}
+		return &Date32{scalar: s, Value: arrow.Date32(i)}, nil
+
+	case *arrow.Date64Type:
+		switch v := v.(type) {
+		case arrow.Date64:
+			return &Date64{scalar: s, Value: v}, nil
+		case time.Time:
+			return &Date64{scalar: s, Value: arrow.Date64(floorDiv(v.Unix(), arrtime.SecondsPerDay) * arrtime.SecondsPerDay * 1000)}, nil
+		}
+		i, ok := toInt64(v)
+		if !ok {
+			return nil, err
+		}
+		return &Date64{scalar: s, Value: arrow.Date64(i)}, nil
+
+	case *arrow.Time32Type:
+		if t, ok := v.(time.Time); ok {
+			return &Time32{scalar: s, Value: arrow.Time32(timeOfDay(t) / dt.Unit.Multiplier())}, nil
+		}
+		i, ok := toInt64(v)
+		if !ok {
+			return nil, err
+		}
+		return &Time32{scalar: s, Value: arrow.Time32(i)}, nil
+
+	case *arrow.Time64Type:
+		if t, ok := v.(time.Time); ok {
+			return &Time64{scalar: s, Value: arrow.Time64(timeOfDay(t) / dt.Unit.Multiplier())}, nil
+		}
+		i, ok := toInt64(v)
+		if !ok {
+			return nil, err
+		}
+		return &Time64{scalar: s, Value: arrow.Time64(i)}, nil
+
+	case *arrow.DurationType:
+		if d, ok := v.(time.Duration); ok {
+			return &Duration{scalar: s, Value: arrow.Duration(d / dt.Unit.Multiplier())}, nil
+		}
+		i, ok := toInt64(v)
+		if !ok {
+			return nil, err
+		}
+		return &Duration{scalar: s, Value: arrow.Duration(i)}, nil
+
+	case *arrow.MonthIntervalType:
+		if v, ok := v.(arrow.MonthInterval); ok {
+			return &MonthInterval{scalar: s, Value: v}, nil
+		}
+		return nil, err
+
+	case *arrow.DayTimeIntervalType:
+		if v, ok := v.(arrow.DayTimeInterval); ok {
+			return &DayTimeInterval{scalar: s, Value: v}, nil
+		}
+		return nil, err
+
+	case *arrow.ListType:
+		arr, ok := v.(array.Interface)
+		if !ok {
+			return nil, err
+		}
+		if !arrow.TypeEqual(arr.DataType(), dt.Elem()) {
+			return nil, xerrors.Errorf("arrow/scalar: list values of type %v, want %v", arr.DataType(), dt.Elem())
+		}
+		arr.Retain()
+		return &List{scalar: s, Value: arr}, nil
+
+	case *arrow.LargeListType:
+		arr, ok := v.(array.Interface)
+		if !ok {
+			return nil, err
+		}
+		if !arrow.TypeEqual(arr.DataType(), dt.Elem()) {
+			return nil, xerrors.Errorf("arrow/scalar: list values of type %v, want %v", arr.DataType(), dt.Elem())
+		}
+		arr.Retain()
+		return &List{scalar: s, Value: arr}, nil
+
+	case *arrow.FixedSizeListType:
+		arr, ok := v.(array.Interface)
+		if !ok {
+			return nil, err
+		}
+		if !arrow.TypeEqual(arr.DataType(), dt.Elem()) || int32(arr.Len()) != dt.Len() {
+			return nil, xerrors.Errorf("arrow/scalar: list values of type %v and length %d, want %v", arr.DataType(), arr.Len(), dtype)
+		}
+		arr.Retain()
+		return &FixedSizeList{scalar: s, Value: arr}, nil
+
+	case *arrow.StructType:
+		rv := reflect.ValueOf(v)
+		if rv.Kind() != reflect.Slice || rv.Len() != len(dt.Fields()) {
+			return nil, err
+		}
+		fields := make([]Scalar, rv.Len())
+		for i, f := range dt.Fields() {
+			fv := rv.Index(i).Interface()
+			fs, err := MakeScalarParam(fv, f.Type)
+			if err != nil {
+				for _, fs := range fields[:i] {
+					fs.Release()
+				}
+				return nil, xerrors.Errorf("arrow/scalar: struct field %q: %w", f.Name, err)
+			}
+			if _, ok := fv.(Scalar); ok {
+				// the caller keeps its reference to the field scalar.
+				fs.Retain()
+			}
+			fields[i] = fs
+		}
+		return &Struct{scalar: s, Value: fields}, nil
+	}
+
+	return nil, err
+}
+
+func makeInteger(v interface{}, s scalar) (Scalar, error) {
+	var (
+		i, iok = toInt64(v)
+		u, uok = toUint64(v)
+		err    = xerrors.Errorf("arrow/scalar: value %v out of range of %v", v, s.Type)
+	)
+	if !iok && !uok {
+		return nil, xerrors.Errorf("arrow/scalar: could not convert value of type %T to %v", v, s.Type)
+	}
+
+	inRange := func(min, max int64) bool {
+		if iok {
+			return i >= min && i <= max
+		}
+		return u <= uint64(max)
+	}
+
+	switch s.Type.ID() {
+	case arrow.INT8:
+		if inRange(-1<<7, 1<<7-1) {
+			return &Int8{scalar: s, Value: int8(i)}, nil
+		}
+	case arrow.INT16:
+		if inRange(-1<<15, 1<<15-1) {
+			return &Int16{scalar: s, Value: int16(i)}, nil
+		}
+	case arrow.INT32:
+		if inRange(-1<<31, 1<<31-1) {
+			return &Int32{scalar: s, Value: int32(i)}, nil
+		}
+	case arrow.INT64:
+		if iok {
+			return &Int64{scalar: s, Value: i}, nil
+		}
+	case arrow.UINT8:
+		if inRange(0, 1<<8-1) {
+			return &Uint8{scalar: s, Value: uint8(u)}, nil
+		}
+	case arrow.UINT16:
+		if inRange(0, 1<<16-1) {
+			return &Uint16{scalar: s, Value: uint16(u)}, nil
+		}
+	case arrow.UINT32:
+		if inRange(0, 1<<32-1) {
+			return &Uint32{scalar: s, Value: uint32(u)}, nil
+		}
+	case arrow.UINT64:
+		if uok {
+			return &Uint64{scalar: s, Value: u}, nil
+		}
+	}
+	return nil, err
+}
+
+// toInt64 converts Go integers, and floating-point values without fractional
+// part, to int64.
+func toInt64(v interface{}) (int64, bool) {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return rv.Int(), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u := rv.Uint()
+		return int64(u), u <= 1<<63-1
+	case reflect.Float32, reflect.Float64:
+		f := rv.Float()
+		return int64(f), f == float64(int64(f))
+	}
+	return 0, false
+}
+
+// toUint64 converts non-negative Go integers, and floating-point values
+// without fractional part, to uint64.
+func toUint64(v interface{}) (uint64, bool) {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i := rv.Int()
+		return uint64(i), i >= 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return rv.Uint(), true
+	case reflect.Float32, reflect.Float64:
+		f := rv.Float()
+		return uint64(f), f >= 0 && f == float64(uint64(f))
+	}
+	return 0, false
+}
+
+// toFloat64 converts Go integers and floating-point values to float64.
+func toFloat64(v interface{}) (float64, bool) {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(rv.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(rv.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return rv.Float(), true
+	}
+	return 0, false
+}
+
+// timeOfDay returns the duration elapsed since the midnight of t.
+func timeOfDay(t time.Time) time.Duration {
+	h, m, s := t.Clock()
+	return time.Duration(h)*time.Hour + time.Duration(m)*time.Minute + time.Duration(s)*time.Second +
+		time.Duration(t.Nanosecond())
+}
+
+func floorDiv(a, b int64) int64 {
+	q := a / b
+	if a%b < 0 {
+		q--
+	}
+	return q
+}
+
+// GetScalar returns the i-th value of the array as a scalar.
+// Scalars of list types hold a slice of the values of the array, and must be
+// released after use.
+func GetScalar(arr array.Interface, i int) (Scalar, error) {
+	if i < 0 || i >= arr.Len() {
+		return nil, xerrors.Errorf("arrow/scalar: index %d out of range [0, %d)", i, arr.Len())
+	}
+	if arr.IsNull(i) {
+		return MakeNullScalar(arr.DataType()), nil
+	}
+
+	s := scalar{Type: arr.DataType(), Valid: true}
+	switch arr := arr.(type) {
+	case *array.Boolean:
+		return &Boolean{scalar: s, Value: arr.Value(i)}, nil
+	case *array.Int8:
+		return &Int8{scalar: s, Value: arr.Value(i)}, nil
+	case *array.Int16:
+		return &Int16{scalar: s, Value: arr.Value(i)}, nil
+	case *array.Int32:
+		return &Int32{scalar: s, Value: arr.Value(i)}, nil
+	case *array.Int64:
+		return &Int64{scalar: s, Value: arr.Value(i)}, nil
+	case *array.Uint8:
+		return &Uint8{scalar: s, Value: arr.Value(i)}, nil
+	case *array.Uint16:
+		return &Uint16{scalar: s, Value: arr.Value(i)}, nil
+	case *array.Uint32:
+		return &Uint32{scalar: s, Value: arr.Value(i)}, nil
+	case *array.Uint64:
+		return &Uint64{scalar: s, Value: arr.Value(i)}, nil
+	case *array.Float16:
+		return &Float16{scalar: s, Value: arr.Value(i)}, nil
+	case *array.Float32:
+		return &Float32{scalar: s, Value: arr.Value(i)}, nil
+	case *array.Float64:
+		return &Float64{scalar: s, Value: arr.Value(i)}, nil
+	case *array.Decimal128:
+		return &Decimal128{scalar: s, Value: arr.Value(i)}, nil
+	case *array.String:
+		return &String{scalar: s, Value: arr.Value(i)}, nil
+	case *array.Binary:
+		return &Binary{scalar: s, Value: append([]byte(nil), arr.Value(i)...)}, nil
+	case *array.LargeString:
+		return &String{scalar: s, Value: arr.Value(i)}, nil
+	case *array.LargeBinary:
+		return &Binary{scalar: s, Value: append([]byte(nil), arr.Value(i)...)}, nil
+	case *array.FixedSizeBinary:
+		return &FixedSizeBinary{scalar: s, Value: append([]byte(nil), arr.Value(i)...)}, nil
+	case *array.Date32:
+		return &Date32{scalar: s, Value: arr.Value(i)}, nil
+	case *array.Date64:
+		return &Date64{scalar: s, Value: arr.Value(i)}, nil
+	case *array.Time32:
+		return &Time32{scalar: s, Value: arr.Value(i)}, nil
+	case *array.Time64:
+		return &Time64{scalar: s, Value: arr.Value(i)}, nil
+	case *array.Timestamp:
+		return &Timestamp{scalar: s, Value: arr.Value(i)}, nil
+	case *array.Duration:
+		return &Duration{scalar: s, Value: arr.Value(i)}, nil
+	case *array.MonthInterval:
+		return &MonthInterval{scalar: s, Value: arr.Value(i)}, nil
+	case *array.DayTimeInterval:
+		return &DayTimeInterval{scalar: s, Value: arr.Value(i)}, nil
+	case *array.List:
+		j := i + arr.Data().Offset()
+		beg, end := int64(arr.Offsets()[j]), int64(arr.Offsets()[j+1])
+		return &List{scalar: s, Value: array.NewSlice(arr.ListValues(), beg, end)}, nil
+	case *array.LargeList:
+		j := i + arr.Data().Offset()
+		beg, end := arr.Offsets()[j], arr.Offsets()[j+1]
+		return &List{scalar: s, Value: array.NewSlice(arr.ListValues(), beg, end)}, nil
+	case *array.FixedSizeList:
+		n := int64(arr.DataType().(*arrow.FixedSizeListType).Len())
+		beg := int64(i+arr.Data().Offset()) * n
+		return &FixedSizeList{scalar: s, Value: array.NewSlice(arr.ListValues(), beg, beg+n)}, nil
+	case *array.Struct:
+		fields := make([]Scalar, arr.NumField())
+		for k := range fields {
+			fs, err := GetScalar(arr.Field(k), i)
+			if err != nil {
+				for _, fs := range fields[:k] {
+					fs.Release()
+				}
+				return nil, err
+			}
+			fields[k] = fs
+		}
+		return &Struct{scalar: s, Value: fields}, nil
+	}
+	return nil, xerrors.Errorf("arrow/scalar: unsupported array type %T", arr)
+}
+
+// MakeArrayFromScalar returns an array of n values, all equal to the scalar.
+//
+// The returned array must be Release()'d after use.
+func MakeArrayFromScalar(s Scalar, n int, mem memory.Allocator) (array.Interface, error) {
+	if s.DataType().ID() == arrow.NULL {
+		return array.NewNull(n), nil
+	}
+
+	bldr := array.NewBuilder(mem, s.DataType())
+	defer bldr.Release()
+
+	bldr.Reserve(n)
+	for i := 0; i < n; i++ {
+		if err := appendScalar(bldr, s); err != nil {
+			return nil, err
+		}
+	}
+	return bldr.NewArray(), nil
+}
+
+// appendScalar appends the value of the scalar to the builder.
+func appendScalar(bldr array.Builder, s Scalar) error {
+	if !s.IsValid() {
+		appendNull(bldr, s.DataType())
+		return nil
+	}
+
+	switch s := s.(type) {
+	case *Boolean:
+		bldr.(*array.BooleanBuilder).Append(s.Value)
+	case *Int8:
+		bldr.(*array.Int8Builder).Append(s.Value)
+	case *Int16:
+		bldr.(*array.Int16Builder).Append(s.Value)
+	case *Int32:
+		bldr.(*array.Int32Builder).Append(s.Value)
+	case *Int64:
+		bldr.(*array.Int64Builder).Append(s.Value)
+	case *Uint8:
+		bldr.(*array.Uint8Builder).Append(s.Value)
+	case *Uint16:
+		bldr.(*array.Uint16Builder).Append(s.Value)
+	case *Uint32:
+		bldr.(*array.Uint32Builder).Append(s.Value)
+	case *Uint64:
+		bldr.(*array.Uint64Builder).Append(s.Value)
+	case *Float16:
+		bldr.(*array.Float16Builder).Append(s.Value)
+	case *Float32:
+		bldr.(*array.Float32Builder).Append(s.Value)
+	case *Float64:
+		bldr.(*array.Float64Builder).Append(s.Value)
+	case *Decimal128:
+		bldr.(*array.Decimal128Builder).Append(s.Value)
+	case *String:
+		switch bldr := bldr.(type) {
+		case *array.LargeStringBuilder:
+			bldr.Append(s.Value)
+		default:
+			bldr.(*array.StringBuilder).Append(s.Value)
+		}
+	case *Binary:
+		switch bldr := bldr.(type) {
+		case *array.LargeBinaryBuilder:
+			bldr.Append(s.Value)
+		default:
+			bldr.(*array.BinaryBuilder).Append(s.Value)
+		}
+	case *FixedSizeBinary:
+		bldr.(*array.FixedSizeBinaryBuilder).Append(s.Value)
+	case *Date32:
+		bldr.(*array.Date32Builder).Append(s.Value)
+	case *Date64:
+		bldr.(*array.Date64Builder).Append(s.Value)
+	case *Time32:
+		bldr.(*array.Time32Builder).Append(s.Value)
+	case *Time64:
+		bldr.(*array.Time64Builder).Append(s.Value)
+	case *Timestamp:
+		bldr.(*array.TimestampBuilder).Append(s.Value)
+	case *Duration:
+		bldr.(*array.DurationBuilder).Append(s.Value)
+	case *MonthInterval:
+		bldr.(*array.MonthIntervalBuilder).Append(s.Value)
+	case *DayTimeInterval:
+		bldr.(*array.DayTimeIntervalBuilder).Append(s.Value)
+	case *List:
+		if lb, ok := bldr.(*array.LargeListBuilder); ok {
+			lb.Append(true)
+			return appendValues(lb.ValueBuilder(), s.Value)
+		}
+		lb := bldr.(*array.ListBuilder)
+		lb.Append(true)
+		return appendValues(lb.ValueBuilder(), s.Value)
+	case *FixedSizeList:
+		lb := bldr.(*array.FixedSizeListBuilder)
+		lb.Append(true)
+		return appendValues(lb.ValueBuilder(), s.Value)
+	case *Struct:
+		sb := bldr.(*array.StructBuilder)
+		sb.Append(true)
+		for i, fs := range s.Value {
+			if err := appendScalar(sb.FieldBuilder(i), fs); err != nil {
+				return err
+			}
+		}
+	default:
+		return xerrors.Errorf("arrow/scalar: unsupported scalar type %T", s)
+	}
+	return nil
+}
+
+// appendValues appends the values of the array to the builder.
+func appendValues(bldr array.Builder, arr array.Interface) error {
+	for i := 0; i < arr.Len(); i++ {
+		s, err := GetScalar(arr, i)
+		if err != nil {
+			return err
+		}
+		err = appendScalar(bldr, s)
+		s.Release()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// appendNull appends a null value of the given data type to the builder,
+// along with the child values needed by struct and fixed-size list builders.
+func appendNull(bldr array.Builder, dtype arrow.DataType) {
+	switch dt := dtype.(type) {
+	case *arrow.StructType:
+		sb := bldr.(*array.StructBuilder)
+		// AppendNull would also append plain nulls to the field builders.
+		sb.AppendValues([]bool{false})
+		for i, f := range dt.Fields() {
+			appendNull(sb.FieldBuilder(i), f.Type)
+		}
+	case *arrow.FixedSizeListType:
+		lb := bldr.(*array.FixedSizeListBuilder)
+		lb.AppendNull()
+		for i := 0; i < int(dt.Len()); i++ {
+			appendNull(lb.ValueBuilder(), dt.Elem())
+		}
+	default:
+		bldr.AppendNull()
+	}
+}
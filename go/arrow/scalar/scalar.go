@@ -0,0 +1,492 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package scalar provides typed representations of single values of Arrow
+// data types.
+//
+// A scalar holds a data type, a validity flag and, when valid, a Go value.
+// Scalars may be created from Go values with MakeScalar and MakeScalarParam,
+// extracted from arrays with GetScalar, and repeated into arrays with
+// MakeArrayFromScalar.
+package scalar // import "github.com/apache/arrow/go/arrow/scalar"
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/decimal128"
+	"github.com/apache/arrow/go/arrow/float16"
+)
+
+// Scalar is a single value of an Arrow data type, possibly null.
+type Scalar interface {
+	fmt.Stringer
+
+	// DataType returns the data type of the scalar.
+	DataType() arrow.DataType
+
+	// IsValid returns whether the scalar holds a value, or is null.
+	IsValid() bool
+
+	// Interface returns the value of the scalar as a Go value, or nil if the
+	// scalar is null.
+	Interface() interface{}
+
+	// Retain increases the reference count of the arrays held by the
+	// scalar, if any.
+	Retain()
+
+	// Release decreases the reference count of the arrays held by the
+	// scalar, if any.
+	Release()
+
+	// equals reports whether the values of two valid scalars of the same
+	// data type are equal.
+	equals(Scalar) bool
+}
+
+const nullString = "null"
+
+// scalar holds the data type and the validity of a scalar.
+type scalar struct {
+	Type  arrow.DataType
+	Valid bool
+}
+
+func (s *scalar) DataType() arrow.DataType { return s.Type }
+func (s *scalar) IsValid() bool            { return s.Valid }
+func (s *scalar) Retain()                  {}
+func (s *scalar) Release()                 {}
+
+// Equals reports whether the scalars have the same data type, the same
+// validity and, when valid, equal values.
+func Equals(left, right Scalar) bool {
+	switch {
+	case left == nil || right == nil:
+		return left == right
+	case !arrow.TypeEqual(left.DataType(), right.DataType()):
+		return false
+	case left.IsValid() != right.IsValid():
+		return false
+	case !left.IsValid():
+		return true
+	default:
+		return left.equals(right)
+	}
+}
+
+// Null is the scalar of the null data type. It is never valid.
+type Null struct {
+	scalar
+}
+
+// ScalarNull is the null scalar.
+var ScalarNull = &Null{scalar: scalar{Type: arrow.Null}}
+
+func (s *Null) Interface() interface{} { return nil }
+func (s *Null) String() string         { return nullString }
+func (s *Null) equals(Scalar) bool     { return true }
+
+// Boolean is a scalar holding one bool value.
+type Boolean struct {
+	scalar
+	Value bool
+}
+
+// NewBooleanScalar returns a valid Boolean scalar.
+func NewBooleanScalar(v bool) *Boolean {
+	return &Boolean{scalar: scalar{Type: arrow.FixedWidthTypes.Boolean, Valid: true}, Value: v}
+}
+
+func (s *Boolean) Interface() interface{} {
+	if !s.Valid {
+		return nil
+	}
+	return s.Value
+}
+
+func (s *Boolean) String() string {
+	if !s.Valid {
+		return nullString
+	}
+	return fmt.Sprint(s.Value)
+}
+
+func (s *Boolean) equals(o Scalar) bool { return s.Value == o.(*Boolean).Value }
+
+// Float16 is a scalar holding one float16.Num value.
+type Float16 struct {
+	scalar
+	Value float16.Num
+}
+
+// NewFloat16Scalar returns a valid Float16 scalar.
+func NewFloat16Scalar(v float16.Num) *Float16 {
+	return &Float16{scalar: scalar{Type: arrow.FixedWidthTypes.Float16, Valid: true}, Value: v}
+}
+
+func (s *Float16) Interface() interface{} {
+	if !s.Valid {
+		return nil
+	}
+	return s.Value
+}
+
+func (s *Float16) String() string {
+	if !s.Valid {
+		return nullString
+	}
+	return s.Value.String()
+}
+
+func (s *Float16) equals(o Scalar) bool { return s.Value == o.(*Float16).Value }
+
+// Decimal128 is a scalar holding one decimal128.Num value.
+type Decimal128 struct {
+	scalar
+	Value decimal128.Num
+}
+
+// NewDecimal128Scalar returns a valid Decimal128 scalar of the given data
+// type.
+func NewDecimal128Scalar(v decimal128.Num, dtype *arrow.Decimal128Type) *Decimal128 {
+	return &Decimal128{scalar: scalar{Type: dtype, Valid: true}, Value: v}
+}
+
+func (s *Decimal128) Interface() interface{} {
+	if !s.Valid {
+		return nil
+	}
+	return s.Value
+}
+
+func (s *Decimal128) String() string {
+	if !s.Valid {
+		return nullString
+	}
+	return s.Value.ToString(s.Type.(*arrow.Decimal128Type).Scale)
+}
+
+func (s *Decimal128) equals(o Scalar) bool { return s.Value == o.(*Decimal128).Value }
+
+// MonthInterval is a scalar holding one arrow.MonthInterval value.
+type MonthInterval struct {
+	scalar
+	Value arrow.MonthInterval
+}
+
+// NewMonthIntervalScalar returns a valid MonthInterval scalar.
+func NewMonthIntervalScalar(v arrow.MonthInterval) *MonthInterval {
+	return &MonthInterval{scalar: scalar{Type: arrow.FixedWidthTypes.MonthInterval, Valid: true}, Value: v}
+}
+
+func (s *MonthInterval) Interface() interface{} {
+	if !s.Valid {
+		return nil
+	}
+	return s.Value
+}
+
+func (s *MonthInterval) String() string {
+	if !s.Valid {
+		return nullString
+	}
+	return fmt.Sprint(s.Value)
+}
+
+func (s *MonthInterval) equals(o Scalar) bool { return s.Value == o.(*MonthInterval).Value }
+
+// DayTimeInterval is a scalar holding one arrow.DayTimeInterval value.
+type DayTimeInterval struct {
+	scalar
+	Value arrow.DayTimeInterval
+}
+
+// NewDayTimeIntervalScalar returns a valid DayTimeInterval scalar.
+func NewDayTimeIntervalScalar(v arrow.DayTimeInterval) *DayTimeInterval {
+	return &DayTimeInterval{scalar: scalar{Type: arrow.FixedWidthTypes.DayTimeInterval, Valid: true}, Value: v}
+}
+
+func (s *DayTimeInterval) Interface() interface{} {
+	if !s.Valid {
+		return nil
+	}
+	return s.Value
+}
+
+func (s *DayTimeInterval) String() string {
+	if !s.Valid {
+		return nullString
+	}
+	return fmt.Sprint(s.Value)
+}
+
+func (s *DayTimeInterval) equals(o Scalar) bool { return s.Value == o.(*DayTimeInterval).Value }
+
+// String is a scalar holding one string value, of type utf8 or large_utf8.
+type String struct {
+	scalar
+	Value string
+}
+
+// NewStringScalar returns a valid String scalar.
+func NewStringScalar(v string) *String {
+	return &String{scalar: scalar{Type: arrow.BinaryTypes.String, Valid: true}, Value: v}
+}
+
+func (s *String) Interface() interface{} {
+	if !s.Valid {
+		return nil
+	}
+	return s.Value
+}
+
+func (s *String) String() string {
+	if !s.Valid {
+		return nullString
+	}
+	return fmt.Sprintf("%q", s.Value)
+}
+
+func (s *String) equals(o Scalar) bool { return s.Value == o.(*String).Value }
+
+// Binary is a scalar holding one []byte value, of type binary or
+// large_binary.
+type Binary struct {
+	scalar
+	Value []byte
+}
+
+// NewBinaryScalar returns a valid Binary scalar.
+func NewBinaryScalar(v []byte) *Binary {
+	return &Binary{scalar: scalar{Type: arrow.BinaryTypes.Binary, Valid: true}, Value: v}
+}
+
+func (s *Binary) Interface() interface{} {
+	if !s.Valid {
+		return nil
+	}
+	return s.Value
+}
+
+func (s *Binary) String() string {
+	if !s.Valid {
+		return nullString
+	}
+	return fmt.Sprintf("%q", s.Value)
+}
+
+func (s *Binary) equals(o Scalar) bool { return string(s.Value) == string(o.(*Binary).Value) }
+
+// FixedSizeBinary is a scalar holding one []byte value of a fixed size.
+type FixedSizeBinary struct {
+	scalar
+	Value []byte
+}
+
+// NewFixedSizeBinaryScalar returns a valid FixedSizeBinary scalar of the
+// given data type.
+func NewFixedSizeBinaryScalar(v []byte, dtype *arrow.FixedSizeBinaryType) *FixedSizeBinary {
+	return &FixedSizeBinary{scalar: scalar{Type: dtype, Valid: true}, Value: v}
+}
+
+func (s *FixedSizeBinary) Interface() interface{} {
+	if !s.Valid {
+		return nil
+	}
+	return s.Value
+}
+
+func (s *FixedSizeBinary) String() string {
+	if !s.Valid {
+		return nullString
+	}
+	return fmt.Sprintf("%q", s.Value)
+}
+
+func (s *FixedSizeBinary) equals(o Scalar) bool {
+	return string(s.Value) == string(o.(*FixedSizeBinary).Value)
+}
+
+// List is a scalar holding one list or large list value, as an array of the
+// element type of the list.
+type List struct {
+	scalar
+	Value array.Interface
+}
+
+// NewListScalar returns a valid List scalar holding the values of the array.
+// The array is retained by the scalar, until it is released.
+func NewListScalar(v array.Interface) *List {
+	v.Retain()
+	return &List{scalar: scalar{Type: arrow.ListOf(v.DataType()), Valid: true}, Value: v}
+}
+
+func (s *List) Interface() interface{} {
+	if !s.Valid {
+		return nil
+	}
+	return s.Value
+}
+
+func (s *List) String() string {
+	if !s.Valid {
+		return nullString
+	}
+	return fmt.Sprint(s.Value)
+}
+
+func (s *List) Retain() {
+	if s.Value != nil {
+		s.Value.Retain()
+	}
+}
+
+func (s *List) Release() {
+	if s.Value != nil {
+		s.Value.Release()
+	}
+}
+
+func (s *List) equals(o Scalar) bool { return array.ArrayEqual(s.Value, o.(*List).Value) }
+
+// FixedSizeList is a scalar holding one fixed-size list value, as an array of
+// the element type of the list.
+type FixedSizeList struct {
+	scalar
+	Value array.Interface
+}
+
+// NewFixedSizeListScalar returns a valid FixedSizeList scalar holding the
+// values of the array. The array is retained by the scalar, until it is
+// released.
+func NewFixedSizeListScalar(v array.Interface) *FixedSizeList {
+	v.Retain()
+	return &FixedSizeList{
+		scalar: scalar{Type: arrow.FixedSizeListOf(int32(v.Len()), v.DataType()), Valid: true},
+		Value:  v,
+	}
+}
+
+func (s *FixedSizeList) Interface() interface{} {
+	if !s.Valid {
+		return nil
+	}
+	return s.Value
+}
+
+func (s *FixedSizeList) String() string {
+	if !s.Valid {
+		return nullString
+	}
+	return fmt.Sprint(s.Value)
+}
+
+func (s *FixedSizeList) Retain() {
+	if s.Value != nil {
+		s.Value.Retain()
+	}
+}
+
+func (s *FixedSizeList) Release() {
+	if s.Value != nil {
+		s.Value.Release()
+	}
+}
+
+func (s *FixedSizeList) equals(o Scalar) bool {
+	return array.ArrayEqual(s.Value, o.(*FixedSizeList).Value)
+}
+
+// Struct is a scalar holding one struct value, as the scalars of its fields.
+type Struct struct {
+	scalar
+	Value []Scalar
+}
+
+// NewStructScalar returns a valid Struct scalar of the given data type,
+// holding the values of its fields.
+func NewStructScalar(v []Scalar, dtype *arrow.StructType) *Struct {
+	return &Struct{scalar: scalar{Type: dtype, Valid: true}, Value: v}
+}
+
+func (s *Struct) Interface() interface{} {
+	if !s.Valid {
+		return nil
+	}
+	return s.Value
+}
+
+func (s *Struct) String() string {
+	if !s.Valid {
+		return nullString
+	}
+	var (
+		o      = new(strings.Builder)
+		fields = s.Type.(*arrow.StructType).Fields()
+	)
+	o.WriteString("{")
+	for i, v := range s.Value {
+		if i > 0 {
+			o.WriteString(", ")
+		}
+		fmt.Fprintf(o, "%s: %v", fields[i].Name, v)
+	}
+	o.WriteString("}")
+	return o.String()
+}
+
+func (s *Struct) Retain() {
+	for _, v := range s.Value {
+		v.Retain()
+	}
+}
+
+func (s *Struct) Release() {
+	for _, v := range s.Value {
+		v.Release()
+	}
+}
+
+func (s *Struct) equals(o Scalar) bool {
+	ov := o.(*Struct).Value
+	if len(s.Value) != len(ov) {
+		return false
+	}
+	for i, v := range s.Value {
+		if !Equals(v, ov[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+var (
+	_ Scalar = (*Null)(nil)
+	_ Scalar = (*Boolean)(nil)
+	_ Scalar = (*Float16)(nil)
+	_ Scalar = (*Decimal128)(nil)
+	_ Scalar = (*MonthInterval)(nil)
+	_ Scalar = (*DayTimeInterval)(nil)
+	_ Scalar = (*String)(nil)
+	_ Scalar = (*Binary)(nil)
+	_ Scalar = (*FixedSizeBinary)(nil)
+	_ Scalar = (*List)(nil)
+	_ Scalar = (*FixedSizeList)(nil)
+	_ Scalar = (*Struct)(nil)
+)
@@ -0,0 +1,324 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scalar_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/decimal128"
+	"github.com/apache/arrow/go/arrow/float16"
+	"github.com/apache/arrow/go/arrow/memory"
+	"github.com/apache/arrow/go/arrow/scalar"
+)
+
+func TestMakeScalar(t *testing.T) {
+	for _, tc := range []struct {
+		v     interface{}
+		dtype arrow.DataType
+		str   string
+	}{
+		{nil, arrow.Null, "null"},
+		{true, arrow.FixedWidthTypes.Boolean, "true"},
+		{int8(-1), arrow.PrimitiveTypes.Int8, "-1"},
+		{int16(-2), arrow.PrimitiveTypes.Int16, "-2"},
+		{int32(-3), arrow.PrimitiveTypes.Int32, "-3"},
+		{int64(-4), arrow.PrimitiveTypes.Int64, "-4"},
+		{5, arrow.PrimitiveTypes.Int64, "5"},
+		{uint8(1), arrow.PrimitiveTypes.Uint8, "1"},
+		{uint16(2), arrow.PrimitiveTypes.Uint16, "2"},
+		{uint32(3), arrow.PrimitiveTypes.Uint32, "3"},
+		{uint64(4), arrow.PrimitiveTypes.Uint64, "4"},
+		{uint(5), arrow.PrimitiveTypes.Uint64, "5"},
+		{float16.New(1.5), arrow.FixedWidthTypes.Float16, "1.5"},
+		{float32(2.5), arrow.PrimitiveTypes.Float32, "2.5"},
+		{3.5, arrow.PrimitiveTypes.Float64, "3.5"},
+		{decimal128.FromI64(123), &arrow.Decimal128Type{Precision: 38}, "123"},
+		{"hello", arrow.BinaryTypes.String, `"hello"`},
+		{[]byte("world"), arrow.BinaryTypes.Binary, `"world"`},
+		{arrow.Date32(10), arrow.PrimitiveTypes.Date32, "10"},
+		{arrow.Date64(20), arrow.PrimitiveTypes.Date64, "20"},
+		{arrow.MonthInterval(3), arrow.FixedWidthTypes.MonthInterval, "3"},
+		{arrow.DayTimeInterval{Days: 1, Milliseconds: 2}, arrow.FixedWidthTypes.DayTimeInterval, "{1 2}"},
+		{time.Unix(1, 2), arrow.FixedWidthTypes.Timestamp_ns, "1000000002"},
+		{3 * time.Second, arrow.FixedWidthTypes.Duration_ns, "3000000000"},
+	} {
+		s, err := scalar.MakeScalar(tc.v)
+		if err != nil {
+			t.Fatalf("could not make scalar from %T: %v", tc.v, err)
+		}
+		if !arrow.TypeEqual(s.DataType(), tc.dtype) {
+			t.Fatalf("invalid data type for %T: got=%v, want=%v", tc.v, s.DataType(), tc.dtype)
+		}
+		if got, want := s.IsValid(), tc.v != nil; got != want {
+			t.Fatalf("invalid validity for %T: got=%v, want=%v", tc.v, got, want)
+		}
+		if got, want := s.String(), tc.str; got != want {
+			t.Fatalf("invalid string for %T: got=%q, want=%q", tc.v, got, want)
+		}
+	}
+
+	_, err := scalar.MakeScalar(struct{}{})
+	if err == nil {
+		t.Fatalf("expected an error for unsupported Go type")
+	}
+}
+
+func TestMakeScalarParam(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	ib := array.NewInt32Builder(mem)
+	defer ib.Release()
+	ib.AppendValues([]int32{1, 2}, nil)
+	ints := ib.NewArray()
+	defer ints.Release()
+
+	ts := time.Date(2020, 1, 2, 3, 4, 5, 6e6, time.UTC)
+	structType := arrow.StructOf(
+		arrow.Field{Name: "a", Type: arrow.PrimitiveTypes.Int8},
+		arrow.Field{Name: "b", Type: arrow.BinaryTypes.String, Nullable: true},
+	)
+
+	for _, tc := range []struct {
+		v     interface{}
+		dtype arrow.DataType
+		str   string
+	}{
+		{42, arrow.PrimitiveTypes.Uint8, "42"},
+		{-42.0, arrow.PrimitiveTypes.Int16, "-42"},
+		{uint64(7), arrow.PrimitiveTypes.Int64, "7"},
+		{2, arrow.PrimitiveTypes.Float32, "2"},
+		{1.5, arrow.FixedWidthTypes.Float16, "1.5"},
+		{12345, &arrow.Decimal128Type{Precision: 10, Scale: 2}, "123.45"},
+		{"abc", &arrow.FixedSizeBinaryType{ByteWidth: 3}, `"abc"`},
+		{[]byte("abc"), arrow.BinaryTypes.String, `"abc"`},
+		{"abc", arrow.BinaryTypes.LargeString, `"abc"`},
+		{"abc", arrow.BinaryTypes.LargeBinary, `"abc"`},
+		{ts, arrow.FixedWidthTypes.Timestamp_ms, "1577934245006"},
+		{ts, arrow.PrimitiveTypes.Date32, "18263"},
+		{ts, arrow.PrimitiveTypes.Date64, "1577923200000"},
+		{ts, arrow.FixedWidthTypes.Time32s, "11045"},
+		{ts, arrow.FixedWidthTypes.Time64us, "11045006000"},
+		{2 * time.Second, arrow.FixedWidthTypes.Duration_ms, "2000"},
+		{ints, arrow.ListOf(arrow.PrimitiveTypes.Int32), "[1 2]"},
+		{ints, arrow.LargeListOf(arrow.PrimitiveTypes.Int32), "[1 2]"},
+		{ints, arrow.FixedSizeListOf(2, arrow.PrimitiveTypes.Int32), "[1 2]"},
+		{[]interface{}{1, nil}, structType, "{a: 1, b: null}"},
+		{nil, arrow.PrimitiveTypes.Int32, "null"},
+	} {
+		s, err := scalar.MakeScalarParam(tc.v, tc.dtype)
+		if err != nil {
+			t.Fatalf("could not make %v scalar from %T: %v", tc.dtype, tc.v, err)
+		}
+		if !arrow.TypeEqual(s.DataType(), tc.dtype) {
+			t.Fatalf("invalid data type: got=%v, want=%v", s.DataType(), tc.dtype)
+		}
+		if got, want := s.String(), tc.str; got != want {
+			t.Fatalf("invalid %v scalar: got=%q, want=%q", tc.dtype, got, want)
+		}
+
+		arr, err := scalar.MakeArrayFromScalar(s, 2, mem)
+		if err != nil {
+			t.Fatalf("could not make %v array from scalar: %v", tc.dtype, err)
+		}
+		got, err := scalar.GetScalar(arr, 1)
+		if err != nil {
+			t.Fatalf("could not get %v scalar: %v", tc.dtype, err)
+		}
+		if !scalar.Equals(got, s) {
+			t.Fatalf("invalid %v scalar from array: got=%v, want=%v", tc.dtype, got, s)
+		}
+		got.Release()
+		arr.Release()
+		s.Release()
+	}
+
+	for _, tc := range []struct {
+		v     interface{}
+		dtype arrow.DataType
+	}{
+		{256, arrow.PrimitiveTypes.Uint8},
+		{-1, arrow.PrimitiveTypes.Uint64},
+		{1.5, arrow.PrimitiveTypes.Int32},
+		{uint64(1 << 63), arrow.PrimitiveTypes.Int64},
+		{"abcd", &arrow.FixedSizeBinaryType{ByteWidth: 3}},
+		{"abc", arrow.PrimitiveTypes.Int32},
+		{ints, arrow.ListOf(arrow.PrimitiveTypes.Int64)},
+		{ints, arrow.FixedSizeListOf(3, arrow.PrimitiveTypes.Int32)},
+		{[]interface{}{1}, structType},
+		{[]interface{}{1, 2}, structType},
+		{scalar.NewInt8Scalar(1), arrow.PrimitiveTypes.Int16},
+	} {
+		_, err := scalar.MakeScalarParam(tc.v, tc.dtype)
+		if err == nil {
+			t.Fatalf("expected an error converting %v (%T) to %v", tc.v, tc.v, tc.dtype)
+		}
+	}
+}
+
+func TestMakeStructScalarRetainsFields(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	ib := array.NewInt64Builder(mem)
+	defer ib.Release()
+	ib.AppendValues([]int64{1, 2, 3}, nil)
+	arr := ib.NewArray()
+	list := scalar.NewListScalar(arr)
+	arr.Release()
+
+	dtype := arrow.StructOf(
+		arrow.Field{Name: "a", Type: arrow.ListOf(arrow.PrimitiveTypes.Int64)},
+		arrow.Field{Name: "b", Type: arrow.PrimitiveTypes.Int64},
+	)
+	s, err := scalar.MakeScalarParam([]interface{}{list, int64(1)}, dtype)
+	if err != nil {
+		t.Fatalf("could not make struct scalar: %v", err)
+	}
+	if got, want := s.String(), "{a: [1 2 3], b: 1}"; got != want {
+		t.Fatalf("invalid struct scalar: got=%q, want=%q", got, want)
+	}
+	s.Release()
+
+	if got, want := list.String(), "[1 2 3]"; got != want {
+		t.Fatalf("invalid list scalar after struct release: got=%q, want=%q", got, want)
+	}
+	list.Release()
+}
+
+func TestGetScalar(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	dtype := arrow.StructOf(
+		arrow.Field{Name: "f64", Type: arrow.PrimitiveTypes.Float64, Nullable: true},
+		arrow.Field{Name: "strs", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true},
+		arrow.Field{Name: "pair", Type: arrow.FixedSizeListOf(2, arrow.PrimitiveTypes.Int16), Nullable: true},
+	)
+
+	sb := array.NewStructBuilder(mem, dtype)
+	defer sb.Release()
+
+	var (
+		fb = sb.FieldBuilder(0).(*array.Float64Builder)
+		lb = sb.FieldBuilder(1).(*array.ListBuilder)
+		vb = lb.ValueBuilder().(*array.StringBuilder)
+		pb = sb.FieldBuilder(2).(*array.FixedSizeListBuilder)
+		qb = pb.ValueBuilder().(*array.Int16Builder)
+	)
+
+	sb.Append(true)
+	fb.Append(1.5)
+	lb.Append(true)
+	vb.AppendValues([]string{"a", "b"}, nil)
+	pb.Append(true)
+	qb.AppendValues([]int16{1, 2}, nil)
+
+	sb.AppendValues([]bool{false})
+	fb.AppendNull()
+	lb.AppendNull()
+	pb.AppendNull()
+	qb.AppendNull()
+	qb.AppendNull()
+
+	sb.Append(true)
+	fb.AppendNull()
+	lb.Append(true)
+	pb.Append(true)
+	qb.AppendValues([]int16{3, 4}, []bool{true, false})
+
+	arr := sb.NewArray()
+	defer arr.Release()
+
+	slice := array.NewSlice(arr, 1, 3)
+	defer slice.Release()
+
+	for _, tc := range []struct {
+		arr  array.Interface
+		want []string
+	}{
+		{arr, []string{`{f64: 1.5, strs: ["a" "b"], pair: [1 2]}`, "null", "{f64: null, strs: [], pair: [3 (null)]}"}},
+		{slice, []string{"null", "{f64: null, strs: [], pair: [3 (null)]}"}},
+	} {
+		for i, want := range tc.want {
+			s, err := scalar.GetScalar(tc.arr, i)
+			if err != nil {
+				t.Fatalf("could not get scalar %d: %v", i, err)
+			}
+			if got := s.String(); got != want {
+				t.Fatalf("invalid scalar %d: got=%s, want=%s", i, got, want)
+			}
+
+			rep, err := scalar.MakeArrayFromScalar(s, 3, mem)
+			if err != nil {
+				t.Fatalf("could not make array from scalar %d: %v", i, err)
+			}
+			if err := array.ValidateFull(rep); err != nil {
+				t.Fatalf("invalid array from scalar %d: %v", i, err)
+			}
+			for j := 0; j < rep.Len(); j++ {
+				if !array.ArraySliceEqual(rep, int64(j), int64(j+1), tc.arr, int64(i), int64(i+1)) {
+					t.Fatalf("invalid array from scalar %d: got=%v", i, rep)
+				}
+				o, err := scalar.GetScalar(rep, j)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !scalar.Equals(o, s) {
+					t.Fatalf("scalars differ: got=%v, want=%v", o, s)
+				}
+				o.Release()
+			}
+			rep.Release()
+			s.Release()
+		}
+	}
+
+	_, err := scalar.GetScalar(arr, 3)
+	if err == nil {
+		t.Fatalf("expected an error for out of range index")
+	}
+}
+
+func TestEquals(t *testing.T) {
+	for _, tc := range []struct {
+		l, r scalar.Scalar
+		want bool
+	}{
+		{scalar.NewInt32Scalar(1), scalar.NewInt32Scalar(1), true},
+		{scalar.NewInt32Scalar(1), scalar.NewInt32Scalar(2), false},
+		{scalar.NewInt32Scalar(1), scalar.NewInt64Scalar(1), false},
+		{scalar.NewInt32Scalar(1), scalar.MakeNullScalar(arrow.PrimitiveTypes.Int32), false},
+		{scalar.MakeNullScalar(arrow.PrimitiveTypes.Int32), scalar.MakeNullScalar(arrow.PrimitiveTypes.Int32), true},
+		{scalar.NewBinaryScalar([]byte("a")), scalar.NewBinaryScalar([]byte("a")), true},
+		{
+			scalar.NewTimestampScalar(1, arrow.FixedWidthTypes.Timestamp_s),
+			scalar.NewTimestampScalar(1, arrow.FixedWidthTypes.Timestamp_ms),
+			false,
+		},
+		{scalar.ScalarNull, scalar.MakeNullScalar(arrow.Null), true},
+		{nil, nil, true},
+		{nil, scalar.ScalarNull, false},
+	} {
+		if got := scalar.Equals(tc.l, tc.r); got != tc.want {
+			t.Fatalf("Equals(%v, %v): got=%v, want=%v", tc.l, tc.r, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,238 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package arrow
+
+import (
+	"golang.org/x/xerrors"
+)
+
+// PromoteTypes returns the data type to which values of types a and b can
+// both be converted without loss of information, and whether such a type
+// exists.
+//
+// The promotion rules are:
+//   - null is promoted to any other type,
+//   - signed and unsigned integers are promoted to the widest of the two,
+//     unsigned integers being promoted to a wider signed integer when mixed
+//     with signed integers,
+//   - floating point values are promoted to the widest of the two,
+//   - integers of at most 32 bits mixed with floating point values are
+//     promoted to float64,
+//   - utf8 and large_utf8 are promoted to large_utf8, and likewise for
+//     binary and list types,
+//   - list element types are promoted recursively.
+func PromoteTypes(a, b DataType) (DataType, bool) {
+	switch {
+	case TypeEqual(a, b):
+		return a, true
+	case a.ID() == NULL:
+		return b, true
+	case b.ID() == NULL:
+		return a, true
+	}
+
+	ka, wa := numericKind(a)
+	kb, wb := numericKind(b)
+	if ka != nonNumeric && kb != nonNumeric {
+		return promoteNumeric(ka, wa, kb, wb)
+	}
+
+	switch {
+	case isOneOf(a, b, STRING, LARGE_STRING):
+		return BinaryTypes.LargeString, true
+	case isOneOf(a, b, BINARY, LARGE_BINARY):
+		return BinaryTypes.LargeBinary, true
+	case isOneOf(a, b, LIST, LARGE_LIST):
+		elem, ok := PromoteTypes(listElem(a), listElem(b))
+		if !ok {
+			return nil, false
+		}
+		if a.ID() == LIST && b.ID() == LIST {
+			return ListOf(elem), true
+		}
+		return LargeListOf(elem), true
+	}
+	return nil, false
+}
+
+// MergeSchemas returns a schema holding the union of the fields of the
+// provided schemas, matched by name.
+//
+// Fields are ordered by first appearance. The type of a field is the
+// promotion of its types in all the schemas, as defined by PromoteTypes.
+// A field is nullable if it is nullable in any of the schemas, if it is
+// missing from some of them, or if it has the null type in some of them.
+// Metadata are merged, the first schema declaring a key winning.
+//
+// MergeSchemas returns an error if a schema holds several fields with the
+// same name, or if the types of a field cannot be promoted.
+func MergeSchemas(schemas ...*Schema) (*Schema, error) {
+	var (
+		fields []Field
+		index  = make(map[string]int)
+		counts = make(map[string]int)
+		nulls  = make(map[string]bool)
+		keys   []string
+		values []string
+		seen   = make(map[string]bool)
+	)
+
+	for _, schema := range schemas {
+		for _, field := range schema.Fields() {
+			if len(schema.FieldIndices(field.Name)) > 1 {
+				return nil, xerrors.Errorf("arrow: ambiguous field name %q", field.Name)
+			}
+			counts[field.Name]++
+			if field.Type.ID() == NULL {
+				nulls[field.Name] = true
+			}
+
+			i, ok := index[field.Name]
+			if !ok {
+				index[field.Name] = len(fields)
+				fields = append(fields, field)
+				continue
+			}
+
+			dtype, ok := PromoteTypes(fields[i].Type, field.Type)
+			if !ok {
+				return nil, xerrors.Errorf(
+					"arrow: could not merge field %q: incompatible types %v and %v",
+					field.Name, fields[i].Type, field.Type,
+				)
+			}
+			fields[i].Type = dtype
+			fields[i].Nullable = fields[i].Nullable || field.Nullable
+		}
+
+		md := schema.Metadata()
+		for i, k := range md.Keys() {
+			if seen[k] {
+				continue
+			}
+			seen[k] = true
+			keys = append(keys, k)
+			values = append(values, md.Values()[i])
+		}
+	}
+
+	for i, field := range fields {
+		if counts[field.Name] != len(schemas) || nulls[field.Name] {
+			fields[i].Nullable = true
+		}
+	}
+
+	md := NewMetadata(keys, values)
+	return NewSchema(fields, &md), nil
+}
+
+type numKind int
+
+const (
+	nonNumeric numKind = iota
+	signedKind
+	unsignedKind
+	floatKind
+)
+
+// numericKind returns the kind of numeric type dt is, and its width in bits.
+func numericKind(dt DataType) (numKind, int) {
+	switch dt.ID() {
+	case INT8:
+		return signedKind, 8
+	case INT16:
+		return signedKind, 16
+	case INT32:
+		return signedKind, 32
+	case INT64:
+		return signedKind, 64
+	case UINT8:
+		return unsignedKind, 8
+	case UINT16:
+		return unsignedKind, 16
+	case UINT32:
+		return unsignedKind, 32
+	case UINT64:
+		return unsignedKind, 64
+	case FLOAT16:
+		return floatKind, 16
+	case FLOAT32:
+		return floatKind, 32
+	case FLOAT64:
+		return floatKind, 64
+	}
+	return nonNumeric, 0
+}
+
+func promoteNumeric(ka numKind, wa int, kb numKind, wb int) (DataType, bool) {
+	if ka > kb {
+		ka, wa, kb, wb = kb, wb, ka, wa
+	}
+	w := wa
+	if wb > w {
+		w = wb
+	}
+
+	switch {
+	case ka == kb:
+		return numericType(ka, w), true
+	case ka == signedKind && kb == unsignedKind:
+		if wb >= wa {
+			// an unsigned integer needs a wider signed integer.
+			w = 2 * wb
+		}
+		if w > 64 {
+			return nil, false
+		}
+		return numericType(signedKind, w), true
+	case kb == floatKind && wa <= 32:
+		return PrimitiveTypes.Float64, true
+	}
+	return nil, false
+}
+
+var numericTypes = map[numKind]map[int]DataType{
+	signedKind: {
+		8: PrimitiveTypes.Int8, 16: PrimitiveTypes.Int16,
+		32: PrimitiveTypes.Int32, 64: PrimitiveTypes.Int64,
+	},
+	unsignedKind: {
+		8: PrimitiveTypes.Uint8, 16: PrimitiveTypes.Uint16,
+		32: PrimitiveTypes.Uint32, 64: PrimitiveTypes.Uint64,
+	},
+	floatKind: {
+		16: FixedWidthTypes.Float16, 32: PrimitiveTypes.Float32,
+		64: PrimitiveTypes.Float64,
+	},
+}
+
+func numericType(k numKind, w int) DataType { return numericTypes[k][w] }
+
+// isOneOf returns whether a and b both have one of the type ids x and y.
+func isOneOf(a, b DataType, x, y Type) bool {
+	return (a.ID() == x || a.ID() == y) && (b.ID() == x || b.ID() == y)
+}
+
+func listElem(dt DataType) DataType {
+	switch dt := dt.(type) {
+	case *ListType:
+		return dt.Elem()
+	case *LargeListType:
+		return dt.Elem()
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package arrow
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestPromoteTypes(t *testing.T) {
+	for _, tc := range []struct {
+		a, b DataType
+		want DataType
+	}{
+		{PrimitiveTypes.Int32, PrimitiveTypes.Int32, PrimitiveTypes.Int32},
+		{Null, BinaryTypes.String, BinaryTypes.String},
+		{ListOf(PrimitiveTypes.Int8), Null, ListOf(PrimitiveTypes.Int8)},
+		{PrimitiveTypes.Int8, PrimitiveTypes.Int64, PrimitiveTypes.Int64},
+		{PrimitiveTypes.Uint32, PrimitiveTypes.Uint8, PrimitiveTypes.Uint32},
+		{PrimitiveTypes.Int32, PrimitiveTypes.Uint16, PrimitiveTypes.Int32},
+		{PrimitiveTypes.Uint16, PrimitiveTypes.Int16, PrimitiveTypes.Int32},
+		{PrimitiveTypes.Int8, PrimitiveTypes.Uint32, PrimitiveTypes.Int64},
+		{PrimitiveTypes.Int64, PrimitiveTypes.Uint64, nil},
+		{FixedWidthTypes.Float16, PrimitiveTypes.Float32, PrimitiveTypes.Float32},
+		{PrimitiveTypes.Int32, PrimitiveTypes.Float32, PrimitiveTypes.Float64},
+		{PrimitiveTypes.Float64, PrimitiveTypes.Uint8, PrimitiveTypes.Float64},
+		{PrimitiveTypes.Int64, PrimitiveTypes.Float64, nil},
+		{BinaryTypes.String, BinaryTypes.LargeString, BinaryTypes.LargeString},
+		{BinaryTypes.LargeBinary, BinaryTypes.Binary, BinaryTypes.LargeBinary},
+		{BinaryTypes.String, BinaryTypes.Binary, nil},
+		{ListOf(PrimitiveTypes.Int32), ListOf(PrimitiveTypes.Int64), ListOf(PrimitiveTypes.Int64)},
+		{ListOf(BinaryTypes.String), LargeListOf(Null), LargeListOf(BinaryTypes.String)},
+		{ListOf(BinaryTypes.String), ListOf(PrimitiveTypes.Int32), nil},
+		{PrimitiveTypes.Int32, BinaryTypes.String, nil},
+		{FixedWidthTypes.Boolean, PrimitiveTypes.Int8, nil},
+	} {
+		t.Run(fmt.Sprintf("%v-%v", tc.a, tc.b), func(t *testing.T) {
+			for _, types := range [][2]DataType{{tc.a, tc.b}, {tc.b, tc.a}} {
+				got, ok := PromoteTypes(types[0], types[1])
+				if ok != (tc.want != nil) {
+					t.Fatalf("invalid promotion of %v and %v: got=%v, want=%v", types[0], types[1], got, tc.want)
+				}
+				if ok && !TypeEqual(got, tc.want) {
+					t.Fatalf("invalid promotion of %v and %v: got=%v, want=%v", types[0], types[1], got, tc.want)
+				}
+			}
+		})
+	}
+}
+
+func TestMergeSchemas(t *testing.T) {
+	md1 := NewMetadata([]string{"k1", "k2"}, []string{"v1", "v2"})
+	md2 := NewMetadata([]string{"k2", "k3"}, []string{"xx", "v3"})
+
+	s1 := NewSchema([]Field{
+		{Name: "a", Type: PrimitiveTypes.Int32},
+		{Name: "b", Type: BinaryTypes.String},
+		{Name: "c", Type: Null, Nullable: true},
+	}, &md1)
+	s2 := NewSchema([]Field{
+		{Name: "c", Type: PrimitiveTypes.Float64},
+		{Name: "a", Type: PrimitiveTypes.Int64},
+		{Name: "d", Type: FixedWidthTypes.Boolean},
+	}, &md2)
+
+	got, err := MergeSchemas(s1, s2)
+	if err != nil {
+		t.Fatalf("could not merge schemas: %v", err)
+	}
+
+	md := NewMetadata([]string{"k1", "k2", "k3"}, []string{"v1", "v2", "v3"})
+	want := NewSchema([]Field{
+		{Name: "a", Type: PrimitiveTypes.Int64},
+		{Name: "b", Type: BinaryTypes.String, Nullable: true},
+		{Name: "c", Type: PrimitiveTypes.Float64, Nullable: true},
+		{Name: "d", Type: FixedWidthTypes.Boolean, Nullable: true},
+	}, &md)
+
+	if !got.Equal(want) {
+		t.Fatalf("invalid merged schema:\ngot=%v\nwant=%v", got, want)
+	}
+	if got, want := got.Metadata().String(), want.Metadata().String(); got != want {
+		t.Fatalf("invalid merged metadata: got=%v, want=%v", got, want)
+	}
+
+	nulls := NewSchema([]Field{{Name: "a", Type: Null}}, nil)
+	ints := NewSchema([]Field{{Name: "a", Type: PrimitiveTypes.Int32}}, nil)
+	for _, schemas := range [][]*Schema{{nulls, ints}, {ints, nulls}} {
+		got, err := MergeSchemas(schemas...)
+		if err != nil {
+			t.Fatalf("could not merge schemas: %v", err)
+		}
+		want := NewSchema([]Field{{Name: "a", Type: PrimitiveTypes.Int32, Nullable: true}}, nil)
+		if !got.Equal(want) {
+			t.Fatalf("invalid merged schema:\ngot=%v\nwant=%v", got, want)
+		}
+	}
+
+	for _, schemas := range [][]*Schema{
+		{s1, NewSchema([]Field{{Name: "b", Type: PrimitiveTypes.Int32}}, nil)},
+		{s1, NewSchema([]Field{{Name: "x", Type: Null}, {Name: "x", Type: Null}}, nil)},
+	} {
+		_, err := MergeSchemas(schemas...)
+		if err == nil {
+			t.Fatalf("expected an error")
+		}
+	}
+}
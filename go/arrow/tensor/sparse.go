@@ -0,0 +1,729 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tensor
+
+import (
+	"fmt"
+	"sync/atomic"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/internal/debug"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+// SparseIndexFormat describes how the coordinates of the non-zero values
+// of a sparse tensor are stored.
+type SparseIndexFormat int
+
+const (
+	// SparseCOOIndexFormat stores the coordinates of each non-zero value.
+	SparseCOOIndexFormat SparseIndexFormat = iota
+	// SparseCSRIndexFormat stores a compressed sparse row matrix index.
+	SparseCSRIndexFormat
+	// SparseCSCIndexFormat stores a compressed sparse column matrix index.
+	SparseCSCIndexFormat
+)
+
+func (f SparseIndexFormat) String() string {
+	switch f {
+	case SparseCOOIndexFormat:
+		return "COO"
+	case SparseCSRIndexFormat:
+		return "CSR"
+	case SparseCSCIndexFormat:
+		return "CSC"
+	default:
+		return fmt.Sprintf("SparseIndexFormat(%d)", int(f))
+	}
+}
+
+// SparseIndex describes the location of the non-zero values of a sparse tensor.
+type SparseIndex interface {
+	// Retain increases the reference count by 1.
+	// Retain may be called simultaneously from multiple goroutines.
+	Retain()
+
+	// Release decreases the reference count by 1.
+	// Release may be called simultaneously from multiple goroutines.
+	// When the reference count goes to zero, the memory is freed.
+	Release()
+
+	// Format returns the storage format of the index.
+	Format() SparseIndexFormat
+
+	// NonZeroLength returns the number of non-zero values indexed.
+	NonZeroLength() int64
+
+	// find returns the position, in the sparse values, of the element at
+	// the provided index, or -1 if the element is not stored.
+	find(index []int64) int64
+
+	// visit calls f with the dense coordinates and the value position of
+	// every stored element.
+	visit(f func(index []int64, pos int64))
+
+	validate(shape []int64) error
+}
+
+// SparseCOOIndex is a coordinate-format index of a sparse tensor.
+// The coordinates are stored as a row-major int64 tensor of shape
+// (non-zero length, number of dimensions).
+type SparseCOOIndex struct {
+	refCount  int64
+	coords    *Int64
+	canonical bool
+}
+
+// NewSparseCOOIndex returns a new coordinate-format index from the provided
+// coordinates tensor.
+// canonical indicates whether the coordinates are sorted in row-major order
+// and do not contain duplicates.
+//
+// NewSparseCOOIndex panics if coords is not a 2-dim tensor.
+func NewSparseCOOIndex(coords *Int64, canonical bool) *SparseCOOIndex {
+	if coords.NumDims() != 2 {
+		panic(fmt.Errorf("arrow/tensor: invalid COO coordinates dimensions (got=%d, want=2)", coords.NumDims()))
+	}
+	coords.Retain()
+	return &SparseCOOIndex{
+		refCount:  1,
+		coords:    coords,
+		canonical: canonical,
+	}
+}
+
+// Retain increases the reference count by 1.
+// Retain may be called simultaneously from multiple goroutines.
+func (idx *SparseCOOIndex) Retain() {
+	atomic.AddInt64(&idx.refCount, 1)
+}
+
+// Release decreases the reference count by 1.
+// Release may be called simultaneously from multiple goroutines.
+// When the reference count goes to zero, the memory is freed.
+func (idx *SparseCOOIndex) Release() {
+	debug.Assert(atomic.LoadInt64(&idx.refCount) > 0, "too many releases")
+
+	if atomic.AddInt64(&idx.refCount, -1) == 0 {
+		idx.coords.Release()
+		idx.coords = nil
+	}
+}
+
+func (idx *SparseCOOIndex) Format() SparseIndexFormat { return SparseCOOIndexFormat }
+func (idx *SparseCOOIndex) NonZeroLength() int64      { return idx.coords.Shape()[0] }
+
+// Coords returns the tensor holding the coordinates of the non-zero values.
+func (idx *SparseCOOIndex) Coords() *Int64 { return idx.coords }
+
+// IsCanonical returns whether the coordinates are sorted and unique.
+func (idx *SparseCOOIndex) IsCanonical() bool { return idx.canonical }
+
+func (idx *SparseCOOIndex) find(index []int64) int64 {
+	var (
+		n   = idx.NonZeroLength()
+		pos = make([]int64, 2)
+	)
+	for i := int64(0); i < n; i++ {
+		pos[0] = i
+		match := true
+		for j, v := range index {
+			pos[1] = int64(j)
+			if idx.coords.Value(pos) != v {
+				match = false
+				break
+			}
+		}
+		if match {
+			return i
+		}
+	}
+	return -1
+}
+
+func (idx *SparseCOOIndex) visit(f func(index []int64, pos int64)) {
+	var (
+		n     = idx.NonZeroLength()
+		ndims = idx.coords.Shape()[1]
+		pos   = make([]int64, 2)
+		index = make([]int64, ndims)
+	)
+	for i := int64(0); i < n; i++ {
+		pos[0] = i
+		for j := range index {
+			pos[1] = int64(j)
+			index[j] = idx.coords.Value(pos)
+		}
+		f(index, i)
+	}
+}
+
+func (idx *SparseCOOIndex) validate(shape []int64) error {
+	if got, want := idx.coords.Shape()[1], int64(len(shape)); got != want {
+		return fmt.Errorf("arrow/tensor: COO coordinates dimensions mismatch (got=%d, want=%d)", got, want)
+	}
+	var err error
+	idx.visit(func(index []int64, pos int64) {
+		if err != nil {
+			return
+		}
+		for i, v := range index {
+			if v < 0 || v >= shape[i] {
+				err = fmt.Errorf("arrow/tensor: COO coordinate %v out of bounds for shape %v", index, shape)
+				return
+			}
+		}
+	})
+	return err
+}
+
+// SparseCSXIndex is a compressed sparse row (CSR) or column (CSC) index
+// of a 2-dim sparse tensor.
+//
+// For a CSR index, the i-th row holds the values at positions
+// indptr[i] to indptr[i+1], and indices holds the column of each value.
+// For a CSC index, the roles of rows and columns are swapped.
+type SparseCSXIndex struct {
+	refCount int64
+	format   SparseIndexFormat
+	indptr   *Int64
+	indices  *Int64
+}
+
+// NewSparseCSRIndex returns a new compressed sparse row index from the
+// provided 1-dim index pointer and column indices tensors.
+func NewSparseCSRIndex(indptr, indices *Int64) *SparseCSXIndex {
+	return newSparseCSXIndex(SparseCSRIndexFormat, indptr, indices)
+}
+
+// NewSparseCSCIndex returns a new compressed sparse column index from the
+// provided 1-dim index pointer and row indices tensors.
+func NewSparseCSCIndex(indptr, indices *Int64) *SparseCSXIndex {
+	return newSparseCSXIndex(SparseCSCIndexFormat, indptr, indices)
+}
+
+func newSparseCSXIndex(format SparseIndexFormat, indptr, indices *Int64) *SparseCSXIndex {
+	switch {
+	case indptr.NumDims() != 1:
+		panic(fmt.Errorf("arrow/tensor: invalid %v indptr dimensions (got=%d, want=1)", format, indptr.NumDims()))
+	case indices.NumDims() != 1:
+		panic(fmt.Errorf("arrow/tensor: invalid %v indices dimensions (got=%d, want=1)", format, indices.NumDims()))
+	}
+	indptr.Retain()
+	indices.Retain()
+	return &SparseCSXIndex{
+		refCount: 1,
+		format:   format,
+		indptr:   indptr,
+		indices:  indices,
+	}
+}
+
+// Retain increases the reference count by 1.
+// Retain may be called simultaneously from multiple goroutines.
+func (idx *SparseCSXIndex) Retain() {
+	atomic.AddInt64(&idx.refCount, 1)
+}
+
+// Release decreases the reference count by 1.
+// Release may be called simultaneously from multiple goroutines.
+// When the reference count goes to zero, the memory is freed.
+func (idx *SparseCSXIndex) Release() {
+	debug.Assert(atomic.LoadInt64(&idx.refCount) > 0, "too many releases")
+
+	if atomic.AddInt64(&idx.refCount, -1) == 0 {
+		idx.indptr.Release()
+		idx.indices.Release()
+		idx.indptr = nil
+		idx.indices = nil
+	}
+}
+
+func (idx *SparseCSXIndex) Format() SparseIndexFormat { return idx.format }
+func (idx *SparseCSXIndex) NonZeroLength() int64      { return idx.indices.Shape()[0] }
+
+// IndPtr returns the tensor holding the index pointers.
+func (idx *SparseCSXIndex) IndPtr() *Int64 { return idx.indptr }
+
+// Indices returns the tensor holding the column (CSR) or row (CSC) indices.
+func (idx *SparseCSXIndex) Indices() *Int64 { return idx.indices }
+
+// major returns the position of the compressed axis.
+func (idx *SparseCSXIndex) major() int {
+	if idx.format == SparseCSCIndexFormat {
+		return 1
+	}
+	return 0
+}
+
+func (idx *SparseCSXIndex) find(index []int64) int64 {
+	var (
+		i   = idx.major()
+		ptr = idx.indptr.Int64Values()
+		ind = idx.indices.Int64Values()
+	)
+	for pos := ptr[index[i]]; pos < ptr[index[i]+1]; pos++ {
+		if ind[pos] == index[1-i] {
+			return pos
+		}
+	}
+	return -1
+}
+
+func (idx *SparseCSXIndex) visit(f func(index []int64, pos int64)) {
+	var (
+		i     = idx.major()
+		ptr   = idx.indptr.Int64Values()
+		ind   = idx.indices.Int64Values()
+		index = make([]int64, 2)
+	)
+	for k := 0; k < len(ptr)-1; k++ {
+		index[i] = int64(k)
+		for pos := ptr[k]; pos < ptr[k+1]; pos++ {
+			index[1-i] = ind[pos]
+			f(index, pos)
+		}
+	}
+}
+
+func (idx *SparseCSXIndex) validate(shape []int64) error {
+	if len(shape) != 2 {
+		return fmt.Errorf("arrow/tensor: %v index requires a 2-dim tensor (got=%d)", idx.format, len(shape))
+	}
+	var (
+		i   = idx.major()
+		ptr = idx.indptr.Int64Values()
+		ind = idx.indices.Int64Values()
+	)
+	if got, want := int64(len(ptr)), shape[i]+1; got != want {
+		return fmt.Errorf("arrow/tensor: invalid %v indptr length (got=%d, want=%d)", idx.format, got, want)
+	}
+	if ptr[0] != 0 || ptr[len(ptr)-1] != int64(len(ind)) {
+		return fmt.Errorf("arrow/tensor: invalid %v indptr bounds", idx.format)
+	}
+	for k := 1; k < len(ptr); k++ {
+		if ptr[k] < ptr[k-1] {
+			return fmt.Errorf("arrow/tensor: %v indptr is not monotonic", idx.format)
+		}
+	}
+	for _, v := range ind {
+		if v < 0 || v >= shape[1-i] {
+			return fmt.Errorf("arrow/tensor: %v index %d out of bounds for shape %v", idx.format, v, shape)
+		}
+	}
+	return nil
+}
+
+// Sparse is an n-dimensional array of numerical data for which only the
+// non-zero values, and their location, are stored.
+type Sparse struct {
+	refCount int64
+	dtype    arrow.DataType
+	bw       int64 // bytes width
+	data     *array.Data
+	values   array.Interface
+	index    SparseIndex
+	shape    []int64
+	names    []string
+}
+
+// NewSparse returns a new sparse tensor from the non-zero values held in data,
+// their location in index and the shape of the equivalent dense tensor.
+//
+// NewSparse panics if the backing data is not a numerical type, if the number
+// of values does not match the index or if the index is inconsistent with the shape.
+func NewSparse(data *array.Data, index SparseIndex, shape []int64, names []string) *Sparse {
+	dt := data.DataType()
+	switch dt.ID() {
+	case arrow.INT8, arrow.INT16, arrow.INT32, arrow.INT64,
+		arrow.UINT8, arrow.UINT16, arrow.UINT32, arrow.UINT64,
+		arrow.FLOAT32, arrow.FLOAT64,
+		arrow.DATE32, arrow.DATE64:
+	default:
+		panic(fmt.Errorf("arrow/tensor: invalid data type %s", dt.Name()))
+	}
+
+	if got, want := int64(data.Len()), index.NonZeroLength(); got != want {
+		panic(fmt.Errorf("arrow/tensor: sparse values/index length mismatch (got=%d, want=%d)", got, want))
+	}
+
+	if err := index.validate(shape); err != nil {
+		panic(err)
+	}
+
+	data.Retain()
+	index.Retain()
+	return &Sparse{
+		refCount: 1,
+		dtype:    dt,
+		bw:       int64(dt.(arrow.FixedWidthDataType).BitWidth()) / 8,
+		data:     data,
+		values:   array.MakeFromData(data),
+		index:    index,
+		shape:    shape,
+		names:    names,
+	}
+}
+
+// Retain increases the reference count by 1.
+// Retain may be called simultaneously from multiple goroutines.
+func (s *Sparse) Retain() {
+	atomic.AddInt64(&s.refCount, 1)
+}
+
+// Release decreases the reference count by 1.
+// Release may be called simultaneously from multiple goroutines.
+// When the reference count goes to zero, the memory is freed.
+func (s *Sparse) Release() {
+	debug.Assert(atomic.LoadInt64(&s.refCount) > 0, "too many releases")
+
+	if atomic.AddInt64(&s.refCount, -1) == 0 {
+		s.values.Release()
+		s.data.Release()
+		s.index.Release()
+		s.values = nil
+		s.data = nil
+		s.index = nil
+	}
+}
+
+// Len returns the number of elements in the equivalent dense tensor.
+func (s *Sparse) Len() int {
+	o := int64(1)
+	for _, v := range s.shape {
+		o *= v
+	}
+	return int(o)
+}
+
+func (s *Sparse) Shape() []int64           { return s.shape }
+func (s *Sparse) NumDims() int             { return len(s.shape) }
+func (s *Sparse) DimName(i int) string     { return s.names[i] }
+func (s *Sparse) DimNames() []string       { return s.names }
+func (s *Sparse) DataType() arrow.DataType { return s.dtype }
+
+// Data returns the non-zero values of the sparse tensor.
+func (s *Sparse) Data() *array.Data { return s.data }
+
+// Index returns the index locating the non-zero values.
+func (s *Sparse) Index() SparseIndex { return s.index }
+
+// NonZeroLength returns the number of stored (non-zero) values.
+func (s *Sparse) NonZeroLength() int64 { return s.index.NonZeroLength() }
+
+// Value returns the value at the provided index.
+// The zero value of the tensor's data type is returned for elements that
+// are not stored.
+func (s *Sparse) Value(index []int64) interface{} {
+	if len(index) != len(s.shape) {
+		panic(fmt.Errorf("arrow/tensor: invalid index dimensions (got=%d, want=%d)", len(index), len(s.shape)))
+	}
+	for i, v := range index {
+		if v < 0 || v >= s.shape[i] {
+			panic("arrow/tensor: index out of range")
+		}
+	}
+
+	pos := s.index.find(index)
+	if pos < 0 {
+		return zeroValue(s.dtype)
+	}
+	return valueAt(s.values, int(pos))
+}
+
+// ToDense returns the row-major dense tensor equivalent to the sparse tensor.
+// The returned tensor must be Release()'d after use.
+func (s *Sparse) ToDense(mem memory.Allocator) Interface {
+	var (
+		n       = int64(s.Len())
+		buf     = memory.NewResizableBuffer(mem)
+		strides = rowMajorStrides(s.dtype, s.shape)
+		values  []byte
+	)
+	defer buf.Release()
+
+	if s.data.Len() > 0 {
+		values = s.data.Buffers()[1].Bytes()[int64(s.data.Offset())*s.bw:]
+	}
+
+	buf.Resize(int(n * s.bw))
+	memory.Set(buf.Bytes(), 0)
+	raw := buf.Bytes()
+
+	s.index.visit(func(index []int64, pos int64) {
+		var offset int64
+		for i, v := range index {
+			offset += v * strides[i]
+		}
+		copy(raw[offset:offset+s.bw], values[pos*s.bw:(pos+1)*s.bw])
+	})
+
+	data := array.NewData(s.dtype, int(n), []*memory.Buffer{nil, buf}, nil, 0, 0)
+	defer data.Release()
+
+	return New(data, s.shape, nil, s.names)
+}
+
+// NewSparseCOOFromDense returns a sparse tensor, with a canonical coordinate
+// index, holding the non-zero values of the provided dense tensor.
+// The returned tensor must be Release()'d after use.
+func NewSparseCOOFromDense(mem memory.Allocator, tsr Interface) *Sparse {
+	var (
+		ndims  = tsr.NumDims()
+		coords []int64
+	)
+	values := nonZeroValues(mem, tsr, func(index []int64) {
+		coords = append(coords, index...)
+	})
+	defer values.Release()
+
+	nnz := int64(values.Len())
+	ctsr := newInt64Tensor(mem, coords, []int64{nnz, int64(ndims)})
+	defer ctsr.Release()
+
+	idx := NewSparseCOOIndex(ctsr, true)
+	defer idx.Release()
+
+	return NewSparse(values, idx, tsr.Shape(), tsr.DimNames())
+}
+
+// NewSparseCSRFromDense returns a sparse tensor, with a compressed sparse
+// row index, holding the non-zero values of the provided 2-dim dense tensor.
+// The returned tensor must be Release()'d after use.
+//
+// NewSparseCSRFromDense panics if the dense tensor is not a matrix.
+func NewSparseCSRFromDense(mem memory.Allocator, tsr Interface) *Sparse {
+	return newSparseCSXFromDense(mem, tsr, SparseCSRIndexFormat)
+}
+
+// NewSparseCSCFromDense returns a sparse tensor, with a compressed sparse
+// column index, holding the non-zero values of the provided 2-dim dense tensor.
+// The returned tensor must be Release()'d after use.
+//
+// NewSparseCSCFromDense panics if the dense tensor is not a matrix.
+func NewSparseCSCFromDense(mem memory.Allocator, tsr Interface) *Sparse {
+	return newSparseCSXFromDense(mem, tsr, SparseCSCIndexFormat)
+}
+
+func newSparseCSXFromDense(mem memory.Allocator, tsr Interface, format SparseIndexFormat) *Sparse {
+	if tsr.NumDims() != 2 {
+		panic(fmt.Errorf("arrow/tensor: %v format requires a 2-dim tensor (got=%d)", format, tsr.NumDims()))
+	}
+
+	var (
+		shape = tsr.Shape()
+		major = 0
+		order = []int{0, 1}
+	)
+	if format == SparseCSCIndexFormat {
+		major = 1
+		order = []int{1, 0}
+	}
+
+	var (
+		indptr  = make([]int64, shape[major]+1)
+		indices []int64
+	)
+	values := nonZeroValuesInOrder(mem, tsr, order, func(index []int64) {
+		indptr[index[major]+1]++
+		indices = append(indices, index[1-major])
+	})
+	defer values.Release()
+
+	for i := 1; i < len(indptr); i++ {
+		indptr[i] += indptr[i-1]
+	}
+
+	ptr := newInt64Tensor(mem, indptr, []int64{int64(len(indptr))})
+	defer ptr.Release()
+
+	ind := newInt64Tensor(mem, indices, []int64{int64(len(indices))})
+	defer ind.Release()
+
+	idx := newSparseCSXIndex(format, ptr, ind)
+	defer idx.Release()
+
+	return NewSparse(values, idx, shape, tsr.DimNames())
+}
+
+// SparseEqual reports whether the two provided sparse tensors hold the same
+// logical values, irrespective of their index format.
+func SparseEqual(left, right *Sparse) bool {
+	switch {
+	case !arrow.TypeEqual(left.DataType(), right.DataType()):
+		return false
+	case !equalInt64s(left.Shape(), right.Shape()):
+		return false
+	}
+
+	return sparseSubsetOf(left, right) && sparseSubsetOf(right, left)
+}
+
+// sparseSubsetOf reports whether every value stored in a is also found in b.
+func sparseSubsetOf(a, b *Sparse) bool {
+	ok := true
+	a.index.visit(func(index []int64, pos int64) {
+		if !ok {
+			return
+		}
+		ok = valueAt(a.values, int(pos)) == b.Value(index)
+	})
+	return ok
+}
+
+// nonZeroValues returns the non-zero values of the provided dense tensor,
+// in row-major order, calling f with the index of each of them.
+func nonZeroValues(mem memory.Allocator, tsr Interface, f func(index []int64)) *array.Data {
+	order := make([]int, tsr.NumDims())
+	for i := range order {
+		order[i] = i
+	}
+	return nonZeroValuesInOrder(mem, tsr, order, f)
+}
+
+// nonZeroValuesInOrder returns the non-zero values of the provided dense tensor,
+// iterating over the dimensions from the slowest to the fastest varying one as
+// described by order, calling f with the index of each of them.
+func nonZeroValuesInOrder(mem memory.Allocator, tsr Interface, order []int, f func(index []int64)) *array.Data {
+	var (
+		dt      = tsr.DataType()
+		bw      = int64(dt.(arrow.FixedWidthDataType).BitWidth()) / 8
+		data    = tsr.Data()
+		dense   = array.MakeFromData(data)
+		shape   = tsr.Shape()
+		strides = tsr.Strides()
+		index   = make([]int64, len(shape))
+		raw     []byte
+	)
+	defer dense.Release()
+
+	if tsr.Len() > 0 {
+		raw = data.Buffers()[1].Bytes()[int64(data.Offset())*bw:]
+	}
+
+	var offsets []int64
+	for k := 0; k < tsr.Len(); k++ {
+		var offset int64
+		for i, v := range index {
+			offset += v * strides[i]
+		}
+		if valueAt(dense, int(offset/bw)) != zeroValue(dt) {
+			f(index)
+			offsets = append(offsets, offset)
+		}
+
+		// increment the index, fastest varying dimension last in order.
+		for j := len(order) - 1; j >= 0; j-- {
+			d := order[j]
+			index[d]++
+			if index[d] < shape[d] {
+				break
+			}
+			index[d] = 0
+		}
+	}
+
+	buf := memory.NewResizableBuffer(mem)
+	defer buf.Release()
+
+	buf.Resize(len(offsets) * int(bw))
+	out := buf.Bytes()
+	for i, offset := range offsets {
+		copy(out[int64(i)*bw:], raw[offset:offset+bw])
+	}
+
+	return array.NewData(dt, len(offsets), []*memory.Buffer{nil, buf}, nil, 0, 0)
+}
+
+func newInt64Tensor(mem memory.Allocator, vs []int64, shape []int64) *Int64 {
+	bld := array.NewInt64Builder(mem)
+	defer bld.Release()
+
+	bld.AppendValues(vs, nil)
+	arr := bld.NewInt64Array()
+	defer arr.Release()
+
+	return NewInt64(arr.Data(), shape, nil, nil)
+}
+
+func valueAt(arr array.Interface, i int) interface{} {
+	switch arr := arr.(type) {
+	case *array.Int8:
+		return arr.Value(i)
+	case *array.Int16:
+		return arr.Value(i)
+	case *array.Int32:
+		return arr.Value(i)
+	case *array.Int64:
+		return arr.Value(i)
+	case *array.Uint8:
+		return arr.Value(i)
+	case *array.Uint16:
+		return arr.Value(i)
+	case *array.Uint32:
+		return arr.Value(i)
+	case *array.Uint64:
+		return arr.Value(i)
+	case *array.Float32:
+		return arr.Value(i)
+	case *array.Float64:
+		return arr.Value(i)
+	case *array.Date32:
+		return arr.Value(i)
+	case *array.Date64:
+		return arr.Value(i)
+	default:
+		panic(fmt.Errorf("arrow/tensor: invalid data type %s", arr.DataType().Name()))
+	}
+}
+
+func zeroValue(dt arrow.DataType) interface{} {
+	switch dt.ID() {
+	case arrow.INT8:
+		return int8(0)
+	case arrow.INT16:
+		return int16(0)
+	case arrow.INT32:
+		return int32(0)
+	case arrow.INT64:
+		return int64(0)
+	case arrow.UINT8:
+		return uint8(0)
+	case arrow.UINT16:
+		return uint16(0)
+	case arrow.UINT32:
+		return uint32(0)
+	case arrow.UINT64:
+		return uint64(0)
+	case arrow.FLOAT32:
+		return float32(0)
+	case arrow.FLOAT64:
+		return float64(0)
+	case arrow.DATE32:
+		return arrow.Date32(0)
+	case arrow.DATE64:
+		return arrow.Date64(0)
+	default:
+		panic(fmt.Errorf("arrow/tensor: invalid data type %s", dt.Name()))
+	}
+}
+
+var (
+	_ SparseIndex = (*SparseCOOIndex)(nil)
+	_ SparseIndex = (*SparseCSXIndex)(nil)
+)
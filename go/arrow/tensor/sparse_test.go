@@ -0,0 +1,244 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tensor_test
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/memory"
+	"github.com/apache/arrow/go/arrow/tensor"
+)
+
+func newDenseFloat64(mem memory.Allocator, raw []float64, shape []int64, names []string) *tensor.Float64 {
+	bld := array.NewFloat64Builder(mem)
+	defer bld.Release()
+
+	bld.AppendValues(raw, nil)
+	arr := bld.NewFloat64Array()
+	defer arr.Release()
+
+	return tensor.New(arr.Data(), shape, nil, names).(*tensor.Float64)
+}
+
+func TestSparse(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	var (
+		raw = []float64{
+			1, 0, 0, 2,
+			0, 0, 3, 0,
+			0, 4, 0, 5,
+		}
+		shape = []int64{3, 4}
+		names = []string{"x", "y"}
+	)
+
+	dense := newDenseFloat64(mem, raw, shape, names)
+	defer dense.Release()
+
+	for _, tc := range []struct {
+		name   string
+		format tensor.SparseIndexFormat
+		conv   func(memory.Allocator, tensor.Interface) *tensor.Sparse
+	}{
+		{"coo", tensor.SparseCOOIndexFormat, tensor.NewSparseCOOFromDense},
+		{"csr", tensor.SparseCSRIndexFormat, tensor.NewSparseCSRFromDense},
+		{"csc", tensor.SparseCSCIndexFormat, tensor.NewSparseCSCFromDense},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			sp := tc.conv(mem, dense)
+			defer sp.Release()
+
+			sp.Retain()
+			sp.Release()
+
+			if got, want := sp.Index().Format(), tc.format; got != want {
+				t.Fatalf("invalid format: got=%v, want=%v", got, want)
+			}
+
+			if got, want := sp.NonZeroLength(), int64(5); got != want {
+				t.Fatalf("invalid non-zero length: got=%d, want=%d", got, want)
+			}
+
+			if got, want := sp.Len(), 12; got != want {
+				t.Fatalf("invalid length: got=%d, want=%d", got, want)
+			}
+
+			if got, want := sp.Shape(), shape; !reflect.DeepEqual(got, want) {
+				t.Fatalf("invalid shape: got=%v, want=%v", got, want)
+			}
+
+			if got, want := sp.DimNames(), names; !reflect.DeepEqual(got, want) {
+				t.Fatalf("invalid dim-names: got=%v, want=%v", got, want)
+			}
+
+			if got, want := sp.DataType(), dense.DataType(); got != want {
+				t.Fatalf("invalid data-type: got=%q, want=%q", got.Name(), want.Name())
+			}
+
+			for i := int64(0); i < shape[0]; i++ {
+				for j := int64(0); j < shape[1]; j++ {
+					idx := []int64{i, j}
+					if got, want := sp.Value(idx), dense.Value(idx); got != want {
+						t.Fatalf("invalid value at %v: got=%v, want=%v", idx, got, want)
+					}
+				}
+			}
+
+			back := sp.ToDense(mem).(*tensor.Float64)
+			defer back.Release()
+
+			if got, want := back.Float64Values(), raw; !reflect.DeepEqual(got, want) {
+				t.Fatalf("invalid dense values: got=%v, want=%v", got, want)
+			}
+
+			if !back.IsRowMajor() {
+				t.Fatalf("dense tensor should be row-major")
+			}
+		})
+	}
+
+	coo := tensor.NewSparseCOOFromDense(mem, dense)
+	defer coo.Release()
+
+	csr := tensor.NewSparseCSRFromDense(mem, dense)
+	defer csr.Release()
+
+	csc := tensor.NewSparseCSCFromDense(mem, dense)
+	defer csc.Release()
+
+	if got, want := coo.Index().(*tensor.SparseCOOIndex).Coords().Int64Values(), []int64{0, 0, 0, 3, 1, 2, 2, 1, 2, 3}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("invalid COO coords: got=%v, want=%v", got, want)
+	}
+
+	if got, want := csr.Index().(*tensor.SparseCSXIndex).IndPtr().Int64Values(), []int64{0, 2, 3, 5}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("invalid CSR indptr: got=%v, want=%v", got, want)
+	}
+
+	if got, want := csr.Index().(*tensor.SparseCSXIndex).Indices().Int64Values(), []int64{0, 3, 2, 1, 3}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("invalid CSR indices: got=%v, want=%v", got, want)
+	}
+
+	if got, want := csc.Index().(*tensor.SparseCSXIndex).IndPtr().Int64Values(), []int64{0, 1, 2, 3, 5}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("invalid CSC indptr: got=%v, want=%v", got, want)
+	}
+
+	if got, want := csc.Index().(*tensor.SparseCSXIndex).Indices().Int64Values(), []int64{0, 2, 1, 0, 2}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("invalid CSC indices: got=%v, want=%v", got, want)
+	}
+
+	for _, tc := range []struct {
+		a, b *tensor.Sparse
+	}{
+		{coo, csr},
+		{csr, csc},
+		{csc, coo},
+	} {
+		t.Run(fmt.Sprintf("equal-%v-%v", tc.a.Index().Format(), tc.b.Index().Format()), func(t *testing.T) {
+			if !tensor.SparseEqual(tc.a, tc.b) {
+				t.Fatalf("sparse tensors should be equal")
+			}
+		})
+	}
+
+	other := newDenseFloat64(mem, []float64{
+		1, 0, 0, 2,
+		0, 0, 3, 0,
+		0, 4, 0, 6,
+	}, shape, names)
+	defer other.Release()
+
+	sp := tensor.NewSparseCOOFromDense(mem, other)
+	defer sp.Release()
+
+	if tensor.SparseEqual(coo, sp) {
+		t.Fatalf("sparse tensors should not be equal")
+	}
+}
+
+func TestSparseCOO3D(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	bld := array.NewInt32Builder(mem)
+	defer bld.Release()
+
+	bld.AppendValues([]int32{7, 8}, nil)
+	vals := bld.NewInt32Array()
+	defer vals.Release()
+
+	cbld := array.NewInt64Builder(mem)
+	defer cbld.Release()
+
+	cbld.AppendValues([]int64{0, 1, 1, 1, 0, 2}, nil)
+	carr := cbld.NewInt64Array()
+	defer carr.Release()
+
+	coords := tensor.NewInt64(carr.Data(), []int64{2, 3}, nil, nil)
+	defer coords.Release()
+
+	idx := tensor.NewSparseCOOIndex(coords, true)
+	defer idx.Release()
+
+	sp := tensor.NewSparse(vals.Data(), idx, []int64{2, 2, 3}, nil)
+	defer sp.Release()
+
+	if got, want := sp.Value([]int64{0, 1, 1}), int32(7); got != want {
+		t.Fatalf("invalid value: got=%v, want=%v", got, want)
+	}
+
+	if got, want := sp.Value([]int64{1, 0, 2}), int32(8); got != want {
+		t.Fatalf("invalid value: got=%v, want=%v", got, want)
+	}
+
+	if got, want := sp.Value([]int64{1, 1, 1}), int32(0); got != want {
+		t.Fatalf("invalid value: got=%v, want=%v", got, want)
+	}
+
+	dense := sp.ToDense(mem).(*tensor.Int32)
+	defer dense.Release()
+
+	if got, want := dense.Int32Values(), []int32{0, 0, 0, 0, 7, 0, 0, 0, 8, 0, 0, 0}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("invalid dense values: got=%v, want=%v", got, want)
+	}
+}
+
+func TestInvalidSparse(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	dense := newDenseFloat64(mem, []float64{1, 0, 2, 0, 0, 3, 4, 0}, []int64{2, 2, 2}, nil)
+	defer dense.Release()
+
+	want := fmt.Errorf("arrow/tensor: CSR format requires a 2-dim tensor (got=3)")
+	defer func() {
+		e := recover()
+		if e == nil {
+			t.Fatalf("expected an error: %v", want)
+		}
+		if err, ok := e.(error); !ok || !reflect.DeepEqual(err, want) {
+			t.Fatalf("invalid error: got=%v (%T), want=%v", e, e, want)
+		}
+	}()
+
+	sp := tensor.NewSparseCSRFromDense(mem, dense)
+	defer sp.Release()
+}